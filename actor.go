@@ -0,0 +1,343 @@
+package ghevent
+
+import (
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+//
+// One view of the people (and bots and organizations) in events, whichever object
+// carried them:
+//
+// - Account: sender, repository owner, issue user etc. Has login and ID, and for
+//   push commit authors only username, name and email
+// - EmailUser: the pusher of a push, whose name is the login
+// - CommitUser: authors and committers in commit data, with name and email
+//
+// An ActorResolver links these into Actors by ID, login and email, including the
+// "ID+login@users.noreply.github.com" addresses Github uses for commits. One
+// resolver can be used for a single event, or kept and fed many events so activity
+// across them is attributed to the same Actors:
+//
+//   for _, actor := range ghevent.EventActors(event) {
+//       fmt.Println(actor.Login, actor.Roles)
+//   }
+//
+
+type Actor struct {
+	Login  string
+	ID     int
+	Name   string
+	Type   string   // "User", "Bot" or "Organization", if an Account said
+	Emails []string // Lower case
+	Roles  []string // Where the actor was seen, as paths like "sender" or "commits[0].author"
+}
+
+var noreplyEmailRE = regexp.MustCompile(`^(?:(\d+)\+)?([^@]+)@users\.noreply\.github\.com$`)
+
+// ActorFromAccount returns the actor for an Account (nil if there is none)
+func ActorFromAccount(a *Account) *Actor {
+	if a == nil {
+		return nil
+	}
+	actor := &Actor{Login: a.GetLogin(), ID: a.GetID(), Name: a.GetName(), Type: a.GetType()}
+	if actor.Login == "" {
+		actor.Login = a.GetUsername()
+	}
+	actor.addEmail(a.GetEmail())
+	return actor
+}
+
+// ActorFromEmailUser returns the actor for an EmailUser, which Github uses for
+// pushers, with the login as the name
+func ActorFromEmailUser(u *EmailUser) *Actor {
+	if u == nil {
+		return nil
+	}
+	actor := &Actor{Login: u.GetName()}
+	actor.addEmail(u.GetEmail())
+	return actor
+}
+
+// ActorFromCommitUser returns the actor for a CommitUser. The login is only known
+// for noreply emails
+func ActorFromCommitUser(u *CommitUser) *Actor {
+	if u == nil {
+		return nil
+	}
+	actor := &Actor{Name: u.GetName()}
+	actor.addEmail(u.GetEmail())
+	return actor
+}
+
+func (a *Actor) addEmail(email string) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	if email == "" || containsString(a.Emails, email) {
+		return
+	}
+	a.Emails = append(a.Emails, email)
+	if m := noreplyEmailRE.FindStringSubmatch(email); m != nil {
+		if a.ID == 0 && m[1] != "" {
+			a.ID, _ = strconv.Atoi(m[1])
+		}
+		if a.Login == "" {
+			a.Login = m[2]
+		}
+	}
+}
+
+func (a *Actor) addRole(role string) {
+	if role != "" && !containsString(a.Roles, role) {
+		a.Roles = append(a.Roles, role)
+	}
+}
+
+// merge adds what other knows to a
+func (a *Actor) merge(other *Actor) {
+	if a.Login == "" {
+		a.Login = other.Login
+	}
+	if a.ID == 0 {
+		a.ID = other.ID
+	}
+	if a.Name == "" {
+		a.Name = other.Name
+	}
+	if a.Type == "" {
+		a.Type = other.Type
+	}
+	for _, email := range other.Emails {
+		a.addEmail(email)
+	}
+	for _, role := range other.Roles {
+		a.addRole(role)
+	}
+}
+
+type ActorResolver struct {
+	actors  []*Actor
+	byID    map[int]*Actor
+	byLogin map[string]*Actor // Lower case login
+	byEmail map[string]*Actor
+}
+
+func NewActorResolver() *ActorResolver {
+	return &ActorResolver{
+		byID:    map[int]*Actor{},
+		byLogin: map[string]*Actor{},
+		byEmail: map[string]*Actor{},
+	}
+}
+
+// Add links an actor with the ones added before, and returns the resulting actor,
+// which can be one added before (or nil, for a nil actor or one with nothing to
+// link by). Actors that turn out to be the same are merged, but never ones with
+// different IDs or logins: a shared email (e.g. a team address) doesn't make two
+// accounts one person
+func (r *ActorResolver) Add(actor *Actor, role string) *Actor {
+	if actor == nil || (actor.ID == 0 && actor.Login == "" && len(actor.Emails) == 0) {
+		return nil
+	}
+	candidates := []*Actor{}
+	candidate := func(existing *Actor) {
+		if existing != nil && !containsActor(candidates, existing) {
+			candidates = append(candidates, existing)
+		}
+	}
+	if actor.ID != 0 {
+		candidate(r.byID[actor.ID])
+	}
+	if actor.Login != "" {
+		candidate(r.byLogin[strings.ToLower(actor.Login)])
+	}
+	for _, email := range actor.Emails {
+		candidate(r.byEmail[email])
+	}
+
+	// The candidates that can be the same as actor, and each other
+	known := *actor
+	matches := []*Actor{}
+	for _, c := range candidates {
+		if !conflicting(c, &known) {
+			matches = append(matches, c)
+			known.merge(c)
+		}
+	}
+
+	var resolved *Actor
+	if len(matches) == 0 {
+		resolved = &Actor{}
+		r.actors = append(r.actors, resolved)
+	} else {
+		resolved = matches[0]
+		for _, other := range matches[1:] {
+			resolved.merge(other)
+			r.remove(other)
+			r.repoint(other, resolved)
+		}
+	}
+	resolved.merge(actor)
+	resolved.addRole(role)
+	r.index(resolved)
+	return resolved
+}
+
+// conflicting tells if two actors can't be the same, having different IDs or logins
+func conflicting(a, b *Actor) bool {
+	return (a.ID != 0 && b.ID != 0 && a.ID != b.ID) ||
+		(a.Login != "" && b.Login != "" && !strings.EqualFold(a.Login, b.Login))
+}
+
+func containsActor(actors []*Actor, actor *Actor) bool {
+	for _, a := range actors {
+		if a == actor {
+			return true
+		}
+	}
+	return false
+}
+
+// index points the ID, login and emails of actor to it. Emails that already point
+// to another actor (that actor couldn't be merged with) are left alone
+func (r *ActorResolver) index(actor *Actor) {
+	if actor.ID != 0 {
+		r.byID[actor.ID] = actor
+	}
+	if actor.Login != "" {
+		r.byLogin[strings.ToLower(actor.Login)] = actor
+	}
+	for _, email := range actor.Emails {
+		if r.byEmail[email] == nil {
+			r.byEmail[email] = actor
+		}
+	}
+}
+
+// repoint points all the keys pointing to from (merged into to) to to
+func (r *ActorResolver) repoint(from, to *Actor) {
+	for id, actor := range r.byID {
+		if actor == from {
+			r.byID[id] = to
+		}
+	}
+	for login, actor := range r.byLogin {
+		if actor == from {
+			r.byLogin[login] = to
+		}
+	}
+	for email, actor := range r.byEmail {
+		if actor == from {
+			r.byEmail[email] = to
+		}
+	}
+}
+
+func (r *ActorResolver) remove(actor *Actor) {
+	for i, a := range r.actors {
+		if a == actor {
+			r.actors = append(r.actors[:i], r.actors[i+1:]...)
+			break
+		}
+	}
+}
+
+// AddEvent adds the actors of all the Accounts, EmailUsers and CommitUsers in an
+// event, with their paths as roles, and returns them
+func (r *ActorResolver) AddEvent(event interface{}) []*Actor {
+	added := []*Actor{}
+	walkActors(reflect.ValueOf(event), "", func(actor *Actor, path string) {
+		if resolved := r.Add(actor, path); resolved != nil && !containsActor(added, resolved) {
+			added = append(added, resolved)
+		}
+	})
+	// Adding one can merge two added before
+	kept := added[:0]
+	for _, actor := range added {
+		if containsActor(r.actors, actor) {
+			kept = append(kept, actor)
+		}
+	}
+	return kept
+}
+
+var (
+	accountType    = reflect.TypeOf(Account{})
+	emailUserType  = reflect.TypeOf(EmailUser{})
+	commitUserType = reflect.TypeOf(CommitUser{})
+)
+
+func walkActors(v reflect.Value, path string, found func(*Actor, string)) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Struct:
+		switch v.Type() {
+		case accountType:
+			found(ActorFromAccount(v.Addr().Interface().(*Account)), path)
+			return
+		case emailUserType:
+			found(ActorFromEmailUser(v.Addr().Interface().(*EmailUser)), path)
+			return
+		case commitUserType:
+			found(ActorFromCommitUser(v.Addr().Interface().(*CommitUser)), path)
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			name, ok := jsonFieldName(v.Type().Field(i))
+			if !ok {
+				continue
+			}
+			if path != "" {
+				name = path + "." + name
+			}
+			walkActors(v.Field(i), name, found)
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			walkActors(v.Index(i), path+"["+strconv.Itoa(i)+"]", found)
+		}
+	}
+}
+
+// Resolve returns the actor with a login, ID (as a string) or email, or nil
+func (r *ActorResolver) Resolve(key string) *Actor {
+	if actor := r.byLogin[strings.ToLower(key)]; actor != nil {
+		return actor
+	}
+	if actor := r.byEmail[strings.ToLower(key)]; actor != nil {
+		return actor
+	}
+	if id, err := strconv.Atoi(key); err == nil {
+		return r.byID[id]
+	}
+	return nil
+}
+
+// Actors returns all the actors, sorted by login (and those without one by name)
+func (r *ActorResolver) Actors() []*Actor {
+	actors := append([]*Actor{}, r.actors...)
+	sort.SliceStable(actors, func(i, j int) bool {
+		if (actors[i].Login == "") != (actors[j].Login == "") {
+			return actors[i].Login != ""
+		}
+		if actors[i].Login != actors[j].Login {
+			return strings.ToLower(actors[i].Login) < strings.ToLower(actors[j].Login)
+		}
+		return actors[i].Name < actors[j].Name
+	})
+	return actors
+}
+
+// EventActors returns the actors in an event, sorted by login
+func EventActors(event interface{}) []*Actor {
+	r := NewActorResolver()
+	r.AddEvent(event)
+	return r.Actors()
+}
@@ -0,0 +1,103 @@
+package ghevent
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func describeActors(actors []*Actor) string {
+	lines := []string{}
+	for _, a := range actors {
+		lines = append(lines, fmt.Sprintf("%s %d %q %s %v %v", a.Login, a.ID, a.Name, a.Type, a.Emails, a.Roles))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func TestEventActors(t *testing.T) {
+	octocat := NewAccountBuilder("octocat").Build()
+	codertocat := NewAccountBuilder("Codertocat").Build()
+	push := NewPushEventBuilder(NewRepositoryBuilder(codertocat, "Hello-World").Build()).
+		Sender(octocat).
+		Commit("Fix", nil, nil, []string{"main.go"}).
+		Build()
+	// A commit by someone without a Github account
+	push.Commits = append(push.Commits, Commit{ID: Ptr("abc"), Author: &Account{Name: Ptr("Jane Doe"), Email: Ptr("Jane@Example.com")}})
+
+	got := describeActors(EventActors(push))
+	want := strings.Join([]string{
+		fmt.Sprintf(`Codertocat %d "" User [] [repository.owner]`, codertocat.GetID()),
+		fmt.Sprintf(`octocat %d "octocat" User [%s] [commits[0].author commits[0].committer head_commit.author head_commit.committer pusher sender]`,
+			octocat.GetID(), strings.ToLower(noreplyEmail(octocat))),
+		` 0 "Jane Doe"  [jane@example.com] [commits[1].author]`,
+	}, "\n")
+	if got != want {
+		t.Errorf("actors are\n%s\n(should be\n%s)", got, want)
+	}
+}
+
+func TestActorResolver(t *testing.T) {
+	r := NewActorResolver()
+	// Only linked by email at first...
+	byEmail := r.Add(ActorFromCommitUser(&CommitUser{Name: Ptr("Mona"), Email: Ptr("mona@example.com")}), "commit.author")
+	byLogin := r.Add(ActorFromAccount(&Account{Login: Ptr("monalisa"), ID: Ptr(583231), Type: Ptr("User")}), "sender")
+	if byEmail == byLogin || len(r.Actors()) != 2 {
+		t.Fatalf("unrelated actors were linked")
+	}
+	// ...until an account has both
+	merged := r.Add(ActorFromAccount(&Account{Username: Ptr("monalisa"), Email: Ptr("mona@example.com")}), "commits[0].author")
+	if len(r.Actors()) != 1 {
+		t.Fatalf("got actors\n%s", describeActors(r.Actors()))
+	}
+	if got := describeActors([]*Actor{merged}); got != `monalisa 583231 "Mona" User [mona@example.com] [sender commit.author commits[0].author]` {
+		t.Errorf("merged actor is %s", got)
+	}
+	for _, key := range []string{"MonaLisa", "583231", "MONA@example.com"} {
+		if r.Resolve(key) != merged {
+			t.Errorf("Resolve(%s) returned %v", key, r.Resolve(key))
+		}
+	}
+	if r.Resolve("octocat") != nil {
+		t.Errorf("Resolve() found an unknown login")
+	}
+
+	// Noreply emails give the login and ID
+	noreply := ActorFromCommitUser(&CommitUser{Email: Ptr("583231+monalisa@users.noreply.github.com")})
+	if noreply.Login != "monalisa" || noreply.ID != 583231 || r.Add(noreply, "") != merged {
+		t.Errorf("noreply email gave %+v", noreply)
+	}
+	if r.Add(&Actor{}, "sender") != nil || r.Add(nil, "sender") != nil {
+		t.Errorf("empty actors were added")
+	}
+}
+
+func TestActorResolverSharedEmail(t *testing.T) {
+	r := NewActorResolver()
+	alice := r.Add(ActorFromAccount(&Account{Login: Ptr("alice"), ID: Ptr(1), Email: Ptr("team@example.com")}), "sender")
+	bob := r.Add(ActorFromAccount(&Account{Login: Ptr("bob"), ID: Ptr(2), Email: Ptr("team@example.com")}), "sender")
+	if alice == bob || len(r.Actors()) != 2 {
+		t.Fatalf("accounts sharing an email were merged (should not be):\n%s", describeActors(r.Actors()))
+	}
+	if r.Resolve("bob") != bob || r.Resolve("2") != bob || r.Resolve("team@example.com") != alice {
+		t.Errorf("Resolve(bob/2/team@example.com) returned %v/%v/%v", r.Resolve("bob"), r.Resolve("2"), r.Resolve("team@example.com"))
+	}
+	// Nor by an actor that would link them
+	if linking := r.Add(&Actor{Login: "alice", ID: 2}, "sender"); linking == alice || linking == bob || len(r.Actors()) != 3 {
+		t.Errorf("conflicting actors were merged (should not be):\n%s", describeActors(r.Actors()))
+	}
+
+	// When actors are merged, all the keys of the one merged away point to the other
+	r = NewActorResolver()
+	carol := r.Add(ActorFromCommitUser(&CommitUser{Name: Ptr("Carol"), Email: Ptr("carol@example.com")}), "commit.author")
+	account := r.Add(ActorFromAccount(&Account{Login: Ptr("carol"), ID: Ptr(3)}), "sender")
+	merged := r.Add(&Actor{Login: "carol", Emails: []string{"carol@example.com", "c@example.com"}}, "commits[0].author")
+	actors := r.Actors()
+	if len(actors) != 1 || actors[0] != merged || (merged != carol && merged != account) {
+		t.Fatalf("got actors\n%s", describeActors(actors))
+	}
+	for _, key := range []string{"carol", "3", "carol@example.com", "c@example.com"} {
+		if r.Resolve(key) != merged {
+			t.Errorf("Resolve(%s) returned %v (should be the merged actor)", key, r.Resolve(key))
+		}
+	}
+}
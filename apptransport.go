@@ -0,0 +1,226 @@
+package ghevent
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+//
+// Authentication as a Github App. AppTransport signs requests with a JWT (RS256,
+// from the app ID and private key), which is what the /app endpoints need:
+//
+//   appTransport, err := ghevent.NewAppTransport(http.DefaultTransport, 5725, pemKey)
+//   client := &http.Client{Transport: appTransport}
+//   client.Get("https://api.github.com/app/installations")
+//
+// Anything else needs an installation access token. InstallationTransport gets one
+// from BaseURL's /app/installations/{id}/access_tokens, and caches it until shortly
+// before it expires:
+//
+//   client := &http.Client{Transport: appTransport.ForInstallation(event.Installation)}
+//   client.Get("https://api.github.com/installation/repositories")
+//
+
+const (
+	DefaultBaseURL = "https://api.github.com"
+
+	jwtLifetime   = 10 * time.Minute // The maximum Github accepts
+	jwtClockSkew  = time.Minute      // Backdating of "iat", for clocks that run fast
+	refreshMargin = time.Minute      // Tokens are renewed this long before they expire
+)
+
+type AppTransport struct {
+	AppID   int
+	BaseURL string            // Where installation tokens are requested. Defaults to DefaultBaseURL
+	Base    http.RoundTripper // Defaults to http.DefaultTransport
+
+	key *rsa.PrivateKey
+	now func() time.Time
+
+	mu        sync.Mutex
+	jwt       string
+	jwtExpiry time.Time
+	tokens    map[string]*InstallationToken // Access tokens URL -> token
+}
+
+// NewAppTransport returns a transport authenticating as the app, using its private
+// key (the PEM file Github lets you download, PKCS#1 or PKCS#8)
+func NewAppTransport(base http.RoundTripper, appID int, pemKey []byte) (*AppTransport, error) {
+	block, _ := pem.Decode(pemKey)
+	if block == nil {
+		return nil, errors.New("private key is not PEM encoded")
+	}
+	key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+	if err != nil {
+		parsed, err8 := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err8 != nil {
+			return nil, fmt.Errorf("can't parse private key: %v", err)
+		}
+		var ok bool
+		if key, ok = parsed.(*rsa.PrivateKey); !ok {
+			return nil, errors.New("private key is not an RSA key")
+		}
+	}
+	return &AppTransport{
+		AppID:  appID,
+		Base:   base,
+		key:    key,
+		now:    time.Now,
+		tokens: map[string]*InstallationToken{},
+	}, nil
+}
+
+// JWT returns a JSON Web Token for the app, reusing the previous one unless it is
+// about to expire
+func (t *AppTransport) JWT() (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	now := t.now()
+	if t.jwt != "" && now.Add(refreshMargin).Before(t.jwtExpiry) {
+		return t.jwt, nil
+	}
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"RS256","typ":"JWT"}`))
+	claims, err := json.Marshal(map[string]int64{
+		"iat": now.Add(-jwtClockSkew).Unix(),
+		"exp": now.Add(jwtLifetime - jwtClockSkew).Unix(),
+		"iss": int64(t.AppID),
+	})
+	if err != nil {
+		return "", err
+	}
+	signed := header + "." + base64.RawURLEncoding.EncodeToString(claims)
+	hash := sha256.Sum256([]byte(signed))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, t.key, crypto.SHA256, hash[:])
+	if err != nil {
+		return "", err
+	}
+	t.jwt = signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+	t.jwtExpiry = now.Add(jwtLifetime - jwtClockSkew)
+	return t.jwt, nil
+}
+
+// RoundTrip implements http.RoundTripper, authenticating as the app
+func (t *AppTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	jwt, err := t.JWT()
+	if err != nil {
+		closeBody(req)
+		return nil, err
+	}
+	return roundTripWithAuth(t.base(), req, "Bearer "+jwt)
+}
+
+func (t *AppTransport) base() http.RoundTripper {
+	if t.Base == nil {
+		return http.DefaultTransport
+	}
+	return t.Base
+}
+
+// roundTripWithAuth sends a copy of req (RoundTrippers mustn't modify requests)
+// with an Authorization header
+func roundTripWithAuth(base http.RoundTripper, req *http.Request, auth string) (*http.Response, error) {
+	clone := req.Clone(req.Context())
+	clone.Header.Set("Authorization", auth)
+	if clone.Header.Get("Accept") == "" {
+		clone.Header.Set("Accept", "application/vnd.github+json")
+	}
+	return base.RoundTrip(clone)
+}
+
+// closeBody closes the body of a request that won't be sent, as RoundTrippers must
+func closeBody(req *http.Request) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+}
+
+// ForInstallation returns a transport authenticating as the installation. Only the
+// ID of the installation is needed. Its access_tokens_url isn't used: payloads can
+// be forged, and the app's JWT must only be sent to BaseURL
+func (t *AppTransport) ForInstallation(installation *Installation) *InstallationTransport {
+	baseURL := t.BaseURL
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+	url := fmt.Sprintf("%s/app/installations/%d/access_tokens", strings.TrimSuffix(baseURL, "/"), installation.GetID())
+	return &InstallationTransport{app: t, id: installation.GetID(), accessTokensURL: url}
+}
+
+// InstallationToken returns an access token for the installation, from the cache if
+// it doesn't expire within a minute
+func (t *AppTransport) InstallationToken(ctx context.Context, installation *Installation) (*InstallationToken, error) {
+	return t.ForInstallation(installation).Token(ctx)
+}
+
+type InstallationTransport struct {
+	app             *AppTransport
+	id              int
+	accessTokensURL string
+}
+
+// Token returns an access token for the installation, from the cache if it doesn't
+// expire within a minute
+func (t *InstallationTransport) Token(ctx context.Context) (*InstallationToken, error) {
+	if t.id == 0 {
+		return nil, errors.New("getting installation token: installation has no ID")
+	}
+	t.app.mu.Lock()
+	token := t.app.tokens[t.accessTokensURL]
+	t.app.mu.Unlock()
+	if token != nil && t.app.now().Add(refreshMargin).Before(token.GetExpiresAt()) {
+		return token, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.accessTokensURL, bytes.NewReader(nil))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := t.app.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("getting installation token: %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+	token = &InstallationToken{}
+	if err := json.Unmarshal(body, token); err != nil {
+		return nil, fmt.Errorf("getting installation token: %v", err)
+	}
+	if token.GetToken() == "" || token.ExpiresAt == nil {
+		return nil, errors.New("getting installation token: response has no token or expires_at")
+	}
+	t.app.mu.Lock()
+	t.app.tokens[t.accessTokensURL] = token
+	t.app.mu.Unlock()
+	return token, nil
+}
+
+// RoundTrip implements http.RoundTripper, authenticating as the installation
+func (t *InstallationTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.Token(req.Context())
+	if err != nil {
+		closeBody(req)
+		return nil, err
+	}
+	return roundTripWithAuth(t.app.base(), req, "token "+token.GetToken())
+}
@@ -0,0 +1,186 @@
+package ghevent
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeAppAPI is a stand-in for the Github API, handing out installation tokens to
+// requests with a valid JWT, and accepting them
+type fakeAppAPI struct {
+	key        *rsa.PublicKey
+	appID      int
+	now        func() time.Time
+	mu         sync.Mutex
+	tokenCount int
+}
+
+func (api *fakeAppAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	auth := r.Header.Get("Authorization")
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/app/installations/42/access_tokens":
+		if err := api.verifyJWT(strings.TrimPrefix(auth, "Bearer ")); err != nil {
+			http.Error(w, `{"message":"`+err.Error()+`"}`, http.StatusUnauthorized)
+			return
+		}
+		api.mu.Lock()
+		api.tokenCount++
+		token := fmt.Sprintf("ghs_%d", api.tokenCount)
+		api.mu.Unlock()
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"token":"%s","expires_at":"%s","permissions":{"issues":"write"},"repository_selection":"all"}`,
+			token, api.now().Add(time.Hour).UTC().Format(time.RFC3339))
+	case r.URL.Path == "/app":
+		if err := api.verifyJWT(strings.TrimPrefix(auth, "Bearer ")); err != nil {
+			http.Error(w, `{"message":"`+err.Error()+`"}`, http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, `{"id":5725}`)
+	case r.URL.Path == "/installation/repositories":
+		fmt.Fprintf(w, `{"auth":"%s"}`, auth)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (api *fakeAppAPI) verifyJWT(jwt string) error {
+	parts := strings.Split(jwt, ".")
+	if len(parts) != 3 {
+		return fmt.Errorf("malformed JWT")
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return err
+	}
+	hash := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(api.key, crypto.SHA256, hash[:], signature); err != nil {
+		return err
+	}
+	payload, _ := base64.RawURLEncoding.DecodeString(parts[1])
+	claims := struct{ Iat, Exp, Iss int64 }{}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return err
+	}
+	now := api.now().Unix()
+	if claims.Iss != int64(api.appID) || claims.Iat > now || claims.Exp < now || claims.Exp-claims.Iat > 600 {
+		return fmt.Errorf("bad claims %+v", claims)
+	}
+	return nil
+}
+
+func newTestAppTransport(t *testing.T, pkcs8 bool) (*AppTransport, *fakeAppAPI, *time.Time) {
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block := &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}
+	if pkcs8 {
+		der, _ := x509.MarshalPKCS8PrivateKey(key)
+		block = &pem.Block{Type: "PRIVATE KEY", Bytes: der}
+	}
+	now := time.Date(2021, 1, 14, 7, 35, 8, 0, time.UTC)
+	clock := func() time.Time { return now }
+	api := &fakeAppAPI{key: &key.PublicKey, appID: 5725, now: clock}
+	server := httptest.NewServer(api)
+	t.Cleanup(server.Close)
+	transport, err := NewAppTransport(nil, 5725, pem.EncodeToMemory(block))
+	if err != nil {
+		t.Fatal(err)
+	}
+	transport.BaseURL = server.URL
+	transport.now = clock
+	return transport, api, &now
+}
+
+func getBody(t *testing.T, client *http.Client, url string) string {
+	t.Helper()
+	resp, err := client.Get(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp.Status + " " + string(body)
+}
+
+func TestAppTransport(t *testing.T) {
+	for _, pkcs8 := range []bool{false, true} {
+		transport, api, now := newTestAppTransport(t, pkcs8)
+		if got := getBody(t, &http.Client{Transport: transport}, transport.BaseURL+"/app"); got != `200 OK {"id":5725}` {
+			t.Errorf("GET /app as the app returned %s", got)
+		}
+
+		client := &http.Client{Transport: transport.ForInstallation(&Installation{ID: Ptr(42)})}
+		for i := 0; i < 2; i++ {
+			if got := getBody(t, client, transport.BaseURL+"/installation/repositories"); got != `200 OK {"auth":"token ghs_1"}` {
+				t.Errorf("GET as the installation returned %s", got)
+			}
+		}
+		token, err := transport.InstallationToken(context.Background(), &Installation{ID: Ptr(42)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if token.GetToken() != "ghs_1" || token.GetPermissions()["issues"] != "write" || api.tokenCount != 1 {
+			t.Errorf("got token %s (%d tokens handed out)", token.GetToken(), api.tokenCount)
+		}
+
+		// Tokens, and JWTs, are renewed shortly before they expire
+		*now = now.Add(59*time.Minute + time.Second)
+		if got := getBody(t, client, transport.BaseURL+"/installation/repositories"); got != `200 OK {"auth":"token ghs_2"}` {
+			t.Errorf("GET with an expiring token returned %s", got)
+		}
+	}
+}
+
+func TestAppTransportErrors(t *testing.T) {
+	if _, err := NewAppTransport(nil, 1, []byte("not a key")); err == nil {
+		t.Error("NewAppTransport() should fail without a PEM key")
+	}
+	if _, err := NewAppTransport(nil, 1, pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: []byte("junk")})); err == nil {
+		t.Error("NewAppTransport() should fail with a broken key")
+	}
+
+	transport, _, _ := newTestAppTransport(t, false)
+	other, _, _ := newTestAppTransport(t, false)
+	// Signed with the wrong key
+	other.BaseURL = transport.BaseURL
+	if _, err := other.InstallationToken(context.Background(), &Installation{ID: Ptr(42)}); err == nil || !strings.Contains(err.Error(), "401") {
+		t.Errorf("getting a token with the wrong key returned %v", err)
+	}
+	// The JWT is never sent to an access_tokens_url on another host
+	foreignRequests := 0
+	foreign := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		foreignRequests++
+		http.NotFound(w, r)
+	}))
+	defer foreign.Close()
+	installation := &Installation{ID: Ptr(42), AccessTokensURL: Ptr(foreign.URL + "/app/installations/42/access_tokens")}
+	client := &http.Client{Transport: transport.ForInstallation(installation)}
+	if got := getBody(t, client, transport.BaseURL+"/installation/repositories"); got != `200 OK {"auth":"token ghs_1"}` {
+		t.Errorf("GET with a foreign access_tokens_url returned %s", got)
+	}
+	if foreignRequests != 0 {
+		t.Errorf("%d requests were sent to the foreign access_tokens_url (should be none)", foreignRequests)
+	}
+	if _, err := transport.InstallationToken(context.Background(), &Installation{AccessTokensURL: Ptr(transport.BaseURL + "/app/installations/42/access_tokens")}); err == nil {
+		t.Error("getting a token for an installation without ID succeeded (should fail)")
+	}
+}
@@ -0,0 +1,323 @@
+package ghevent
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+//
+// Reading GH Archive (https://www.gharchive.org) hourly dumps, which are gzipped
+// JSON lines of Events API envelopes:
+//
+//   f, _ := os.Open("2021-01-14-7.json.gz")
+//   archive, err := ghevent.NewArchiveReader(f)
+//   for archive.Next() {
+//       e := archive.Event()
+//       if e.Err != nil {
+//           log.Print(e.Err) // A bad line, reading goes on
+//           continue
+//       }
+//       ...
+//   }
+//   if err := archive.Err(); err != nil { // Reading failed
+//
+// Dumps from before 2015 (the old timeline API) are converted to the current
+// envelope: "actor" was a login with the account in "actor_attributes", "repository"
+// had the owner's login and a github.com URL, and push payloads had "shas" rather
+// than "commits". Events that have no ID in those dumps get a nil ID.
+//
+
+const maxArchiveLine = 16 << 20 // Some events (big pushes, gollum) have long lines
+
+// ArchiveEvent is a line of a GH Archive dump: the envelope, and the payload decoded
+// into an event struct if this package models the type
+type ArchiveEvent struct {
+	Line  int
+	API   *APIEvent
+	Event interface{}
+	Err   error // An *ArchiveLineError
+}
+
+// ArchiveLineError is a line that couldn't be decoded
+type ArchiveLineError struct {
+	Line int
+	Err  error
+}
+
+func (e *ArchiveLineError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+func (e *ArchiveLineError) Unwrap() error {
+	return e.Err
+}
+
+type ArchiveReader struct {
+	reader *bufio.Reader
+	buf    []byte
+	line   int
+	event  *ArchiveEvent
+	err    error
+}
+
+// NewArchiveReader returns a reader for a dump, gzipped or not
+func NewArchiveReader(r io.Reader) (*ArchiveReader, error) {
+	buffered := bufio.NewReader(r)
+	if magic, err := buffered.Peek(2); err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		gz, err := gzip.NewReader(buffered)
+		if err != nil {
+			return nil, err
+		}
+		r = gz
+	} else {
+		r = buffered
+	}
+	return &ArchiveReader{reader: bufio.NewReaderSize(r, 64*1024)}, nil
+}
+
+// Next reads the next event, returning false at the end of the dump or if reading
+// fails (see Err). Lines that can't be decoded, or are longer than 16MB, are events
+// with Err set
+func (r *ArchiveReader) Next() bool {
+	for {
+		line, tooLong, err := r.readLine()
+		if err != nil {
+			r.event = nil
+			if err != io.EOF {
+				r.err = fmt.Errorf("line %d: %v", r.line+1, err)
+			}
+			return false
+		}
+		r.line++
+		if tooLong {
+			r.event = &ArchiveEvent{Line: r.line, Err: &ArchiveLineError{Line: r.line, Err: fmt.Errorf("longer than %d bytes", maxArchiveLine)}}
+			return true
+		}
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+		r.event = &ArchiveEvent{Line: r.line}
+		apiEvent, err := decodeArchiveLine(line)
+		if err == nil {
+			r.event.API = apiEvent
+			if apiEvent.EventType() != "" {
+				r.event.Event, err = apiEvent.Decode()
+			}
+		}
+		if err != nil {
+			r.event.Err = &ArchiveLineError{Line: r.line, Err: err}
+		}
+		return true
+	}
+}
+
+// readLine returns the next line (valid until the next one), or tells that it is
+// longer than maxArchiveLine, in which case the rest of it is skipped. The error is
+// io.EOF at the end of the dump
+func (r *ArchiveReader) readLine() ([]byte, bool, error) {
+	r.buf = r.buf[:0]
+	read, tooLong := false, false
+	for {
+		chunk, err := r.reader.ReadSlice('\n')
+		read = read || len(chunk) > 0
+		if !tooLong {
+			if tooLong = len(r.buf)+len(chunk) > maxArchiveLine+1; tooLong { // With the "\n"
+				r.buf = r.buf[:0]
+			} else {
+				r.buf = append(r.buf, chunk...)
+			}
+		}
+		switch {
+		case err == bufio.ErrBufferFull:
+			continue
+		case err == io.EOF && read:
+			return r.buf, tooLong, nil // The last line has no "\n"
+		case err != nil:
+			return nil, false, err
+		}
+		return r.buf, tooLong, nil
+	}
+}
+
+// Event returns the event read by Next
+func (r *ArchiveReader) Event() *ArchiveEvent {
+	return r.event
+}
+
+// Err returns the error that stopped Next, if any
+func (r *ArchiveReader) Err() error {
+	return r.err
+}
+
+// archiveEnvelope has the fields of both the current and the pre-2015 envelopes
+type archiveEnvelope struct {
+	ID              json.RawMessage `json:"id"` // A string, but a number in some dumps
+	Type            *string         `json:"type"`
+	Actor           json.RawMessage `json:"actor"` // A login before 2015
+	ActorAttributes *Account        `json:"actor_attributes"`
+	Repo            *APIEventRepo   `json:"repo"`
+	Repository      *struct {
+		ID    *int    `json:"id"`
+		Name  *string `json:"name"`
+		Owner *string `json:"owner"`
+		URL   *string `json:"url"`
+	} `json:"repository"`
+	Org       *Account        `json:"org"`
+	Payload   json.RawMessage `json:"payload"`
+	Public    *bool           `json:"public"`
+	CreatedAt *string         `json:"created_at"`
+}
+
+// Time formats seen in created_at, besides RFC 3339
+var archiveTimeFormats = []string{
+	"2006/01/02 15:04:05 -0700",
+	"2006-01-02 15:04:05 -0700",
+}
+
+func decodeArchiveLine(line []byte) (*APIEvent, error) {
+	envelope := archiveEnvelope{}
+	if err := json.Unmarshal(line, &envelope); err != nil {
+		return nil, err
+	}
+	if envelope.Type == nil {
+		return nil, fmt.Errorf("no event type")
+	}
+	apiEvent := &APIEvent{
+		Type:    envelope.Type,
+		Repo:    envelope.Repo,
+		Org:     envelope.Org,
+		Payload: envelope.Payload,
+		Public:  envelope.Public,
+	}
+
+	if len(envelope.ID) > 0 && string(envelope.ID) != "null" {
+		id := strings.Trim(string(envelope.ID), `"`)
+		apiEvent.ID = &id
+	}
+
+	switch {
+	case len(envelope.Actor) > 0 && envelope.Actor[0] == '"':
+		login := ""
+		if err := json.Unmarshal(envelope.Actor, &login); err != nil {
+			return nil, fmt.Errorf("actor: %v", err)
+		}
+		apiEvent.Actor = envelope.ActorAttributes
+		if apiEvent.Actor == nil {
+			apiEvent.Actor = &Account{}
+		}
+		apiEvent.Actor.Login = &login
+	case len(envelope.Actor) > 0 && string(envelope.Actor) != "null":
+		apiEvent.Actor = &Account{}
+		if err := json.Unmarshal(envelope.Actor, apiEvent.Actor); err != nil {
+			return nil, fmt.Errorf("actor: %v", err)
+		}
+	}
+
+	if apiEvent.Repo == nil && envelope.Repository != nil { // Before 2015
+		old := envelope.Repository
+		apiEvent.Repo = &APIEventRepo{ID: old.ID, Name: old.Name, URL: old.URL}
+		if old.Owner != nil && old.Name != nil {
+			fullName := *old.Owner + "/" + *old.Name
+			apiEvent.Repo.Name = &fullName
+		}
+		payload, err := legacyArchivePayload(apiEvent.GetType(), apiEvent.Payload)
+		if err != nil {
+			return nil, fmt.Errorf("payload: %v", err)
+		}
+		apiEvent.Payload = payload
+	}
+
+	if envelope.CreatedAt != nil {
+		createdAt, err := parseArchiveTime(*envelope.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		apiEvent.CreatedAt = NewTimeWrapper(createdAt)
+	}
+	return apiEvent, nil
+}
+
+// legacyArchivePayload converts the parts of pre-2015 payloads that don't decode
+// into the event structs: push "shas" (lists of sha, email, message, name and
+// distinct), and issue and comment IDs where there are objects now
+func legacyArchivePayload(apiType string, payload json.RawMessage) (json.RawMessage, error) {
+	fields := map[string]json.RawMessage{}
+	if len(payload) == 0 || json.Unmarshal(payload, &fields) != nil {
+		return payload, nil // Left for Decode to complain about
+	}
+	changed := false
+	switch apiType {
+	case "PushEvent":
+		shas := [][]interface{}{}
+		if _, ok := fields["commits"]; ok || fields["shas"] == nil {
+			break
+		}
+		if err := json.Unmarshal(fields["shas"], &shas); err != nil {
+			return nil, fmt.Errorf("shas: %v", err)
+		}
+		commits := []map[string]interface{}{}
+		for _, sha := range shas {
+			if len(sha) < 4 {
+				return nil, fmt.Errorf("shas: %v has too few elements", sha)
+			}
+			commit := map[string]interface{}{
+				"sha":     sha[0],
+				"author":  map[string]interface{}{"email": sha[1], "name": sha[3]},
+				"message": sha[2],
+			}
+			if len(sha) > 4 {
+				commit["distinct"] = sha[4]
+			}
+			commits = append(commits, commit)
+		}
+		fields["commits"], _ = json.Marshal(commits)
+		delete(fields, "shas")
+		changed = true
+	case "IssuesEvent":
+		if id, ok := fields["issue"]; ok && len(id) > 0 && id[0] != '{' {
+			fields["issue"] = json.RawMessage(fmt.Sprintf(`{"id":%s,"number":%s}`, id, orNull(fields["number"])))
+			changed = true
+		}
+	case "IssueCommentEvent":
+		if id, ok := fields["issue_id"]; ok && fields["issue"] == nil {
+			fields["issue"] = json.RawMessage(fmt.Sprintf(`{"id":%s}`, id))
+			changed = true
+		}
+		if id, ok := fields["comment_id"]; ok && fields["comment"] == nil {
+			fields["comment"] = json.RawMessage(fmt.Sprintf(`{"id":%s}`, id))
+			changed = true
+		}
+	}
+	if !changed {
+		return payload, nil
+	}
+	return json.Marshal(fields)
+}
+
+func orNull(raw json.RawMessage) string {
+	if len(raw) == 0 {
+		return "null"
+	}
+	return string(raw)
+}
+
+func parseArchiveTime(s string) (time.Time, error) {
+	tw := &TimeWrapper{}
+	if err := tw.UnmarshalJSON([]byte(strconv.Quote(s))); err == nil {
+		return tw.Time(), nil
+	}
+	for _, format := range archiveTimeFormats {
+		if t, err := time.Parse(format, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("can't parse created_at \"%s\"", s)
+}
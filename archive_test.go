@@ -0,0 +1,137 @@
+package ghevent
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+// readArchive returns a line per event read: the line number, Events API type and
+// X-Github-Event name, or the error
+func readArchive(t *testing.T, name string) ([]string, []*ArchiveEvent) {
+	t.Helper()
+	f, err := os.Open("testdata/archive/" + name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	archive, err := NewArchiveReader(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines, events := []string{}, []*ArchiveEvent{}
+	for archive.Next() {
+		e := archive.Event()
+		events = append(events, e)
+		if e.Err != nil {
+			lines = append(lines, e.Err.Error())
+		} else {
+			lines = append(lines, fmt.Sprintf("%d %s %s", e.Line, e.API.GetType(), EventTypeOf(e.Event)))
+		}
+	}
+	if err := archive.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return lines, events
+}
+
+func TestArchiveReader(t *testing.T) {
+	lines, events := readArchive(t, "2021-01-14-7.json.gz")
+	want := []string{
+		"1 PushEvent push",
+		"2 WatchEvent ",
+		"line 3: decoding IssuesEvent 14871346664: json: cannot unmarshal string", // The rest depends on the Go version
+		"line 4: unexpected end of JSON input",
+		"5 IssuesEvent issues",
+	}
+	matches := len(lines) == len(want)
+	for i := 0; matches && i < len(want); i++ {
+		matches = strings.HasPrefix(lines[i], want[i])
+	}
+	if !matches {
+		t.Errorf("read\n%s\n(should be\n%s)", strings.Join(lines, "\n"), strings.Join(want, "\n"))
+	}
+	lineErr := &ArchiveLineError{}
+	if !errors.As(events[3].Err, &lineErr) || lineErr.Line != 4 {
+		t.Errorf("line error is %#v", events[3].Err)
+	}
+	push := events[0].Event.(*PushEvent)
+	expectString(t, "after", push.After, "7b8f8b1c4c2b1e5a3f0e1d2c3b4a5968778695a4")
+	expectString(t, "repository.full_name", push.Repository.FullName, "Codertocat/Hello-World")
+	expectString(t, "organization.login", events[4].Event.(*IssuesEvent).Organization.Login, "Octocoders")
+}
+
+func TestArchiveReaderLegacy(t *testing.T) {
+	lines, events := readArchive(t, "2012-03-10-22.json.gz")
+	if want := "1 PushEvent push|2 IssuesEvent issues|3 IssueCommentEvent issue_comment"; strings.Join(lines, "|") != want {
+		t.Fatalf("read %s (should be %s)", strings.Join(lines, "|"), want)
+	}
+	push := events[0].Event.(*PushEvent)
+	if events[0].API.ID != nil {
+		t.Errorf("ID is %s (should be nil)", events[0].API.GetID())
+	}
+	if want := time.Date(2012, 3, 11, 6, 0, 23, 0, time.UTC); !events[0].API.GetCreatedAt().Equal(want) {
+		t.Errorf("created_at is %v (should be %v)", events[0].API.GetCreatedAt(), want)
+	}
+	expectString(t, "sender.login", push.Sender.Login, "Codertocat")
+	expectString(t, "sender.email", push.Sender.Email, "codertocat@example.com")
+	expectString(t, "repository.full_name", push.Repository.FullName, "Codertocat/Hello-World")
+	expectString(t, "repository.owner.login", push.Repository.Owner.Login, "Codertocat")
+	expectString(t, "after", push.After, "6113728f27ae82c7b1a177c8d03f9e96e0adf246")
+	if len(push.Commits) != 1 {
+		t.Fatalf("push has %d commits (should be 1)", len(push.Commits))
+	}
+	expectString(t, "commits[0].id", push.Commits[0].ID, "6113728f27ae82c7b1a177c8d03f9e96e0adf246")
+	expectString(t, "commits[0].message", push.Commits[0].Message, "Initial commit")
+	expectString(t, "commits[0].author.email", push.Commits[0].Author.Email, "codertocat@example.com")
+	expectBool(t, "commits[0].distinct", push.Commits[0].Distinct, true)
+
+	issues := events[1].Event.(*IssuesEvent)
+	expectInt(t, "issue.id", issues.Issue.ID, 4567)
+	expectInt(t, "issue.number", issues.Issue.Number, 12)
+	if want := time.Date(2011, 5, 1, 19, 0, 1, 0, time.UTC); !events[1].API.GetCreatedAt().Equal(want) {
+		t.Errorf("created_at is %v (should be %v)", events[1].API.GetCreatedAt(), want)
+	}
+	comment := events[2].Event.(*IssueCommentEvent)
+	expectInt(t, "comment.id", comment.Comment.ID, 890)
+	expectInt(t, "issue.id", comment.Issue.ID, 4567)
+}
+
+func TestArchiveReaderPlain(t *testing.T) {
+	archive, err := NewArchiveReader(bytes.NewReader([]byte("\n" + apiPushEvent[:1] + strings.ReplaceAll(apiPushEvent[1:], "\n", "") + "\n")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !archive.Next() || archive.Event().Err != nil || archive.Event().Line != 2 {
+		t.Fatalf("reading an uncompressed dump returned %+v", archive.Event())
+	}
+	if archive.Next() || archive.Err() != nil {
+		t.Errorf("reading past the end returned %v", archive.Err())
+	}
+	// A line that is too long is an error, and reading goes on
+	line := apiPushEvent[:1] + strings.ReplaceAll(apiPushEvent[1:], "\n", "")
+	long := `{"type":"GollumEvent","payload":{"text":"` + strings.Repeat("x", maxArchiveLine) + `"}}`
+	archive, err = NewArchiveReader(strings.NewReader(line + "\n" + long + "\n" + line))
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines, lineErr := []int{}, &ArchiveLineError{}
+	for archive.Next() {
+		if e := archive.Event(); e.Err == nil {
+			lines = append(lines, e.Line)
+		} else if !errors.As(e.Err, &lineErr) || lineErr.Line != 2 {
+			t.Errorf("line %d has error %v (should be only line 2, too long)", e.Line, e.Err)
+		}
+	}
+	if archive.Err() != nil || fmt.Sprint(lines) != "[1 3]" {
+		t.Errorf("read lines %v around a long one, with error %v (should be [1 3])", lines, archive.Err())
+	}
+
+	if _, err := NewArchiveReader(bytes.NewReader([]byte{0x1f, 0x8b, 0})); err == nil {
+		t.Errorf("NewArchiveReader() should fail on a broken gzip header")
+	}
+}
@@ -0,0 +1,153 @@
+package ghevent
+
+import "strings"
+
+//
+// Telling automation from people. An Account is a bot if Github says it is an app
+// (type "Bot", which app accounts have, along with a "[bot]" suffix on the login,
+// e.g. "dependabot[bot]"), or if it is on a deny list, which is where machine users
+// (regular accounts used by scripts) go. A user that is only named like an app, e.g.
+// "stale", is not a bot. An allow list overrides all of that.
+//
+// Apps also act on behalf of users (performed_via_github_app). That is automation
+// when the app is a known one, by its slug: others, like chat integrations, mostly
+// relay what a person did.
+//
+//   detector := &ghevent.BotDetector{Deny: []string{"ci-user"}}
+//   if detector.IsAutomated(event) {
+//       return // Not counted
+//   }
+//
+
+// KnownApps are the slugs of common apps that automate, which are also the logins of
+// their accounts without "[bot]"
+var KnownApps = []string{
+	"dependabot", "dependabot-preview", "renovate", "github-actions", "codecov",
+	"greenkeeper", "imgbot", "allcontributors", "mergify", "stale", "pre-commit-ci",
+	"netlify", "vercel", "sonarcloud",
+}
+
+type BotDetector struct {
+	Apps  []string // Slugs of apps that automate, KnownApps if nil
+	Allow []string // Logins (or app slugs) that are never bots
+	Deny  []string // Logins that are always bots (but not apps)
+}
+
+// DefaultBotDetector is used by IsBot, IsApp and IsAutomated
+var DefaultBotDetector = &BotDetector{}
+
+func IsBot(a *Account) bool {
+	return DefaultBotDetector.IsBot(a)
+}
+
+func IsApp(a *Account) bool {
+	return DefaultBotDetector.IsApp(a)
+}
+
+func IsAutomated(event interface{}) bool {
+	return DefaultBotDetector.IsAutomated(event)
+}
+
+// IsBot tells if an account is an app or a machine user
+func (d *BotDetector) IsBot(a *Account) bool {
+	if a == nil {
+		return false
+	}
+	login := strings.ToLower(accountLogin(a))
+	switch {
+	case containsFold(d.Allow, login):
+		return false
+	case containsFold(d.Deny, login):
+		return true
+	}
+	return d.IsApp(a)
+}
+
+// IsApp tells if an account is that of a Github App. Only app accounts have logins
+// ending with "[bot]", so a "[bot]" login is enough where there is no type, as for
+// commit authors in pushes
+func (d *BotDetector) IsApp(a *Account) bool {
+	if a == nil {
+		return false
+	}
+	login := strings.ToLower(accountLogin(a))
+	if containsFold(d.Allow, login) {
+		return false
+	}
+	return a.GetType() == "Bot" || strings.HasSuffix(login, "[bot]")
+}
+
+// IsKnownApp tells if an account is that of one of Apps. Only app accounts (type
+// "Bot") count, so a user with the login of an app's slug isn't one
+func (d *BotDetector) IsKnownApp(a *Account) bool {
+	return a.GetType() == "Bot" && d.isKnownSlug(strings.TrimSuffix(strings.ToLower(accountLogin(a)), "[bot]"))
+}
+
+func (d *BotDetector) isKnownSlug(slug string) bool {
+	apps := d.Apps
+	if apps == nil {
+		apps = KnownApps
+	}
+	return slug != "" && !containsFold(d.Allow, slug) && containsFold(apps, slug)
+}
+
+// accountLogin returns the login, or for commit authors in pushes the username
+func accountLogin(a *Account) string {
+	if a.GetLogin() != "" {
+		return a.GetLogin()
+	}
+	return a.GetUsername()
+}
+
+func containsFold(list []string, s string) bool {
+	s = strings.TrimSuffix(s, "[bot]")
+	for _, item := range list {
+		if strings.EqualFold(strings.TrimSuffix(item, "[bot]"), s) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsAutomated tells if an event was caused by automation: its sender is a bot, or
+// its comment was made by one of Apps on behalf of a user, or its issue was opened
+// by one. The issue only counts for its opening, and for what its author (the user
+// the app acted for) does to it: a person closing or commenting on an issue an app
+// opened is not automation
+func (d *BotDetector) IsAutomated(event interface{}) bool {
+	sender := (*Account)(nil)
+	if e, ok := event.(interface{ GetSender() *Account }); ok {
+		if sender = e.GetSender(); d.IsBot(sender) {
+			return true
+		}
+	}
+	if e, ok := event.(interface{ GetComment() *IssueComment }); ok && d.isKnownSlug(e.GetComment().GetPerformedViaGithubApp().GetSlug()) {
+		return true
+	}
+	if _, isComment := event.(*IssueCommentEvent); isComment {
+		return false
+	}
+	if e, ok := event.(interface{ GetIssue() *Issue }); ok && d.isKnownSlug(e.GetIssue().GetPerformedViaGithubApp().GetSlug()) {
+		if e, ok := event.(interface{ GetAction() string }); ok && e.GetAction() == "opened" {
+			return true
+		}
+		return sameAccount(e.GetIssue().GetUser(), sender)
+	}
+	return false
+}
+
+// sameAccount tells if a and b are the same account, by ID or else by login
+func sameAccount(a, b *Account) bool {
+	switch {
+	case a == nil || b == nil:
+		return false
+	case a.GetID() != 0 && b.GetID() != 0:
+		return a.GetID() == b.GetID()
+	}
+	return a.GetLogin() != "" && strings.EqualFold(a.GetLogin(), b.GetLogin())
+}
+
+// Human is IsAutomated negated, for use as a filter
+func (d *BotDetector) Human(event interface{}) bool {
+	return !d.IsAutomated(event)
+}
@@ -0,0 +1,96 @@
+package ghevent
+
+import "testing"
+
+func TestBotDetector(t *testing.T) {
+	detector := &BotDetector{Allow: []string{"renovate"}, Deny: []string{"ci-user"}}
+	tests := []struct {
+		account *Account
+		bot     bool
+		app     bool
+	}{
+		{nil, false, false},
+		{&Account{Login: Ptr("octocat"), Type: Ptr("User")}, false, false},
+		{&Account{Login: Ptr("dependabot[bot]"), Type: Ptr("Bot")}, true, true},
+		{&Account{Login: Ptr("some-app[bot]")}, true, true},
+		{&Account{Login: Ptr("Codecov"), Type: Ptr("User")}, false, false},
+		{&Account{Login: Ptr("stale"), Type: Ptr("User")}, false, false},
+		{&Account{Username: Ptr("github-actions[bot]")}, true, true},
+		{&Account{Username: Ptr("web-flow")}, false, false},
+		{&Account{Login: Ptr("renovate[bot]"), Type: Ptr("Bot")}, false, false},
+		{&Account{Login: Ptr("CI-User"), Type: Ptr("User")}, true, false},
+	}
+	for _, test := range tests {
+		if bot := detector.IsBot(test.account); bot != test.bot {
+			t.Errorf("IsBot(%s) returned %t (should be %t)", test.account.GetLogin(), bot, test.bot)
+		}
+		if app := detector.IsApp(test.account); app != test.app {
+			t.Errorf("IsApp(%s) returned %t (should be %t)", test.account.GetLogin(), app, test.app)
+		}
+	}
+
+	// Known apps are app accounts with one of Apps' slugs, not users named like one
+	known := []struct {
+		account *Account
+		known   bool
+	}{
+		{&Account{Login: Ptr("stale[bot]"), Type: Ptr("Bot")}, true},
+		{&Account{Login: Ptr("stale"), Type: Ptr("User")}, false},
+		{&Account{Login: Ptr("Codecov"), Type: Ptr("User")}, false},
+		{&Account{Login: Ptr("some-app[bot]"), Type: Ptr("Bot")}, false},
+		{&Account{Login: Ptr("renovate[bot]"), Type: Ptr("Bot")}, false}, // Allowed
+		{nil, false},
+	}
+	for _, test := range known {
+		if got := detector.IsKnownApp(test.account); got != test.known {
+			t.Errorf("IsKnownApp(%s) returned %t (should be %t)", test.account.GetLogin(), got, test.known)
+		}
+	}
+	custom := &BotDetector{Apps: []string{"our-app"}}
+	if !custom.IsKnownApp(&Account{Login: Ptr("our-app[bot]"), Type: Ptr("Bot")}) || custom.IsKnownApp(&Account{Login: Ptr("stale[bot]"), Type: Ptr("Bot")}) {
+		t.Errorf("IsKnownApp doesn't use Apps")
+	}
+	if custom.IsBot(&Account{Login: Ptr("our-app"), Type: Ptr("User")}) {
+		t.Errorf("user named our-app is a bot with Apps set (should not be)")
+	}
+
+	// Machine users go on the deny list
+	machines := &BotDetector{Deny: []string{"web-flow"}}
+	if !machines.IsBot(&Account{Username: Ptr("web-flow")}) || machines.IsApp(&Account{Username: Ptr("web-flow")}) {
+		t.Errorf("denied web-flow is not a bot, or is an app (should be a bot only)")
+	}
+}
+
+func TestIsAutomated(t *testing.T) {
+	app := &App{Slug: Ptr("mergify")}
+	integration := &App{Slug: Ptr("slack")}
+	human := &Account{Login: Ptr("octocat"), Type: Ptr("User")}
+	author := &Account{Login: Ptr("hubot"), ID: Ptr(2), Type: Ptr("User")}
+	bot := &Account{Login: Ptr("dependabot[bot]"), Type: Ptr("Bot")}
+	tests := []struct {
+		name      string
+		event     interface{}
+		automated bool
+	}{
+		{"human push", NewPushEventBuilder(NewRepositoryBuilder(human, "hello").Build()).Sender(human).Build(), false},
+		{"bot push", NewPushEventBuilder(NewRepositoryBuilder(human, "hello").Build()).Sender(bot).Build(), true},
+		{"human issue", &IssuesEvent{Sender: human, Issue: &Issue{}}, false},
+		{"app issue", &IssuesEvent{Action: Ptr("opened"), Sender: human, Issue: &Issue{PerformedViaGithubApp: app}}, true},
+		{"human closes app issue", &IssuesEvent{Action: Ptr("closed"), Sender: human, Issue: &Issue{User: author, PerformedViaGithubApp: app}}, false},
+		{"author closes app issue", &IssuesEvent{Action: Ptr("closed"), Sender: author, Issue: &Issue{User: author, PerformedViaGithubApp: app}}, true},
+		{"comment on app issue", &IssueCommentEvent{Sender: human, Issue: &Issue{PerformedViaGithubApp: app}, Comment: &IssueComment{}}, false},
+		{"app comment", &IssueCommentEvent{Sender: human, Issue: &Issue{}, Comment: &IssueComment{PerformedViaGithubApp: app}}, true},
+		{"comment through an integration", &IssueCommentEvent{Sender: human, Issue: &Issue{}, Comment: &IssueComment{PerformedViaGithubApp: integration}}, false},
+		{"issue through an integration", &IssuesEvent{Action: Ptr("opened"), Sender: human, Issue: &Issue{PerformedViaGithubApp: integration}}, false},
+		{"human named like an app", &IssuesEvent{Action: Ptr("opened"), Sender: &Account{Login: Ptr("stale"), Type: Ptr("User")}, Issue: &Issue{}}, false},
+		{"no sender", &Label{}, false},
+	}
+	for _, test := range tests {
+		if automated := IsAutomated(test.event); automated != test.automated {
+			t.Errorf("%s: IsAutomated returned %t (should be %t)", test.name, automated, test.automated)
+		}
+		if human := DefaultBotDetector.Human(test.event); human == test.automated {
+			t.Errorf("%s: Human returned %t (should be %t)", test.name, human, !test.automated)
+		}
+	}
+}
@@ -0,0 +1,825 @@
+package ghevent
+
+import (
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"hash/fnv"
+	"net/url"
+	"strings"
+	"time"
+)
+
+//
+// Helpers for composing events, mostly for use in tests. The builders fill in the
+// same fields Github would, and keep them consistent with each other: URLs are
+// derived from logins and repository names, node IDs from IDs, the "after" SHA of a
+// push is its head commit, and so on. IDs are derived from names, so the same
+// builder calls always produce the same event.
+//
+
+const (
+	builderAPIURL  = "https://api.github.com"
+	builderHTMLURL = "https://github.com"
+	zeroSHA        = "0000000000000000000000000000000000000000"
+)
+
+// The timestamp used by builders unless told otherwise
+var builderTime = time.Date(2021, 1, 14, 7, 35, 8, 0, time.UTC)
+
+// Ptr returns a pointer to a copy of v, e.g. Ptr("refs/heads/master") or Ptr(42)
+func Ptr[T any](v T) *T {
+	return &v
+}
+
+// NewTimeWrapper returns a TimeWrapper holding t
+func NewTimeWrapper(t time.Time) *TimeWrapper {
+	return &TimeWrapper{t: t}
+}
+
+// stableID derives a realistic looking, stable, ID from a name
+func stableID(name string) int {
+	h := fnv.New32a()
+	h.Write([]byte(name))
+	return 10000000 + int(h.Sum32()%90000000)
+}
+
+// nodeID returns a "legacy" Github node ID, e.g. "MDQ6VXNlcjY1MjQ4MDk=" for user 6524809
+func nodeID(typeName string, id int) string {
+	return base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("0%d:%s%d", len(typeName), typeName, id)))
+}
+
+// fakeSHA returns a stable 40 character hex SHA for some content
+func fakeSHA(content ...string) string {
+	sum := sha1.Sum([]byte(strings.Join(content, "\x00")))
+	return hex.EncodeToString(sum[:])
+}
+
+func shortSHA(sha string) string {
+	if len(sha) > 12 {
+		return sha[:12]
+	}
+	return sha
+}
+
+//
+// Account
+//
+
+type AccountBuilder struct {
+	login       string
+	id          int
+	accountType string
+	name        string
+	email       string
+}
+
+// NewAccountBuilder returns a builder for a "User" account with the given login
+func NewAccountBuilder(login string) *AccountBuilder {
+	return &AccountBuilder{login: login, id: stableID(login), accountType: "User"}
+}
+
+func (b *AccountBuilder) ID(id int) *AccountBuilder {
+	b.id = id
+	return b
+}
+
+// Organization makes the account an "Organization"
+func (b *AccountBuilder) Organization() *AccountBuilder {
+	b.accountType = "Organization"
+	return b
+}
+
+// Bot makes the account a "Bot", and adds the "[bot]" suffix to its login
+func (b *AccountBuilder) Bot() *AccountBuilder {
+	b.accountType = "Bot"
+	if !strings.HasSuffix(b.login, "[bot]") {
+		b.login += "[bot]"
+	}
+	return b
+}
+
+func (b *AccountBuilder) Name(name string) *AccountBuilder {
+	b.name = name
+	return b
+}
+
+func (b *AccountBuilder) Email(email string) *AccountBuilder {
+	b.email = email
+	return b
+}
+
+func (b *AccountBuilder) Build() *Account {
+	users := builderAPIURL + "/users/" + b.login
+	a := &Account{
+		Login:             Ptr(b.login),
+		ID:                Ptr(b.id),
+		NodeID:            Ptr(nodeID(b.accountType, b.id)),
+		AvatarURL:         Ptr(fmt.Sprintf("https://avatars.githubusercontent.com/u/%d?v=4", b.id)),
+		GravatarID:        Ptr(""),
+		URL:               Ptr(users),
+		HTMLURL:           Ptr(builderHTMLURL + "/" + b.login),
+		FollowersURL:      Ptr(users + "/followers"),
+		FollowingURL:      Ptr(users + "/following{/other_user}"),
+		GistsURL:          Ptr(users + "/gists{/gist_id}"),
+		StarredURL:        Ptr(users + "/starred{/owner}{/repo}"),
+		SubscriptionsURL:  Ptr(users + "/subscriptions"),
+		OrganizationsURL:  Ptr(users + "/orgs"),
+		ReposURL:          Ptr(users + "/repos"),
+		EventsURL:         Ptr(users + "/events{/privacy}"),
+		ReceivedEventsURL: Ptr(users + "/received_events"),
+		Type:              Ptr(b.accountType),
+		SiteAdmin:         Ptr(false),
+	}
+	if b.accountType == "Bot" {
+		a.HTMLURL = Ptr(builderHTMLURL + "/apps/" + strings.TrimSuffix(b.login, "[bot]"))
+	}
+	if b.name != "" {
+		a.Name = Ptr(b.name)
+	}
+	if b.email != "" {
+		a.Email = Ptr(b.email)
+	}
+	return a
+}
+
+// noreplyEmail returns the "<id>+<login>@users.noreply.github.com" address of an account
+func noreplyEmail(a *Account) string {
+	return fmt.Sprintf("%d+%s@users.noreply.github.com", a.GetID(), a.GetLogin())
+}
+
+//
+// Repository
+//
+
+type RepositoryBuilder struct {
+	owner         *Account
+	name          string
+	id            int
+	private       bool
+	fork          bool
+	description   string
+	defaultBranch string
+	language      string
+	at            time.Time
+}
+
+// NewRepositoryBuilder returns a builder for the repository owner/name. The owner is
+// used as-is, so build it with an AccountBuilder first
+func NewRepositoryBuilder(owner *Account, name string) *RepositoryBuilder {
+	return &RepositoryBuilder{
+		owner:         owner,
+		name:          name,
+		id:            stableID(owner.GetLogin() + "/" + name),
+		defaultBranch: "master",
+		at:            builderTime,
+	}
+}
+
+func (b *RepositoryBuilder) ID(id int) *RepositoryBuilder {
+	b.id = id
+	return b
+}
+
+func (b *RepositoryBuilder) Private() *RepositoryBuilder {
+	b.private = true
+	return b
+}
+
+func (b *RepositoryBuilder) Fork() *RepositoryBuilder {
+	b.fork = true
+	return b
+}
+
+func (b *RepositoryBuilder) Description(description string) *RepositoryBuilder {
+	b.description = description
+	return b
+}
+
+func (b *RepositoryBuilder) DefaultBranch(branch string) *RepositoryBuilder {
+	b.defaultBranch = branch
+	return b
+}
+
+func (b *RepositoryBuilder) Language(language string) *RepositoryBuilder {
+	b.language = language
+	return b
+}
+
+// At sets the created/updated/pushed timestamps
+func (b *RepositoryBuilder) At(t time.Time) *RepositoryBuilder {
+	b.at = t
+	return b
+}
+
+func (b *RepositoryBuilder) Build() *Repository {
+	fullName := b.owner.GetLogin() + "/" + b.name
+	api := builderAPIURL + "/repos/" + fullName
+	html := builderHTMLURL + "/" + fullName
+	r := &Repository{
+		ID:               Ptr(b.id),
+		NodeID:           Ptr(nodeID("Repository", b.id)),
+		Name:             Ptr(b.name),
+		FullName:         Ptr(fullName),
+		Private:          Ptr(b.private),
+		Owner:            b.owner,
+		HTMLURL:          Ptr(html),
+		Fork:             Ptr(b.fork),
+		URL:              Ptr(api),
+		ForksURL:         Ptr(api + "/forks"),
+		KeysURL:          Ptr(api + "/keys{/key_id}"),
+		CollaboratorsURL: Ptr(api + "/collaborators{/collaborator}"),
+		TeamsURL:         Ptr(api + "/teams"),
+		HooksURL:         Ptr(api + "/hooks"),
+		IssueEventsURL:   Ptr(api + "/issues/events{/number}"),
+		EventsURL:        Ptr(api + "/events"),
+		AssigneesURL:     Ptr(api + "/assignees{/user}"),
+		BranchesURL:      Ptr(api + "/branches{/branch}"),
+		TagsURL:          Ptr(api + "/tags"),
+		BlobsURL:         Ptr(api + "/git/blobs{/sha}"),
+		GitTagsURL:       Ptr(api + "/git/tags{/sha}"),
+		GitRefsURL:       Ptr(api + "/git/refs{/sha}"),
+		TreesURL:         Ptr(api + "/git/trees{/sha}"),
+		StatusesURL:      Ptr(api + "/statuses/{sha}"),
+		LanguagesURL:     Ptr(api + "/languages"),
+		StargazersURL:    Ptr(api + "/stargazers"),
+		ContributorsURL:  Ptr(api + "/contributors"),
+		SubscribersURL:   Ptr(api + "/subscribers"),
+		SubscriptionURL:  Ptr(api + "/subscription"),
+		CommitsURL:       Ptr(api + "/commits{/sha}"),
+		GitCommitsURL:    Ptr(api + "/git/commits{/sha}"),
+		CommentsURL:      Ptr(api + "/comments{/number}"),
+		IssueCommentsURL: Ptr(api + "/issues/comments{/number}"),
+		ContentsURL:      Ptr(api + "/contents/{+path}"),
+		CompareURL:       Ptr(api + "/compare/{base}...{head}"),
+		MergesURL:        Ptr(api + "/merges"),
+		ArchiveURL:       Ptr(api + "/{archive_format}{/ref}"),
+		DownloadsURL:     Ptr(api + "/downloads"),
+		IssuesURL:        Ptr(api + "/issues{/number}"),
+		PullsURL:         Ptr(api + "/pulls{/number}"),
+		MilestonesURL:    Ptr(api + "/milestones{/number}"),
+		NotificationURL:  Ptr(api + "/notifications{?since,all,participating}"),
+		LabelsURL:        Ptr(api + "/labels{/name}"),
+		ReleasesURL:      Ptr(api + "/releases{/id}"),
+		DeploymentsURL:   Ptr(api + "/deployments"),
+		CreatedAt:        NewTimeWrapper(b.at),
+		UpdatedAt:        NewTimeWrapper(b.at),
+		PushedAt:         NewTimeWrapper(b.at),
+		GitURL:           Ptr("git://github.com/" + fullName + ".git"),
+		SSHURL:           Ptr("git@github.com:" + fullName + ".git"),
+		CloneURL:         Ptr(html + ".git"),
+		SvnURL:           Ptr(html),
+		Size:             Ptr(0),
+		StargazersCount:  Ptr(0),
+		WatchersCount:    Ptr(0),
+		HasIssues:        Ptr(true),
+		HasProjects:      Ptr(true),
+		HasDownloads:     Ptr(true),
+		HasWiki:          Ptr(true),
+		HasPages:         Ptr(false),
+		ForksCount:       Ptr(0),
+		Archived:         Ptr(false),
+		Disabled:         Ptr(false),
+		OpenIssuesCount:  Ptr(0),
+		Forks:            Ptr(0),
+		OpenIssues:       Ptr(0),
+		Watchers:         Ptr(0),
+		DefaultBranch:    Ptr(b.defaultBranch),
+	}
+	if b.description != "" {
+		r.Description = Ptr(b.description)
+	}
+	if b.language != "" {
+		r.Languages = Ptr(b.language)
+	}
+	return r
+}
+
+// newLabel returns a label in repo. Github only exposes the color, so make one up
+func newLabel(repo *Repository, name string) Label {
+	id := stableID(repo.GetFullName() + "#label:" + name)
+	return Label{
+		ID:      Ptr(id),
+		NodeID:  Ptr(nodeID("Label", id)),
+		URL:     Ptr(repo.GetURL() + "/labels/" + url.PathEscape(name)),
+		Name:    Ptr(name),
+		Color:   Ptr(fakeSHA(name)[:6]),
+		Default: Ptr(false),
+	}
+}
+
+//
+// PushEvent
+//
+
+type PushEventBuilder struct {
+	repo         *Repository
+	ref          string
+	before       string
+	created      bool
+	deleted      bool
+	forced       bool
+	baseRef      string
+	sender       *Account
+	installation int
+	commits      []Commit
+	at           time.Time
+}
+
+// NewPushEventBuilder returns a builder for a push to the default branch of repo,
+// by the repo owner
+func NewPushEventBuilder(repo *Repository) *PushEventBuilder {
+	return &PushEventBuilder{
+		repo:   repo,
+		ref:    "refs/heads/" + repo.GetDefaultBranch(),
+		before: fakeSHA(repo.GetFullName(), "before"),
+		sender: repo.GetOwner(),
+		at:     builderTime,
+	}
+}
+
+// Ref sets the full ref pushed to, e.g. "refs/heads/master"
+func (b *PushEventBuilder) Ref(ref string) *PushEventBuilder {
+	b.ref = ref
+	return b
+}
+
+func (b *PushEventBuilder) Branch(branch string) *PushEventBuilder {
+	return b.Ref("refs/heads/" + branch)
+}
+
+func (b *PushEventBuilder) Tag(tag string) *PushEventBuilder {
+	return b.Ref("refs/tags/" + tag)
+}
+
+func (b *PushEventBuilder) Before(sha string) *PushEventBuilder {
+	b.before = sha
+	return b
+}
+
+// Created makes this the push that created the ref (i.e. "before" is all zeros)
+func (b *PushEventBuilder) Created() *PushEventBuilder {
+	b.created = true
+	b.before = zeroSHA
+	return b
+}
+
+// Deleted makes this the push that deleted the ref (i.e. "after" is all zeros)
+func (b *PushEventBuilder) Deleted() *PushEventBuilder {
+	b.deleted = true
+	return b
+}
+
+func (b *PushEventBuilder) Forced() *PushEventBuilder {
+	b.forced = true
+	return b
+}
+
+func (b *PushEventBuilder) BaseRef(ref string) *PushEventBuilder {
+	b.baseRef = ref
+	return b
+}
+
+// Sender sets the account that pushed (the pusher is derived from it)
+func (b *PushEventBuilder) Sender(sender *Account) *PushEventBuilder {
+	b.sender = sender
+	return b
+}
+
+// Installation adds an installation with the given ID, as Github does for Github Apps
+func (b *PushEventBuilder) Installation(id int) *PushEventBuilder {
+	b.installation = id
+	return b
+}
+
+func (b *PushEventBuilder) At(t time.Time) *PushEventBuilder {
+	b.at = t
+	return b
+}
+
+// Commit adds a commit by the sender, on top of the previous one
+func (b *PushEventBuilder) Commit(message string, added, removed, modified []string) *PushEventBuilder {
+	parent := b.before
+	if len(b.commits) > 0 {
+		parent = *b.commits[len(b.commits)-1].ID
+	}
+	sha := fakeSHA(parent, message)
+	author := &Account{
+		Name:     Ptr(b.sender.GetLogin()),
+		Email:    Ptr(noreplyEmail(b.sender)),
+		Username: Ptr(b.sender.GetLogin()),
+	}
+	committer := *author
+	b.commits = append(b.commits, Commit{
+		ID:        Ptr(sha),
+		TreeID:    Ptr(fakeSHA(sha, "tree")),
+		Distinct:  Ptr(true),
+		Message:   Ptr(message),
+		Timestamp: NewTimeWrapper(b.at.Add(time.Duration(len(b.commits)) * time.Minute)),
+		URL:       Ptr(b.repo.GetHTMLURL() + "/commit/" + sha),
+		Author:    author,
+		Committer: &committer,
+		Added:     append([]string{}, added...),
+		Removed:   append([]string{}, removed...),
+		Modified:  append([]string{}, modified...),
+	})
+	return b
+}
+
+func (b *PushEventBuilder) Build() *PushEvent {
+	after := b.before
+	if len(b.commits) > 0 {
+		after = *b.commits[len(b.commits)-1].ID
+	} else if b.created {
+		// A new ref (e.g. a tag) pointing at an existing commit
+		after = fakeSHA(b.repo.GetFullName(), b.ref)
+	}
+	if b.deleted {
+		after = zeroSHA
+	}
+	e := &PushEvent{
+		Ref:        Ptr(b.ref),
+		Before:     Ptr(b.before),
+		After:      Ptr(after),
+		Created:    Ptr(b.created),
+		Deleted:    Ptr(b.deleted),
+		Forced:     Ptr(b.forced),
+		Compare:    Ptr(fmt.Sprintf("%s/compare/%s...%s", b.repo.GetHTMLURL(), shortSHA(b.before), shortSHA(after))),
+		Commits:    append([]Commit{}, b.commits...),
+		Repository: b.repo,
+		Pusher:     &EmailUser{Name: Ptr(b.sender.GetLogin()), Email: Ptr(noreplyEmail(b.sender))},
+		Sender:     b.sender,
+	}
+	if b.baseRef != "" {
+		e.BaseRef = Ptr(b.baseRef)
+	}
+	if len(b.commits) > 0 && !b.deleted {
+		head := b.commits[len(b.commits)-1]
+		e.HeadCommit = &head
+	}
+	if b.repo.GetOwner().GetType() == "Organization" {
+		e.Organization = b.repo.GetOwner()
+	}
+	if b.installation != 0 {
+		e.Installation = newInstallationRef(b.installation)
+	}
+	return e
+}
+
+// newInstallationRef returns the short installation object Github includes in events
+func newInstallationRef(id int) *Installation {
+	return &Installation{ID: Ptr(id), NodeID: Ptr(nodeID("IntegrationInstallation", id))}
+}
+
+//
+// IssuesEvent
+//
+
+type IssuesEventBuilder struct {
+	action       string
+	repo         *Repository
+	number       int
+	title        string
+	body         string
+	state        string
+	labels       []string
+	label        string
+	assignee     *Account
+	sender       *Account
+	installation int
+	at           time.Time
+}
+
+// NewIssuesEventBuilder returns a builder for an "issues" event with the given action
+// (e.g. "opened") on issue number in repo, sent by the repo owner
+func NewIssuesEventBuilder(action string, repo *Repository, number int) *IssuesEventBuilder {
+	state := "open"
+	if action == "closed" {
+		state = "closed"
+	}
+	return &IssuesEventBuilder{
+		action: action,
+		repo:   repo,
+		number: number,
+		title:  fmt.Sprintf("Issue %d", number),
+		state:  state,
+		sender: repo.GetOwner(),
+		at:     builderTime,
+	}
+}
+
+func (b *IssuesEventBuilder) Title(title string) *IssuesEventBuilder {
+	b.title = title
+	return b
+}
+
+func (b *IssuesEventBuilder) Body(body string) *IssuesEventBuilder {
+	b.body = body
+	return b
+}
+
+func (b *IssuesEventBuilder) State(state string) *IssuesEventBuilder {
+	b.state = state
+	return b
+}
+
+// Labels sets the labels already on the issue
+func (b *IssuesEventBuilder) Labels(names ...string) *IssuesEventBuilder {
+	b.labels = append(b.labels, names...)
+	return b
+}
+
+// Label sets the label of a "labeled" or "unlabeled" event. For "labeled" the label
+// is also added to the issue
+func (b *IssuesEventBuilder) Label(name string) *IssuesEventBuilder {
+	b.label = name
+	return b
+}
+
+func (b *IssuesEventBuilder) Assignee(assignee *Account) *IssuesEventBuilder {
+	b.assignee = assignee
+	return b
+}
+
+func (b *IssuesEventBuilder) Sender(sender *Account) *IssuesEventBuilder {
+	b.sender = sender
+	return b
+}
+
+func (b *IssuesEventBuilder) Installation(id int) *IssuesEventBuilder {
+	b.installation = id
+	return b
+}
+
+func (b *IssuesEventBuilder) At(t time.Time) *IssuesEventBuilder {
+	b.at = t
+	return b
+}
+
+func (b *IssuesEventBuilder) Build() *IssuesEvent {
+	api := fmt.Sprintf("%s/issues/%d", b.repo.GetURL(), b.number)
+	id := stableID(fmt.Sprintf("%s#%d", b.repo.GetFullName(), b.number))
+	issue := &Issue{
+		ID:                Ptr(id),
+		NodeID:            Ptr(nodeID("Issue", id)),
+		URL:               Ptr(api),
+		RepositoryURL:     Ptr(b.repo.GetURL()),
+		LabelsURL:         Ptr(api + "/labels{/name}"),
+		CommentsURL:       Ptr(api + "/comments"),
+		EventsURL:         Ptr(api + "/events"),
+		HTMLURL:           Ptr(fmt.Sprintf("%s/issues/%d", b.repo.GetHTMLURL(), b.number)),
+		Number:            Ptr(b.number),
+		State:             Ptr(b.state),
+		Title:             Ptr(b.title),
+		User:              b.repo.GetOwner(),
+		Labels:            []Label{},
+		Assignees:         []Account{},
+		Locked:            Ptr(false),
+		Comments:          Ptr(0),
+		CreatedAt:         NewTimeWrapper(b.at),
+		UpdatedAt:         NewTimeWrapper(b.at),
+		AuthorAssociation: Ptr("OWNER"),
+	}
+	if b.body != "" {
+		issue.Body = Ptr(b.body)
+	}
+	if b.state == "closed" {
+		issue.ClosedAt = NewTimeWrapper(b.at)
+	}
+	for _, name := range b.labels {
+		issue.Labels = append(issue.Labels, newLabel(b.repo, name))
+	}
+	if b.assignee != nil {
+		issue.Assignee = b.assignee
+		issue.Assignees = append(issue.Assignees, *b.assignee)
+	}
+	e := &IssuesEvent{
+		Action:     Ptr(b.action),
+		Issue:      issue,
+		Repository: b.repo,
+		Sender:     b.sender,
+	}
+	if b.label != "" {
+		label := newLabel(b.repo, b.label)
+		e.Label = &label
+		if b.action == "labeled" {
+			issue.Labels = append(issue.Labels, label)
+		}
+	}
+	if b.assignee != nil && (b.action == "assigned" || b.action == "unassigned") {
+		e.Assignee = b.assignee
+	}
+	if b.repo.GetOwner().GetType() == "Organization" {
+		e.Organization = b.repo.GetOwner()
+	}
+	if b.installation != 0 {
+		e.Installation = newInstallationRef(b.installation)
+	}
+	return e
+}
+
+//
+// PullRequestEvent
+//
+
+type PullRequestEventBuilder struct {
+	action       string
+	repo         *Repository
+	number       int
+	title        string
+	body         string
+	headRef      string
+	headSHA      string
+	baseRef      string
+	draft        bool
+	merged       bool
+	labels       []string
+	label        string
+	sender       *Account
+	installation int
+	at           time.Time
+}
+
+// NewPullRequestEventBuilder returns a builder for a "pull_request" event with the
+// given action (e.g. "opened") on pull request number in repo, from a branch in the
+// same repo into its default branch, sent by the repo owner
+func NewPullRequestEventBuilder(action string, repo *Repository, number int) *PullRequestEventBuilder {
+	head := fmt.Sprintf("pr-%d", number)
+	return &PullRequestEventBuilder{
+		action:  action,
+		repo:    repo,
+		number:  number,
+		title:   fmt.Sprintf("Pull request %d", number),
+		headRef: head,
+		headSHA: fakeSHA(repo.GetFullName(), head),
+		baseRef: repo.GetDefaultBranch(),
+		sender:  repo.GetOwner(),
+		at:      builderTime,
+	}
+}
+
+func (b *PullRequestEventBuilder) Title(title string) *PullRequestEventBuilder {
+	b.title = title
+	return b
+}
+
+func (b *PullRequestEventBuilder) Body(body string) *PullRequestEventBuilder {
+	b.body = body
+	return b
+}
+
+// Head sets the branch, and the SHA of its tip, that the pull request is from
+func (b *PullRequestEventBuilder) Head(ref, sha string) *PullRequestEventBuilder {
+	b.headRef = ref
+	b.headSHA = sha
+	return b
+}
+
+// Base sets the branch the pull request is into
+func (b *PullRequestEventBuilder) Base(ref string) *PullRequestEventBuilder {
+	b.baseRef = ref
+	return b
+}
+
+func (b *PullRequestEventBuilder) Draft() *PullRequestEventBuilder {
+	b.draft = true
+	return b
+}
+
+// Merged marks the pull request as merged (use with the "closed" action)
+func (b *PullRequestEventBuilder) Merged() *PullRequestEventBuilder {
+	b.merged = true
+	return b
+}
+
+func (b *PullRequestEventBuilder) Labels(names ...string) *PullRequestEventBuilder {
+	b.labels = append(b.labels, names...)
+	return b
+}
+
+// Label sets the label of a "labeled" or "unlabeled" event. For "labeled" the label
+// is also added to the pull request
+func (b *PullRequestEventBuilder) Label(name string) *PullRequestEventBuilder {
+	b.label = name
+	return b
+}
+
+func (b *PullRequestEventBuilder) Sender(sender *Account) *PullRequestEventBuilder {
+	b.sender = sender
+	return b
+}
+
+func (b *PullRequestEventBuilder) Installation(id int) *PullRequestEventBuilder {
+	b.installation = id
+	return b
+}
+
+func (b *PullRequestEventBuilder) At(t time.Time) *PullRequestEventBuilder {
+	b.at = t
+	return b
+}
+
+func (b *PullRequestEventBuilder) Build() *PullRequestEvent {
+	api := fmt.Sprintf("%s/pulls/%d", b.repo.GetURL(), b.number)
+	html := fmt.Sprintf("%s/pull/%d", b.repo.GetHTMLURL(), b.number)
+	issueAPI := fmt.Sprintf("%s/issues/%d", b.repo.GetURL(), b.number)
+	statuses := b.repo.GetURL() + "/statuses/" + b.headSHA
+	id := stableID(fmt.Sprintf("%s#%d", b.repo.GetFullName(), b.number))
+	owner := b.repo.GetOwner()
+	state := "open"
+	if b.action == "closed" {
+		state = "closed"
+	}
+	pr := &PullRequest{
+		URL:               Ptr(api),
+		ID:                Ptr(id),
+		NodeID:            Ptr(nodeID("PullRequest", id)),
+		HTMLURL:           Ptr(html),
+		DiffURL:           Ptr(html + ".diff"),
+		PatchURL:          Ptr(html + ".patch"),
+		IssueURL:          Ptr(issueAPI),
+		CommitsURL:        Ptr(api + "/commits"),
+		ReviewCommentsURL: Ptr(api + "/comments"),
+		ReviewCommentURL:  Ptr(b.repo.GetURL() + "/pulls/comments{/number}"),
+		CommentsURL:       Ptr(issueAPI + "/comments"),
+		StatusesURL:       Ptr(statuses),
+		Number:            Ptr(b.number),
+		State:             Ptr(state),
+		Locked:            Ptr(false),
+		Title:             Ptr(b.title),
+		User:              b.sender,
+		Labels:            []Label{},
+		CreatedAt:         NewTimeWrapper(b.at),
+		UpdatedAt:         NewTimeWrapper(b.at),
+		Assignees:         []Account{},
+		Head: &PullRequestBranch{
+			Label: Ptr(owner.GetLogin() + ":" + b.headRef),
+			Ref:   Ptr(b.headRef),
+			SHA:   Ptr(b.headSHA),
+			User:  owner,
+			Repo:  b.repo,
+		},
+		Base: &PullRequestBranch{
+			Label: Ptr(owner.GetLogin() + ":" + b.baseRef),
+			Ref:   Ptr(b.baseRef),
+			SHA:   Ptr(fakeSHA(b.repo.GetFullName(), b.baseRef)),
+			User:  owner,
+			Repo:  b.repo,
+		},
+		Links: &PullRequestLinks{
+			Self:           &Link{Href: Ptr(api)},
+			HTML:           &Link{Href: Ptr(html)},
+			Issue:          &Link{Href: Ptr(issueAPI)},
+			Comments:       &Link{Href: Ptr(issueAPI + "/comments")},
+			ReviewComments: &Link{Href: Ptr(api + "/comments")},
+			ReviewComment:  &Link{Href: Ptr(b.repo.GetURL() + "/pulls/comments{/number}")},
+			Commits:        &Link{Href: Ptr(api + "/commits")},
+			Statuses:       &Link{Href: Ptr(statuses)},
+		},
+		AuthorAssociation: Ptr("OWNER"),
+		Draft:             Ptr(b.draft),
+		Merged:            Ptr(b.merged),
+		Comments:          Ptr(0),
+		ReviewComments:    Ptr(0),
+		Commits:           Ptr(1),
+		Additions:         Ptr(1),
+		Deletions:         Ptr(1),
+		ChangedFiles:      Ptr(1),
+	}
+	if b.body != "" {
+		pr.Body = Ptr(b.body)
+	}
+	if state == "closed" {
+		pr.ClosedAt = NewTimeWrapper(b.at)
+	}
+	if b.merged {
+		pr.MergedAt = NewTimeWrapper(b.at)
+		pr.MergedBy = b.sender
+		pr.MergeCommitSHA = Ptr(fakeSHA(b.headSHA, "merge"))
+	}
+	for _, name := range b.labels {
+		pr.Labels = append(pr.Labels, newLabel(b.repo, name))
+	}
+	e := &PullRequestEvent{
+		Action:      Ptr(b.action),
+		Number:      Ptr(b.number),
+		PullRequest: pr,
+		Repository:  b.repo,
+		Sender:      b.sender,
+	}
+	if b.label != "" {
+		label := newLabel(b.repo, b.label)
+		e.Label = &label
+		if b.action == "labeled" {
+			pr.Labels = append(pr.Labels, label)
+		}
+	}
+	if owner.GetType() == "Organization" {
+		e.Organization = owner
+	}
+	if b.installation != 0 {
+		e.Installation = newInstallationRef(b.installation)
+	}
+	return e
+}
@@ -0,0 +1,165 @@
+package ghevent
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestPtr(t *testing.T) {
+	s := Ptr("hello")
+	if *s != "hello" {
+		t.Errorf("*Ptr(\"hello\") was \"%s\"", *s)
+	}
+	n := 42
+	i := Ptr(n)
+	*i = 43
+	if n != 42 {
+		t.Error("Ptr() didn't return a pointer to a copy")
+	}
+}
+
+func TestAccountBuilder(t *testing.T) {
+	a := NewAccountBuilder("octocat").Build()
+	b := NewAccountBuilder("octocat").Build()
+	if !reflect.DeepEqual(a, b) {
+		t.Error("building the same account twice gave different results")
+	}
+	a = NewAccountBuilder("octocat").ID(583231).Build()
+	if a.GetNodeID() != "MDQ6VXNlcjU4MzIzMQ==" {
+		t.Errorf("node_id was \"%s\" (should have been \"MDQ6VXNlcjU4MzIzMQ==\")", a.GetNodeID())
+	}
+	if a.GetURL() != "https://api.github.com/users/octocat" || a.GetHTMLURL() != "https://github.com/octocat" {
+		t.Errorf("url/html_url were \"%s\"/\"%s\"", a.GetURL(), a.GetHTMLURL())
+	}
+	bot := NewAccountBuilder("dependabot").Bot().Build()
+	if bot.GetLogin() != "dependabot[bot]" || bot.GetType() != "Bot" {
+		t.Errorf("bot login/type were \"%s\"/\"%s\"", bot.GetLogin(), bot.GetType())
+	}
+	org := NewAccountBuilder("Octocoders").Organization().Build()
+	nodeID, _ := base64.StdEncoding.DecodeString(org.GetNodeID())
+	if !strings.HasPrefix(string(nodeID), "012:Organization") {
+		t.Errorf("organization node_id decoded to \"%s\"", nodeID)
+	}
+}
+
+func TestRepositoryBuilder(t *testing.T) {
+	owner := NewAccountBuilder("Codertocat").Build()
+	repo := NewRepositoryBuilder(owner, "Hello-World").DefaultBranch("main").Private().Build()
+	if repo.GetFullName() != "Codertocat/Hello-World" {
+		t.Errorf("full_name was \"%s\"", repo.GetFullName())
+	}
+	if repo.GetCommitsURL() != "https://api.github.com/repos/Codertocat/Hello-World/commits{/sha}" {
+		t.Errorf("commits_url was \"%s\"", repo.GetCommitsURL())
+	}
+	if repo.GetOwner() != owner || !repo.GetPrivate() || repo.GetDefaultBranch() != "main" {
+		t.Error("owner, private or default_branch wasn't set")
+	}
+}
+
+func TestPushEventBuilder(t *testing.T) {
+	owner := NewAccountBuilder("Codertocat").Build()
+	repo := NewRepositoryBuilder(owner, "Hello-World").Build()
+	e := NewPushEventBuilder(repo).
+		Branch("feature").
+		Commit("First", []string{"a.go"}, nil, nil).
+		Commit("Second", nil, []string{"b.go"}, []string{"a.go"}).
+		Installation(2311213).
+		Build()
+	if e.GetRef() != "refs/heads/feature" {
+		t.Errorf("ref was \"%s\"", e.GetRef())
+	}
+	if len(e.Commits) != 2 {
+		t.Fatalf("len(commits) was %d (should have been 2)", len(e.Commits))
+	}
+	if e.GetAfter() != e.Commits[1].GetID() || e.GetHeadCommit().GetID() != e.GetAfter() {
+		t.Error("after and head_commit.id didn't match the last commit")
+	}
+	if e.GetBefore() == e.Commits[0].GetID() || e.Commits[0].GetID() == e.Commits[1].GetID() {
+		t.Error("commit SHAs weren't distinct")
+	}
+	if !strings.HasPrefix(e.Commits[0].GetURL(), repo.GetHTMLURL()+"/commit/") {
+		t.Errorf("commits[0].url was \"%s\"", e.Commits[0].GetURL())
+	}
+	if e.GetPusher().GetName() != "Codertocat" || e.Commits[0].GetAuthor().GetUsername() != "Codertocat" {
+		t.Error("pusher or commit author didn't match the sender")
+	}
+	if e.GetInstallation().GetID() != 2311213 {
+		t.Errorf("installation.id was %d", e.GetInstallation().GetID())
+	}
+	deleted := NewPushEventBuilder(repo).Tag("v1.0").Deleted().Build()
+	if deleted.GetAfter() != zeroSHA || deleted.HeadCommit != nil {
+		t.Error("deleting push had an after SHA or head commit")
+	}
+}
+
+func TestIssuesEventBuilder(t *testing.T) {
+	owner := NewAccountBuilder("Codertocat").Build()
+	repo := NewRepositoryBuilder(owner, "Hello-World").Build()
+	e := NewIssuesEventBuilder("labeled", repo, 7).Labels("bug").Label("sev1").Build()
+	if e.GetIssue().GetURL() != repo.GetURL()+"/issues/7" || e.GetIssue().GetRepositoryURL() != repo.GetURL() {
+		t.Errorf("issue url was \"%s\"", e.GetIssue().GetURL())
+	}
+	if e.GetLabel().GetName() != "sev1" || len(e.GetIssue().GetLabels()) != 2 {
+		t.Errorf("label was \"%s\" and issue had %d labels", e.GetLabel().GetName(), len(e.GetIssue().GetLabels()))
+	}
+	if e.GetIssue().GetLabels()[1].GetID() != e.GetLabel().GetID() {
+		t.Error("the event label and the added issue label had different IDs")
+	}
+	closed := NewIssuesEventBuilder("closed", repo, 7).Build()
+	if closed.GetIssue().GetState() != "closed" || closed.GetIssue().ClosedAt == nil {
+		t.Error("closed issue wasn't closed")
+	}
+	if closed.GetIssue().GetID() != e.GetIssue().GetID() {
+		t.Error("the same issue got different IDs")
+	}
+}
+
+func TestPullRequestEventBuilder(t *testing.T) {
+	org := NewAccountBuilder("Octocoders").Organization().Build()
+	repo := NewRepositoryBuilder(org, "Hello-World").Build()
+	e := NewPullRequestEventBuilder("closed", repo, 2).Head("changes", "ec26c3e57ca3a959ca5aad62de7213c562f8c821").Merged().Build()
+	pr := e.GetPullRequest()
+	if pr.GetNumber() != e.GetNumber() || pr.GetState() != "closed" || !pr.GetMerged() {
+		t.Error("number, state or merged was wrong")
+	}
+	if pr.GetHead().GetRef() != "changes" || pr.GetBase().GetRef() != "master" {
+		t.Errorf("head/base were \"%s\"/\"%s\"", pr.GetHead().GetRef(), pr.GetBase().GetRef())
+	}
+	if pr.GetStatusesURL() != repo.GetURL()+"/statuses/ec26c3e57ca3a959ca5aad62de7213c562f8c821" {
+		t.Errorf("statuses_url was \"%s\"", pr.GetStatusesURL())
+	}
+	if e.GetOrganization() != org {
+		t.Error("organization wasn't set for an organization owned repository")
+	}
+}
+
+// Built events should survive the same round trip as the fixtures do
+func TestBuiltEventsRoundTrip(t *testing.T) {
+	owner := NewAccountBuilder("Codertocat").Build()
+	repo := NewRepositoryBuilder(owner, "Hello-World").Build()
+	events := map[string]interface{}{
+		"push":         NewPushEventBuilder(repo).Commit("Test commit", nil, nil, []string{"README.md"}).Build(),
+		"issues":       NewIssuesEventBuilder("opened", repo, 1).Body("It's broken").Build(),
+		"pull_request": NewPullRequestEventBuilder("opened", repo, 2).Labels("enhancement").Build(),
+	}
+	for eventType, event := range events {
+		payload, err := json.Marshal(event)
+		if err != nil {
+			t.Fatal(err)
+		}
+		decoded, err := ParseWebHook(eventType, payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		again, err := json.Marshal(decoded)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(payload) != string(again) {
+			t.Errorf("%s event changed in round trip:\n%s\n%s", eventType, payload, again)
+		}
+	}
+}
@@ -0,0 +1,390 @@
+package ghevent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+//
+// A minimal REST API client, for the endpoints whose responses are modelled here.
+// Authentication is up to the http.Client, e.g. one using an AppTransport or
+// InstallationTransport.
+//
+// - List methods follow "Link: <...>; rel="next"" headers, and return all pages
+// - GET responses with an ETag are cached, and requested again with If-None-Match.
+//   A 304 Not Modified response (which doesn't count against the rate limit) gives
+//   the cached data
+// - When the rate limit is exhausted, or a response has Retry-After, requests wait
+//   (at most MaxWait) and retry. Longer waits give a *RateLimitError
+//
+
+const (
+	DefaultMaxWait    = time.Minute
+	DefaultMaxRetries = 3
+	defaultPerPage    = 100
+	userAgent         = "ghevent"
+)
+
+// Client is usable as a struct literal, but without NewClient's MaxWait and
+// MaxRetries rate limited requests fail rather than wait
+type Client struct {
+	BaseURL    string        // Defaults to DefaultBaseURL
+	HTTPClient *http.Client  // Defaults to http.DefaultClient
+	MaxWait    time.Duration // The longest to wait for a rate limit to reset
+	MaxRetries int           // How many times to retry a rate limited request
+
+	now   func() time.Time                                 // time.Now if nil
+	sleep func(ctx context.Context, d time.Duration) error // sleepContext if nil
+
+	mu    sync.Mutex
+	rate  Rate
+	cache map[string]cachedResponse // URL -> last response with an ETag
+}
+
+// Rate is the rate limit status, from the last X-RateLimit-* headers
+type Rate struct {
+	Limit     int
+	Remaining int
+	Reset     time.Time
+}
+
+type cachedResponse struct {
+	etag   string
+	body   []byte
+	header http.Header
+}
+
+// APIError is an error response from the API
+type APIError struct {
+	StatusCode       int
+	Message          string `json:"message"`
+	DocumentationURL string `json:"documentation_url"`
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("github: %d %s", e.StatusCode, e.Message)
+}
+
+// RateLimitError is returned when a request is rate limited for longer than MaxWait
+type RateLimitError struct {
+	Rate       Rate
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("github: rate limited for %v", e.RetryAfter.Round(time.Second))
+}
+
+// NewClient returns a client using httpClient (http.DefaultClient if nil)
+func NewClient(httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &Client{
+		BaseURL:    DefaultBaseURL,
+		HTTPClient: httpClient,
+		MaxWait:    DefaultMaxWait,
+		MaxRetries: DefaultMaxRetries,
+		now:        time.Now,
+		sleep:      sleepContext,
+		cache:      map[string]cachedResponse{},
+	}
+}
+
+func (c *Client) httpClient() *http.Client {
+	if c.HTTPClient == nil {
+		return http.DefaultClient
+	}
+	return c.HTTPClient
+}
+
+func (c *Client) currentTime() time.Time {
+	if c.now == nil {
+		return time.Now()
+	}
+	return c.now()
+}
+
+func (c *Client) pause(ctx context.Context, d time.Duration) error {
+	if c.sleep == nil {
+		return sleepContext(ctx, d)
+	}
+	return c.sleep(ctx, d)
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// Rate returns the rate limit status as of the last response
+func (c *Client) Rate() Rate {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.rate
+}
+
+//
+// Endpoints
+//
+
+// ListUserInstallations returns the installations the authenticated user can access
+// (needs a user token)
+func (c *Client) ListUserInstallations(ctx context.Context) ([]Installation, error) {
+	return listAll(ctx, c, "/user/installations", nil, func(data []byte) ([]Installation, error) {
+		resp := UserInstallationsResponse{}
+		err := json.Unmarshal(data, &resp)
+		return resp.Installations, err
+	})
+}
+
+// ListAppInstallations returns the installations of the app (needs an AppTransport)
+func (c *Client) ListAppInstallations(ctx context.Context) ([]Installation, error) {
+	return listAll(ctx, c, "/app/installations", nil, decodeArray[Installation])
+}
+
+// ListInstallationRepositories returns the repositories the installation can access
+// (needs an InstallationTransport)
+func (c *Client) ListInstallationRepositories(ctx context.Context) ([]Repository, error) {
+	return listAll(ctx, c, "/installation/repositories", nil, func(data []byte) ([]Repository, error) {
+		resp := InstallationRepositoriesResponse{}
+		err := json.Unmarshal(data, &resp)
+		return resp.Repositories, err
+	})
+}
+
+func (c *Client) GetRepository(ctx context.Context, owner, repo string) (*Repository, error) {
+	r := &Repository{}
+	return r, c.getJSON(ctx, repoPath(owner, repo), r)
+}
+
+// ListIssues lists the issues (and pull requests, which are issues too) of a
+// repository. params are query parameters like "state" or "labels"
+func (c *Client) ListIssues(ctx context.Context, owner, repo string, params url.Values) ([]Issue, error) {
+	return listAll(ctx, c, repoPath(owner, repo)+"/issues", params, decodeArray[Issue])
+}
+
+func (c *Client) GetIssue(ctx context.Context, owner, repo string, number int) (*Issue, error) {
+	issue := &Issue{}
+	return issue, c.getJSON(ctx, fmt.Sprintf("%s/issues/%d", repoPath(owner, repo), number), issue)
+}
+
+func (c *Client) ListIssueComments(ctx context.Context, owner, repo string, number int) ([]IssueComment, error) {
+	return listAll(ctx, c, fmt.Sprintf("%s/issues/%d/comments", repoPath(owner, repo), number), nil, decodeArray[IssueComment])
+}
+
+// ListPullRequests lists the pull requests of a repository. params are query
+// parameters like "state" or "base"
+func (c *Client) ListPullRequests(ctx context.Context, owner, repo string, params url.Values) ([]PullRequest, error) {
+	return listAll(ctx, c, repoPath(owner, repo)+"/pulls", params, decodeArray[PullRequest])
+}
+
+func (c *Client) GetPullRequest(ctx context.Context, owner, repo string, number int) (*PullRequest, error) {
+	pr := &PullRequest{}
+	return pr, c.getJSON(ctx, fmt.Sprintf("%s/pulls/%d", repoPath(owner, repo), number), pr)
+}
+
+func repoPath(owner, repo string) string {
+	return "/repos/" + url.PathEscape(owner) + "/" + url.PathEscape(repo)
+}
+
+func decodeArray[T any](data []byte) ([]T, error) {
+	items := []T{}
+	err := json.Unmarshal(data, &items)
+	return items, err
+}
+
+//
+// Requests
+//
+
+func (c *Client) url(path string, params url.Values) string {
+	baseURL := c.BaseURL
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+	u := strings.TrimSuffix(baseURL, "/") + path
+	if len(params) > 0 {
+		u += "?" + params.Encode()
+	}
+	return u
+}
+
+func (c *Client) getJSON(ctx context.Context, path string, v interface{}) error {
+	body, _, err := c.get(ctx, c.url(path, nil))
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(body, v)
+}
+
+// listAll gets all pages of a list endpoint
+func listAll[T any](ctx context.Context, c *Client, path string, params url.Values, decode func([]byte) ([]T, error)) ([]T, error) {
+	query := url.Values{}
+	for name, values := range params {
+		query[name] = values
+	}
+	if query.Get("per_page") == "" {
+		query.Set("per_page", strconv.Itoa(defaultPerPage))
+	}
+	all := []T{}
+	next := c.url(path, query)
+	for next != "" {
+		body, header, err := c.get(ctx, next)
+		if err != nil {
+			return nil, err
+		}
+		items, err := decode(body)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, items...)
+		next = nextPageURL(header.Get("Link"))
+	}
+	return all, nil
+}
+
+var linkNextRE = regexp.MustCompile(`<([^>]+)>\s*;\s*rel="?next"?`)
+
+func nextPageURL(link string) string {
+	if m := linkNextRE.FindStringSubmatch(link); m != nil {
+		return m[1]
+	}
+	return ""
+}
+
+// get does a GET request, waiting for and retrying on rate limits, and using the
+// ETag cache
+func (c *Client) get(ctx context.Context, u string) ([]byte, http.Header, error) {
+	waited := false
+	for attempt := 0; ; attempt++ {
+		if !waited {
+			if err := c.waitForRateLimit(ctx); err != nil {
+				return nil, nil, err
+			}
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		req.Header.Set("Accept", "application/vnd.github+json")
+		req.Header.Set("User-Agent", userAgent)
+		c.mu.Lock()
+		cached, isCached := c.cache[u]
+		c.mu.Unlock()
+		if isCached {
+			req.Header.Set("If-None-Match", cached.etag)
+		}
+		resp, err := c.httpClient().Do(req)
+		if err != nil {
+			return nil, nil, err
+		}
+		body, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, nil, err
+		}
+		c.updateRate(resp.Header)
+
+		switch {
+		case resp.StatusCode == http.StatusNotModified && isCached:
+			// The cached headers, updated with the ones sent again (like X-Poll-Interval)
+			header := cached.header.Clone()
+			for name, values := range resp.Header {
+				header[name] = values
+			}
+			return cached.body, header, nil
+		case resp.StatusCode >= 200 && resp.StatusCode <= 299:
+			if etag := resp.Header.Get("ETag"); etag != "" {
+				c.mu.Lock()
+				if c.cache == nil {
+					c.cache = map[string]cachedResponse{}
+				}
+				c.cache[u] = cachedResponse{etag: etag, body: body, header: resp.Header}
+				c.mu.Unlock()
+			}
+			return body, resp.Header, nil
+		}
+
+		if wait, limited := c.rateLimitWait(resp); limited {
+			if wait > c.MaxWait || attempt >= c.MaxRetries {
+				return nil, nil, &RateLimitError{Rate: c.Rate(), RetryAfter: wait}
+			}
+			if err := c.pause(ctx, wait); err != nil {
+				return nil, nil, err
+			}
+			waited = true
+			continue
+		}
+		apiErr := &APIError{StatusCode: resp.StatusCode}
+		if json.Unmarshal(body, apiErr) != nil || apiErr.Message == "" {
+			apiErr.Message = http.StatusText(resp.StatusCode)
+		}
+		return nil, nil, apiErr
+	}
+}
+
+func (c *Client) updateRate(header http.Header) {
+	remaining, err := strconv.Atoi(header.Get("X-Ratelimit-Remaining"))
+	if err != nil {
+		return
+	}
+	rate := Rate{Remaining: remaining}
+	rate.Limit, _ = strconv.Atoi(header.Get("X-Ratelimit-Limit"))
+	if reset, err := strconv.ParseInt(header.Get("X-Ratelimit-Reset"), 10, 64); err == nil {
+		rate.Reset = time.Unix(reset, 0)
+	}
+	c.mu.Lock()
+	c.rate = rate
+	c.mu.Unlock()
+}
+
+// waitForRateLimit waits for the rate limit to reset, if it is exhausted
+func (c *Client) waitForRateLimit(ctx context.Context) error {
+	rate := c.Rate()
+	if rate.Limit == 0 || rate.Remaining > 0 {
+		return nil
+	}
+	wait := rate.Reset.Sub(c.currentTime())
+	if wait <= 0 {
+		return nil
+	}
+	if wait > c.MaxWait {
+		return &RateLimitError{Rate: rate, RetryAfter: wait}
+	}
+	return c.pause(ctx, wait)
+}
+
+// rateLimitWait tells if a response is a (primary or secondary) rate limit error,
+// and how long to wait before retrying
+func (c *Client) rateLimitWait(resp *http.Response) (time.Duration, bool) {
+	if resp.StatusCode != http.StatusForbidden && resp.StatusCode != http.StatusTooManyRequests {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if resp.Header.Get("X-Ratelimit-Remaining") == "0" {
+		wait := c.Rate().Reset.Sub(c.currentTime())
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
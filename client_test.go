@@ -0,0 +1,229 @@
+package ghevent
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeAPI serves numbered issues 1-250, pages of per_page, with Link headers and
+// ETags. Other endpoints are set up per test
+type fakeAPI struct {
+	mu       sync.Mutex
+	requests []string
+	handlers map[string]http.HandlerFunc
+}
+
+func (api *fakeAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	api.mu.Lock()
+	api.requests = append(api.requests, r.URL.RequestURI())
+	handler := api.handlers[r.URL.Path]
+	api.mu.Unlock()
+	if handler == nil {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message":"Not Found","documentation_url":"https://docs.github.com/rest"}`)
+		return
+	}
+	handler(w, r)
+}
+
+func (api *fakeAPI) requestCount() int {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	return len(api.requests)
+}
+
+func newTestClient(t *testing.T, api *fakeAPI) (*Client, *[]time.Duration) {
+	server := httptest.NewServer(api)
+	t.Cleanup(server.Close)
+	client := NewClient(server.Client())
+	client.BaseURL = server.URL
+	slept := &[]time.Duration{}
+	client.now = func() time.Time { return builderTime }
+	client.sleep = func(ctx context.Context, d time.Duration) error {
+		*slept = append(*slept, d)
+		return nil
+	}
+	return client, slept
+}
+
+func issuesHandler(w http.ResponseWriter, r *http.Request) {
+	perPage, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	if page == 0 {
+		page = 1
+	}
+	etag := fmt.Sprintf(`"issues-%d-%d"`, perPage, page)
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	first, last := (page-1)*perPage+1, page*perPage
+	if last >= 250 {
+		last = 250
+	} else {
+		next := *r.URL
+		q := next.Query()
+		q.Set("page", strconv.Itoa(page+1))
+		next.RawQuery = q.Encode()
+		w.Header().Set("Link", fmt.Sprintf(`<http://%s%s>; rel="next", <http://%s/last>; rel="last"`, r.Host, next.RequestURI(), r.Host))
+	}
+	w.Header().Set("ETag", etag)
+	items := []string{}
+	for n := first; n <= last; n++ {
+		items = append(items, fmt.Sprintf(`{"number":%d,"state":"%s"}`, n, r.URL.Query().Get("state")))
+	}
+	fmt.Fprintf(w, "[%s]", strings.Join(items, ","))
+}
+
+func TestClientPagination(t *testing.T) {
+	api := &fakeAPI{handlers: map[string]http.HandlerFunc{
+		"/repos/Codertocat/Hello-World/issues": issuesHandler,
+		"/installation/repositories": func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, `{"total_count":1,"repository_selection":"selected","repositories":[{"full_name":"Codertocat/Hello-World"}]}`)
+		},
+		"/repos/Codertocat/Hello-World/pulls/2": func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, `{"number":2,"base":{"ref":"master"}}`)
+		},
+	}}
+	client, _ := newTestClient(t, api)
+	ctx := context.Background()
+
+	issues, err := client.ListIssues(ctx, "Codertocat", "Hello-World", url.Values{"state": {"open"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(issues) != 250 || issues[249].GetNumber() != 250 || issues[0].GetState() != "open" {
+		t.Errorf("got %d issues", len(issues))
+	}
+	if n := api.requestCount(); n != 3 {
+		t.Errorf("listing took %d requests (should be 3)", n)
+	}
+
+	// The same again is served from the cache, with conditional requests
+	if issues, err = client.ListIssues(ctx, "Codertocat", "Hello-World", url.Values{"state": {"open"}}); err != nil || len(issues) != 250 {
+		t.Errorf("got %d issues from the cache: %v", len(issues), err)
+	}
+	if n := api.requestCount(); n != 6 {
+		t.Errorf("listing again took %d requests (should be 3 more)", n)
+	}
+
+	repos, err := client.ListInstallationRepositories(ctx)
+	if err != nil || len(repos) != 1 || repos[0].GetFullName() != "Codertocat/Hello-World" {
+		t.Errorf("got repositories %v: %v", repos, err)
+	}
+	pr, err := client.GetPullRequest(ctx, "Codertocat", "Hello-World", 2)
+	if err != nil || pr.GetBase().GetRef() != "master" {
+		t.Errorf("got pull request %+v: %v", pr, err)
+	}
+
+	_, err = client.GetIssue(ctx, "Codertocat", "Hello-World", 1)
+	if apiErr, ok := err.(*APIError); !ok || apiErr.StatusCode != 404 || apiErr.Message != "Not Found" {
+		t.Errorf("getting a missing issue returned %v", err)
+	}
+}
+
+// A Client struct literal works, with the defaults NewClient would set
+func TestClientLiteral(t *testing.T) {
+	api := &fakeAPI{handlers: map[string]http.HandlerFunc{
+		"/repos/Codertocat/Hello-World/issues": issuesHandler,
+		"/repos/Codertocat/Hello-World": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("X-RateLimit-Limit", "5000")
+			w.Header().Set("X-RateLimit-Remaining", "0")
+			w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10))
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusForbidden)
+		},
+	}}
+	server := httptest.NewServer(api)
+	defer server.Close()
+	client := &Client{BaseURL: server.URL}
+	for i := 0; i < 2; i++ {
+		issues, err := client.ListIssues(context.Background(), "Codertocat", "Hello-World", nil)
+		if err != nil || len(issues) != 250 {
+			t.Fatalf("got %d issues: %v", len(issues), err)
+		}
+	}
+	if n := api.requestCount(); n != 6 {
+		t.Errorf("listing twice took %d requests (should be 6)", n)
+	}
+
+	// Without MaxWait and MaxRetries, rate limits are errors right away
+	for i := 0; i < 2; i++ {
+		_, err := client.GetRepository(context.Background(), "Codertocat", "Hello-World")
+		if _, ok := err.(*RateLimitError); !ok {
+			t.Errorf("rate limited request returned %v (should be a *RateLimitError)", err)
+		}
+	}
+}
+
+func TestClientRateLimits(t *testing.T) {
+	reset := strconv.FormatInt(builderTime.Add(30*time.Second).Unix(), 10)
+	calls := 0
+	api := &fakeAPI{handlers: map[string]http.HandlerFunc{
+		"/repos/Codertocat/Hello-World": func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			switch calls {
+			case 1: // Secondary rate limit
+				w.Header().Set("Retry-After", "5")
+				w.WriteHeader(http.StatusForbidden)
+			case 2: // Primary rate limit
+				w.Header().Set("X-RateLimit-Limit", "5000")
+				w.Header().Set("X-RateLimit-Remaining", "0")
+				w.Header().Set("X-RateLimit-Reset", reset)
+				w.WriteHeader(http.StatusForbidden)
+			default:
+				w.Header().Set("X-RateLimit-Limit", "5000")
+				w.Header().Set("X-RateLimit-Remaining", "0")
+				w.Header().Set("X-RateLimit-Reset", reset)
+				fmt.Fprint(w, `{"full_name":"Codertocat/Hello-World"}`)
+			}
+		},
+	}}
+	client, slept := newTestClient(t, api)
+	ctx := context.Background()
+
+	repo, err := client.GetRepository(ctx, "Codertocat", "Hello-World")
+	if err != nil || repo.GetFullName() != "Codertocat/Hello-World" {
+		t.Fatalf("got %+v: %v", repo, err)
+	}
+	if want := []time.Duration{5 * time.Second, 30 * time.Second}; fmt.Sprint(*slept) != fmt.Sprint(want) {
+		t.Errorf("slept %v (should have slept %v)", *slept, want)
+	}
+	if rate := client.Rate(); rate.Limit != 5000 || rate.Remaining != 0 {
+		t.Errorf("rate is %+v", rate)
+	}
+
+	// With the limit exhausted, the next request waits for the reset first...
+	*slept = nil
+	if _, err := client.GetRepository(ctx, "Codertocat", "Hello-World"); err != nil || len(*slept) != 1 {
+		t.Errorf("got %v after sleeping %v", err, *slept)
+	}
+	// ...unless that is too long
+	client.MaxWait = 10 * time.Second
+	_, err = client.GetRepository(ctx, "Codertocat", "Hello-World")
+	if rateErr, ok := err.(*RateLimitError); !ok || rateErr.RetryAfter != 30*time.Second {
+		t.Errorf("waiting too long returned %v", err)
+	}
+}
+
+func TestNextPageURL(t *testing.T) {
+	tests := map[string]string{
+		`<https://api.github.com/x?page=2>; rel="next", <https://api.github.com/x?page=5>; rel="last"`: "https://api.github.com/x?page=2",
+		`<https://api.github.com/x?page=1>; rel="prev", <https://api.github.com/x?page=3>; rel="next"`: "https://api.github.com/x?page=3",
+		`<https://api.github.com/x?page=1>; rel="first"`:                                               "",
+		``: "",
+	}
+	for link, want := range tests {
+		if got := nextPageURL(link); got != want {
+			t.Errorf("nextPageURL(%s) returned %s (should be %s)", link, got, want)
+		}
+	}
+}
@@ -0,0 +1,350 @@
+// Command ghevent verifies, decodes and inspects captured Github webhook deliveries,
+// e.g. ones copied from the "Recent Deliveries" page of a webhook (see
+// ghevent.ReadDelivery for the format). Deliveries are read from a file or, if no
+// file is given, from stdin.
+//
+//	ghevent verify --secret <secret> [file]   Check the X-Hub-Signature-256 header
+//	ghevent decode [--event <name>] [--json] [file]
+//	                                         Summarize the event, and list fields the
+//	                                         ghevent types don't model
+//	ghevent fields [--event <name>] [file]   List present, null and absent fields
+//	ghevent match [--event <name>] <expression> [file]
+//	                                         Tell if the event matches an expression
+//	                                         (see ghevent.CompileExpr)
+//	ghevent replay --url <url> [--secret <secret>] [--rate <per second>]
+//	               [--order time|reverse] [--event <names>] [--if <expression>]
+//	               <directory or .jsonl file>
+//	                                         Re-send stored deliveries, re-signed
+//	                                         (see ghevent.Replay)
+//
+// The exit status is 0 on success, 1 if a signature doesn't match, an expression is
+// false or a replayed delivery failed, and 2 on other errors.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	ghevent "github.com/ragnarlonn/github-events"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+const usage = `usage:
+  ghevent verify --secret <secret> [file]
+  ghevent decode [--event <name>] [--json] [file]
+  ghevent fields [--event <name>] [file]
+  ghevent match [--event <name>] <expression> [file]
+  ghevent replay --url <url> [--secret <secret>] [--rate <per second>]
+                 [--order time|reverse] [--event <names>] [--if <expression>]
+                 <directory or .jsonl file>
+`
+
+// errFalse makes run exit with status 1, without printing anything
+var errFalse = errors.New("false")
+
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, usage)
+		return 2
+	}
+	commands := map[string]func(args []string, stdin io.Reader, stdout io.Writer) error{
+		"verify": verify,
+		"decode": decode,
+		"fields": fields,
+		"match":  match,
+		"replay": replay,
+	}
+	command, ok := commands[args[0]]
+	if !ok {
+		fmt.Fprintf(stderr, "ghevent: unknown command \"%s\"\n%s", args[0], usage)
+		return 2
+	}
+	err := command(args[1:], stdin, stdout)
+	switch {
+	case err == nil:
+		return 0
+	case err == errFalse:
+		return 1
+	case errors.Is(err, flag.ErrHelp):
+		fmt.Fprint(stderr, usage)
+		return 2
+	}
+	fmt.Fprintf(stderr, "ghevent %s: %v\n", args[0], err)
+	var f failure
+	if errors.As(err, &f) {
+		return 1
+	}
+	return 2
+}
+
+// failure makes run exit with status 1
+type failure struct {
+	error
+}
+
+func newFlagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	return fs
+}
+
+// readDelivery reads the delivery from the file given, or stdin
+func readDelivery(args []string, stdin io.Reader, eventType string) (*ghevent.Delivery, error) {
+	r := stdin
+	switch len(args) {
+	case 0:
+	case 1:
+		f, err := os.Open(args[0])
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		r = f
+	default:
+		return nil, fmt.Errorf("too many arguments: %s", strings.Join(args, " "))
+	}
+	d, err := ghevent.ReadDelivery(r)
+	if err != nil {
+		return nil, err
+	}
+	if eventType != "" {
+		d.Header.Set(ghevent.EventHeader, eventType)
+	}
+	return d, nil
+}
+
+func verify(args []string, stdin io.Reader, stdout io.Writer) error {
+	fs := newFlagSet("verify")
+	secret := fs.String("secret", os.Getenv("GITHUB_WEBHOOK_SECRET"), "webhook secret (default $GITHUB_WEBHOOK_SECRET)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *secret == "" {
+		return errors.New("no secret given (use --secret or set GITHUB_WEBHOOK_SECRET)")
+	}
+	d, err := readDelivery(fs.Args(), stdin, "")
+	if err != nil {
+		return err
+	}
+	if err := d.Verify([]byte(*secret)); err != nil {
+		return failure{err}
+	}
+	header := ghevent.SignatureHeader
+	if d.Header.Get(header) == "" {
+		header = ghevent.SignatureSHA1Header
+	}
+	fmt.Fprintf(stdout, "%s OK\n", header)
+	return nil
+}
+
+func decode(args []string, stdin io.Reader, stdout io.Writer) error {
+	fs := newFlagSet("decode")
+	eventType := fs.String("event", "", "X-Github-Event name (default from the headers, or guessed from the payload)")
+	asJSON := fs.Bool("json", false, "print the decoded event as JSON instead of a summary")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	d, err := readDelivery(fs.Args(), stdin, *eventType)
+	if err != nil {
+		return err
+	}
+	event, err := d.Decode()
+	if err != nil {
+		return err
+	}
+	if *asJSON {
+		enc := json.NewEncoder(stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(event)
+	}
+	for _, line := range summarize(d, event) {
+		fmt.Fprintf(stdout, "%-14s %s\n", line[0]+":", line[1])
+	}
+	unmodelled, err := ghevent.UnmodelledFields(d.Body, event)
+	if err != nil {
+		return err
+	}
+	if len(unmodelled) > 0 {
+		fmt.Fprintf(stdout, "\nUnmodelled fields (%d):\n", len(unmodelled))
+		for _, path := range unmodelled {
+			fmt.Fprintf(stdout, "  %s\n", path)
+		}
+	}
+	return nil
+}
+
+func fields(args []string, stdin io.Reader, stdout io.Writer) error {
+	fs := newFlagSet("fields")
+	eventType := fs.String("event", "", "X-Github-Event name (default from the headers, or guessed from the payload)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	d, err := readDelivery(fs.Args(), stdin, *eventType)
+	if err != nil {
+		return err
+	}
+	event, err := d.Decode()
+	if err != nil {
+		return err
+	}
+	presence, err := ghevent.NewPresence(d.Body)
+	if err != nil {
+		return err
+	}
+	absent, err := ghevent.AbsentFields(d.Body, event)
+	if err != nil {
+		return err
+	}
+	status := map[string]string{}
+	for _, path := range presence.Fields() {
+		status[path] = "present"
+		if presence.IsNull(path) {
+			status[path] = "null"
+		}
+	}
+	for _, path := range absent {
+		status[path] = "absent"
+	}
+	for _, path := range sortedKeys(status) {
+		fmt.Fprintf(stdout, "%-8s %s\n", status[path], path)
+	}
+	return nil
+}
+
+func match(args []string, stdin io.Reader, stdout io.Writer) error {
+	fs := newFlagSet("match")
+	eventType := fs.String("event", "", "X-Github-Event name (default from the headers, or guessed from the payload)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() == 0 {
+		return errors.New("no expression given")
+	}
+	d, err := readDelivery(fs.Args()[1:], stdin, *eventType)
+	if err != nil {
+		return err
+	}
+	event, err := d.Decode()
+	if err != nil {
+		return err
+	}
+	expr, err := ghevent.CompileExpr(fs.Arg(0), d.EventType())
+	if err != nil {
+		return err
+	}
+	ok, err := expr.Eval(d.EventType(), event)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(stdout, ok)
+	if !ok {
+		return errFalse
+	}
+	return nil
+}
+
+func replay(args []string, stdin io.Reader, stdout io.Writer) error {
+	fs := newFlagSet("replay")
+	url := fs.String("url", "", "endpoint to POST the deliveries to")
+	secret := fs.String("secret", os.Getenv("GITHUB_WEBHOOK_SECRET"), "webhook secret to sign with (default $GITHUB_WEBHOOK_SECRET)")
+	rate := fs.Float64("rate", 0, "deliveries per second (default no limit)")
+	order := fs.String("order", "", "\"time\" (oldest first) or \"reverse\" (default as read)")
+	events := fs.String("event", "", "comma separated X-Github-Event names to replay (default all)")
+	condition := fs.String("if", "", "only replay deliveries matching this expression")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *url == "" {
+		return errors.New("no --url given")
+	}
+	if fs.NArg() != 1 {
+		return errors.New("give one directory or .jsonl file of deliveries")
+	}
+	deliveries, err := ghevent.ReadDeliveries(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	var eventTypes []string
+	if *events != "" {
+		eventTypes = strings.Split(*events, ",")
+	}
+	var expr *ghevent.Expr
+	if *condition != "" {
+		if expr, err = ghevent.CompileExpr(*condition, eventTypes...); err != nil {
+			return err
+		}
+	}
+	var filterErr error
+	filter := func(d *ghevent.Delivery) bool {
+		if len(eventTypes) > 0 && !containsString(eventTypes, d.EventType()) {
+			return false
+		}
+		if expr == nil {
+			return true
+		}
+		event, err := d.Decode()
+		if err == nil {
+			var ok bool
+			if ok, err = expr.Eval(d.EventType(), event); err == nil {
+				return ok
+			}
+		}
+		if filterErr == nil {
+			filterErr = fmt.Errorf("delivery %s: %v", d.GUID(), err)
+		}
+		return false
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	failed := 0
+	_, err = ghevent.Replay(ctx, *url, deliveries, ghevent.ReplayOptions{
+		Secret: []byte(*secret),
+		Rate:   *rate,
+		Order:  ghevent.ReplayOrder(*order),
+		Filter: filter,
+		Result: func(res ghevent.ReplayResult) {
+			status := fmt.Sprint(res.Status)
+			if res.Err != nil {
+				failed++
+				if res.Status == 0 {
+					status = "ERR"
+				}
+			}
+			line := fmt.Sprintf("%-3s %-26s %-36s %v", status, res.Delivery.EventType(), res.Delivery.GUID(), res.Duration.Round(time.Millisecond))
+			if res.Err != nil && res.Status == 0 {
+				line += " " + res.Err.Error()
+			}
+			fmt.Fprintln(stdout, line)
+		},
+	})
+	switch {
+	case err != nil:
+		return err
+	case filterErr != nil:
+		return filterErr
+	case failed > 0:
+		return failure{fmt.Errorf("%d deliveries failed", failed)}
+	}
+	return nil
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	ghevent "github.com/ragnarlonn/github-events"
+)
+
+func readFixture(t *testing.T, name string) []byte {
+	t.Helper()
+	data, err := ioutil.ReadFile("../../testdata/" + name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func runCommand(stdin string, args ...string) (int, string, string) {
+	var stdout, stderr bytes.Buffer
+	status := run(args, strings.NewReader(stdin), &stdout, &stderr)
+	return status, stdout.String(), stderr.String()
+}
+
+func TestCommands(t *testing.T) {
+	payload := readFixture(t, "issues/labeled.json")
+	signed := fmt.Sprintf("Request method: POST\nX-GitHub-Event: issues\nX-GitHub-Delivery: 72d3162e\nX-Hub-Signature-256: %s\n\n%s",
+		ghevent.SignPayload(payload, []byte("s3cret")), payload)
+	withExtra := bytes.Replace(payload, []byte(`"action": "labeled",`), []byte(`"action": "labeled", "shiny_new_field": {"a": 1},`), 1)
+	tests := []struct {
+		name   string
+		stdin  string
+		args   []string
+		status int
+		output []string // Substrings of stdout, or of stderr if status != 0
+	}{
+		{"verify", signed, []string{"verify", "--secret", "s3cret"}, 0, []string{"X-Hub-Signature-256 OK"}},
+		{"verify wrong secret", signed, []string{"verify", "--secret", "wrong"}, 1, []string{"doesn't match"}},
+		{"verify unsigned", string(payload), []string{"verify", "--secret", "s3cret"}, 1, []string{"not signed"}},
+		{"decode", signed, []string{"decode"}, 0, []string{"issues (labeled)", "72d3162e", "Codertocat/Hello-World", "label:"}},
+		{"decode guessed type", string(payload), []string{"decode"}, 0, []string{"issues (labeled)"}},
+		{"decode unmodelled", string(withExtra), []string{"decode"}, 0, []string{"Unmodelled fields (1):\n  shiny_new_field\n"}},
+		{"decode json", signed, []string{"decode", "--json"}, 0, []string{`"action": "labeled"`}},
+		{"decode wrong type", `{"foo": 1}`, []string{"decode"}, 2, []string{"can't tell the event type"}},
+		{"fields", signed, []string{"fields"}, 0, []string{"present  issue.number\n", "absent   issue.pull_request\n", "null     issue.closed_at\n"}},
+		{"match", signed, []string{"match", `action == "labeled" && label.name != null`}, 0, []string{"true"}},
+		{"no match", signed, []string{"match", `action == "opened"`}, 1, []string{"false"}},
+		{"bad expression", signed, []string{"match", `actoin == "opened"`}, 2, []string{"doesn't exist in issues"}},
+		{"unknown command", signed, []string{"frobnicate"}, 2, []string{"unknown command"}},
+	}
+	for _, test := range tests {
+		status, stdout, stderr := runCommand(test.stdin, test.args...)
+		if status != test.status {
+			t.Errorf("%s: exit status %d (should be %d), stderr: %s", test.name, status, test.status, stderr)
+			continue
+		}
+		output := stdout
+		if status != 0 && stderr != "" {
+			output = stderr
+		}
+		for _, want := range test.output {
+			if !strings.Contains(output, want) {
+				t.Errorf("%s: output doesn't contain %q:\n%s", test.name, want, output)
+			}
+		}
+	}
+}
+
+func TestReplayCommand(t *testing.T) {
+	received := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		if ghevent.VerifySignature(body, r.Header.Get(ghevent.SignatureHeader), []byte("s3cret")) != nil {
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+		received = append(received, r.Header.Get(ghevent.DeliveryHeader))
+	}))
+	defer server.Close()
+	dir := t.TempDir()
+	for i, name := range []string{"push/branch.json", "issues/labeled.json", "issues/opened.json"} {
+		content := fmt.Sprintf("X-GitHub-Event: %s\nX-GitHub-Delivery: guid-%d\n\n%s", strings.Split(name, "/")[0], i, readFixture(t, name))
+		if err := ioutil.WriteFile(filepath.Join(dir, fmt.Sprintf("%d.txt", i)), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	status, stdout, stderr := runCommand("", "replay", "--url", server.URL, "--secret", "s3cret", "--event", "issues", "--if", `action == "labeled"`, dir)
+	if status != 0 || !reflect.DeepEqual(received, []string{"guid-1"}) || !strings.HasPrefix(stdout, "200 issues") {
+		t.Errorf("replay exited with %d and sent %v: %s%s", status, received, stdout, stderr)
+	}
+	received = nil
+	status, stdout, _ = runCommand("", "replay", "--url", server.URL, "--secret", "wrong", dir)
+	if status != 1 || len(received) != 3 || !strings.Contains(stdout, "401 push") {
+		t.Errorf("replay with wrong secret exited with %d and sent %v: %s", status, received, stdout)
+	}
+	if status, _, stderr = runCommand("", "replay", dir); status != 2 || !strings.Contains(stderr, "--url") {
+		t.Errorf("replay without --url exited with %d: %s", status, stderr)
+	}
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	ghevent "github.com/ragnarlonn/github-events"
+)
+
+// summarize returns label/value pairs describing a decoded event: the fields most
+// events have, followed by the ones specific to the event type
+func summarize(d *ghevent.Delivery, event interface{}) [][2]string {
+	lines := [][2]string{}
+	add := func(label, format string, args ...interface{}) {
+		lines = append(lines, [2]string{label, fmt.Sprintf(format, args...)})
+	}
+	eventType := d.EventType()
+	if e, ok := event.(interface{ GetAction() string }); ok && e.GetAction() != "" {
+		add("event", "%s (%s)", eventType, e.GetAction())
+	} else {
+		add("event", "%s", eventType)
+	}
+	if d.GUID() != "" {
+		add("delivery", "%s", d.GUID())
+	}
+	if e, ok := event.(interface{ GetRepository() *ghevent.Repository }); ok && e.GetRepository() != nil {
+		add("repository", "%s", e.GetRepository().GetFullName())
+	}
+	if e, ok := event.(interface{ GetSender() *ghevent.Account }); ok && e.GetSender() != nil {
+		add("sender", "%s (%s)", e.GetSender().GetLogin(), e.GetSender().GetType())
+	}
+	if e, ok := event.(interface{ GetInstallation() *ghevent.Installation }); ok && e.GetInstallation() != nil {
+		add("installation", "%d", e.GetInstallation().GetID())
+	}
+
+	switch e := event.(type) {
+	case *ghevent.PushEvent:
+		add("ref", "%s", e.GetRef())
+		switch {
+		case e.IsDeletion():
+			add("change", "deleted (was %s)", shortSHA(e.GetBefore()))
+		case e.IsCreation():
+			add("change", "created at %s", shortSHA(e.GetAfter()))
+		case e.IsForcePush():
+			add("change", "%s...%s (forced)", shortSHA(e.GetBefore()), shortSHA(e.GetAfter()))
+		default:
+			add("change", "%s..%s", shortSHA(e.GetBefore()), shortSHA(e.GetAfter()))
+		}
+		add("commits", "%d", len(e.GetCommits()))
+		files := e.ChangedFiles()
+		add("files", "%d added, %d modified, %d removed", len(files.Added), len(files.Modified), len(files.Removed))
+	case *ghevent.ForkEvent:
+		add("forkee", "%s", e.GetForkee().GetFullName())
+	case *ghevent.IssuesEvent:
+		add("issue", "#%d %q (%s)", e.GetIssue().GetNumber(), e.GetIssue().GetTitle(), e.GetIssue().GetState())
+		if e.GetLabel() != nil {
+			add("label", "%s", e.GetLabel().GetName())
+		}
+		if e.GetAssignee() != nil {
+			add("assignee", "%s", e.GetAssignee().GetLogin())
+		}
+	case *ghevent.IssueCommentEvent:
+		add("issue", "#%d %q (%s)", e.GetIssue().GetNumber(), e.GetIssue().GetTitle(), e.GetIssue().GetState())
+		add("comment", "%d by %s", e.GetComment().GetID(), e.GetComment().GetUser().GetLogin())
+	case *ghevent.LabelEvent:
+		add("label", "%s", e.GetLabel().GetName())
+	case *ghevent.MilestoneEvent:
+		add("milestone", "%d %q (%s)", e.GetMilestone().GetNumber(), e.GetMilestone().GetTitle(), e.GetMilestone().GetState())
+	case *ghevent.PullRequestEvent:
+		pr := e.GetPullRequest()
+		add("pull request", "#%d %q (%s)", pr.GetNumber(), pr.GetTitle(), pr.GetState())
+		add("branches", "%s <- %s", pr.GetBase().GetRef(), pr.GetHead().GetRef())
+		if e.GetLabel() != nil {
+			add("label", "%s", e.GetLabel().GetName())
+		}
+		if e.GetRequestedReviewer() != nil {
+			add("reviewer", "%s", e.GetRequestedReviewer().GetLogin())
+		}
+	case *ghevent.PingEvent:
+		add("hook", "%d (%s) %s", e.GetHookID(), e.GetHook().GetType(), e.GetHook().GetConfig().GetURL())
+		add("events", "%s", strings.Join(e.GetHook().GetEvents(), ", "))
+	case *ghevent.InstallationEvent:
+		add("account", "%s (%s)", e.GetInstallation().GetAccount().GetLogin(), e.GetInstallation().GetAccount().GetType())
+		add("app", "%s (%d)", e.GetInstallation().GetAppSlug(), e.GetInstallation().GetAppID())
+		add("repositories", "%s", repositoryNames(e.GetRepositories()))
+	case *ghevent.InstallationRepositoriesEvent:
+		add("selection", "%s", e.GetRepositorySelection())
+		add("added", "%s", repositoryNames(e.GetRepositoriesAdded()))
+		add("removed", "%s", repositoryNames(e.GetRepositoriesRemoved()))
+	}
+	return lines
+}
+
+func shortSHA(sha string) string {
+	if len(sha) > 7 {
+		return sha[:7]
+	}
+	return sha
+}
+
+func repositoryNames(repos []ghevent.Repository) string {
+	if len(repos) == 0 {
+		return "-"
+	}
+	names := []string{}
+	for _, repo := range repos {
+		names = append(names, repo.GetFullName())
+	}
+	return strings.Join(names, ", ")
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,207 @@
+package ghevent
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+// The decoder gives the same result as encoding/json for every fixture, also when
+// reading a byte at a time
+func TestDecoderFixtures(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("testdata", "*", "*.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range files {
+		eventType := filepath.Base(filepath.Dir(file))
+		payload, err := ioutil.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, err := ParseWebHook(eventType, payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, r := range []io.Reader{bytes.NewReader(payload), iotest.OneByteReader(bytes.NewReader(payload))} {
+			got, err := DecodeWebHook(eventType, r, DecodeOptions{})
+			if err != nil {
+				t.Errorf("%s: %v", file, err)
+				continue
+			}
+			if !reflect.DeepEqual(got, want) {
+				gotJSON, _ := json.Marshal(got)
+				wantJSON, _ := json.Marshal(want)
+				t.Errorf("%s decoded as\n%s\n(should be\n%s)", file, gotJSON, wantJSON)
+			}
+		}
+	}
+}
+
+func TestDecoderSkipFields(t *testing.T) {
+	payload, err := ioutil.ReadFile("testdata/push/branch.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	event, err := DecodeWebHook("push", bytes.NewReader(payload), DecodeOptions{SkipFields: append(URLFields, "node_id")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	push := event.(*PushEvent)
+	if push.GetRepository().GetFullName() == "" || push.GetSender().GetLogin() == "" {
+		t.Errorf("skipping URLs lost the other fields")
+	}
+	if push.Repository.URL != nil || push.Repository.HTMLURL != nil || push.Sender.AvatarURL != nil || push.Sender.NodeID != nil {
+		t.Errorf("skipped fields were decoded")
+	}
+}
+
+func TestDecoder(t *testing.T) {
+	tests := []struct {
+		json string
+		want string // Re-encoded, or the error
+	}{
+		{`{"Login": "octo\"cat", "id": -12, "site_admin": true, "name": null}`, `{"login":"octo\"cat","id":-12,"site_admin":true}`},
+		{`{"login": "é😀\n", "unknown": {"a": [1, "}", {"b": null}]}}`, `{"login":"é😀\n"}`},
+		{` {"id": 1} {"id": 2} `, `{"id":1}`},
+		{`{"id": "1"}`, "decoding at offset 7: can't decode string into int"},
+		{`{"login": "x" "id": 1}`, "decoding at offset 14: unexpected '\"' (expected ',' or '}')"},
+		{`{"login": "x`, "decoding at offset 12: unterminated string"},
+		{`{"site_admin": tru}`, "decoding at offset 18: unexpected '}' (expected true)"},
+		{``, "EOF"},
+	}
+	for _, test := range tests {
+		account := &Account{}
+		got := ""
+		if err := NewDecoder(strings.NewReader(test.json), DecodeOptions{}).Decode(account); err != nil {
+			got = err.Error()
+		} else {
+			data, _ := json.Marshal(account)
+			got = string(data)
+		}
+		if got != test.want {
+			t.Errorf("decoding %s gave %s (should be %s)", test.json, got, test.want)
+		}
+	}
+
+	// A stream of values
+	decoder := NewDecoder(strings.NewReader(`{"id": 1} {"id": 2}`), DecodeOptions{})
+	ids := []int{}
+	for {
+		account := &Account{}
+		if err := decoder.Decode(account); err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, account.GetID())
+	}
+	if fmt.Sprint(ids) != "[1 2]" {
+		t.Errorf("decoded IDs %v from a stream (should be [1 2])", ids)
+	}
+}
+
+// bigPushPayload returns testdata/push/branch.json with n (different) commits
+func bigPushPayload(b *testing.B, n int) []byte {
+	payload, err := ioutil.ReadFile("testdata/push/branch.json")
+	if err != nil {
+		b.Fatal(err)
+	}
+	push := &PushEvent{}
+	if err := json.Unmarshal(payload, push); err != nil {
+		b.Fatal(err)
+	}
+	for i := len(push.Commits); i < n; i++ {
+		commit := *push.HeadCommit
+		id := fmt.Sprintf("%040x", i)
+		commit.ID, commit.TreeID = &id, &id
+		commit.Message = Ptr(fmt.Sprintf("Change %d", i))
+		commit.URL = Ptr("https://github.com/Codertocat/Hello-World/commit/" + id)
+		commit.Added = []string{fmt.Sprintf("src/file%d.go", i)}
+		push.Commits = append(push.Commits, commit)
+	}
+	if payload, err = json.Marshal(push); err != nil {
+		b.Fatal(err)
+	}
+	return payload
+}
+
+func BenchmarkDecodePush(b *testing.B) {
+	payload := bigPushPayload(b, 300)
+	b.Run("encoding/json", func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetBytes(int64(len(payload)))
+		for i := 0; i < b.N; i++ {
+			if _, err := ParseWebHook("push", payload); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("Decoder", func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetBytes(int64(len(payload)))
+		for i := 0; i < b.N; i++ {
+			if _, err := DecodeWebHook("push", bytes.NewReader(payload), DecodeOptions{}); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("Decoder skipping URLs", func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetBytes(int64(len(payload)))
+		for i := 0; i < b.N; i++ {
+			if _, err := DecodeWebHook("push", bytes.NewReader(payload), DecodeOptions{SkipFields: URLFields}); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+// Strings, numbers and skipped values that encoding/json replaces or rejects are
+// replaced or rejected the same way
+func TestDecoderLikeEncodingJSON(t *testing.T) {
+	type value struct {
+		S *string           `json:"s,omitempty"`
+		I *int              `json:"i,omitempty"`
+		F *float64          `json:"f,omitempty"`
+		L []string          `json:"l,omitempty"`
+		M map[string]string `json:"m,omitempty"`
+	}
+	tests := []string{
+		"{\"s\": \"a\xffb\"}", "{\"s\": \"\xff\xfe\"}", "{\"s\": \"\xe2\x82\"}", "{\"s\": \"caf\xc3\xa9 \xc3\"}",
+		"{\"s\": \"a\\u00e9\xffb\"}", `{"s": "\ud800"}`, `{"s": "\ud83d\ude00"}`, "{\"s\": \"a\x01b\"}", "{\"s\": \"a\tb\"}",
+		"{\"l\": [\"\xff\", \"ok\"], \"m\": {\"k\": \"\xc0\"}}", "{\"x\": \"\xff\", \"s\": \"ok\"}", "{\"x\": \"\n\"}",
+		`{"x": "\q"}`, `{"s": "\q"}`, "{\"m\": {\"\xff\": \"v\"}}",
+		`{"i": 7}`, `{"i": 0}`, `{"i": -0}`, `{"i": 007}`, `{"i": -01}`, `{"i": 1.}`, `{"i": +1}`,
+		`{"f": 1.5e3}`, `{"f": 0.5}`, `{"f": .5}`, `{"f": 01.5}`, `{"f": 1e}`, `{"f": 1e+}`, `{"f": 1E-2}`,
+		`{"f": 1.e2}`, `{"f": --1}`, `{"f": 1-2}`, `{"x": 007, "s": "ok"}`, `{"x": [1, 2.5e1, -0.0]}`,
+		`{"x": [}}`, `{"x": {1 2}}`, `{"x": {"a" "b"}}`, `{"x": {"a": 1,}}`, `{"x": [1,]}`, `{"x": [1 2]}`,
+		`{"x": {"a": [1, {"b": null}], "c": {}}, "s": "ok"}`, `{"x": []}`, `{"x": ]}`, `{"x": [:]}`,
+	}
+	for _, test := range tests {
+		want, got := &value{}, &value{}
+		wantErr := json.Unmarshal([]byte(test), want)
+		gotErr := NewDecoder(strings.NewReader(test), DecodeOptions{}).Decode(got)
+		if (gotErr != nil) != (wantErr != nil) {
+			t.Errorf("decoding %q gave error %v (should be %v, like encoding/json)", test, gotErr, wantErr)
+		} else if wantErr == nil && !reflect.DeepEqual(got, want) {
+			t.Errorf("decoding %q gave %+v (should be %+v, like encoding/json)", test, got, want)
+		}
+	}
+
+	// Skipped fields are checked the same
+	for _, test := range []string{`{"zen":"x","hook":[}}`, `{"hook":{1 2}}`, `{"hook":{"a" "b"}}`, `{"hook":{"a":[1,{}]},"zen":"x"}`} {
+		wantErr := json.Unmarshal([]byte(test), &PingEvent{})
+		gotErr := NewDecoder(strings.NewReader(test), DecodeOptions{SkipFields: []string{"hook"}}).Decode(&PingEvent{})
+		if (gotErr != nil) != (wantErr != nil) {
+			t.Errorf("decoding %s skipping hook gave error %v (should be %v, like encoding/json)", test, gotErr, wantErr)
+		}
+	}
+}
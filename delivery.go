@@ -0,0 +1,210 @@
+package ghevent
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+)
+
+//
+// A Delivery is a webhook request as sent by Github: the X-Github-* headers plus the
+// JSON payload. ReadDelivery reads one in the format you get when copying headers
+// and payload from the "Recent Deliveries" page of a webhook or app:
+//
+//   Request method: POST
+//   Accept: */*
+//   content-type: application/json
+//   X-GitHub-Delivery: 72d3162e-cc78-11e3-81ab-4c9367dc0958
+//   X-GitHub-Event: issues
+//   X-Hub-Signature-256: sha256=d57c68ca6f92289e6987922ff26938930f6e66a2d161ef06abdf1859230aa23c
+//
+//   {
+//     "action": "opened",
+//     ...
+//   }
+//
+// Lines before the payload that aren't "Name: value" headers are ignored. The
+// payload may also be given on its own, without any headers.
+//
+
+const (
+	EventHeader         = "X-Github-Event"
+	DeliveryHeader      = "X-Github-Delivery"
+	SignatureHeader     = "X-Hub-Signature-256"
+	SignatureSHA1Header = "X-Hub-Signature"
+)
+
+var ErrNoSignature = errors.New("delivery is not signed")
+
+type Delivery struct {
+	Header      http.Header
+	Body        []byte
+	DeliveredAt time.Time // When Github sent it, if known
+}
+
+// ReadDelivery reads headers (if any), an empty line, and the JSON payload
+func ReadDelivery(r io.Reader) (*Delivery, error) {
+	br := bufio.NewReader(r)
+	d := &Delivery{Header: http.Header{}}
+	for {
+		peek, err := br.Peek(1)
+		if err == io.EOF {
+			return nil, errors.New("delivery has no payload")
+		}
+		if err != nil {
+			return nil, err
+		}
+		if peek[0] == '{' {
+			break
+		}
+		line, err := br.ReadString('\n')
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+		if name, value, ok := strings.Cut(strings.TrimSpace(line), ":"); ok && !strings.ContainsAny(name, " \t") {
+			d.Header.Add(name, strings.TrimSpace(value))
+		}
+		if err == io.EOF {
+			return nil, errors.New("delivery has no payload")
+		}
+	}
+	body, err := ioutil.ReadAll(br)
+	if err != nil {
+		return nil, err
+	}
+	d.Body = body
+	if !json.Valid(d.Body) {
+		return nil, errors.New("delivery payload is not valid JSON")
+	}
+	return d, nil
+}
+
+// EventType returns the X-Github-Event header or, if there is none, what
+// GuessEventType makes of the payload
+func (d *Delivery) EventType() string {
+	if eventType := d.Header.Get(EventHeader); eventType != "" {
+		return eventType
+	}
+	return GuessEventType(d.Body)
+}
+
+// GUID returns the X-Github-Delivery header
+func (d *Delivery) GUID() string {
+	return d.Header.Get(DeliveryHeader)
+}
+
+// Decode decodes the payload into the event struct matching EventType
+func (d *Delivery) Decode() (interface{}, error) {
+	eventType := d.EventType()
+	if eventType == "" {
+		return nil, errors.New("can't tell the event type of the delivery")
+	}
+	return ParseWebHook(eventType, d.Body)
+}
+
+// Verify checks the X-Hub-Signature-256 header (or the older, SHA-1 based,
+// X-Hub-Signature if there is none) against the payload. As copying and pasting
+// often adds a final newline, the payload without it is tried too
+func (d *Delivery) Verify(secret []byte) error {
+	signature := d.Header.Get(SignatureHeader)
+	if signature == "" {
+		signature = d.Header.Get(SignatureSHA1Header)
+	}
+	if signature == "" {
+		return ErrNoSignature
+	}
+	err := VerifySignature(d.Body, signature, secret)
+	if trimmed := bytes.TrimRight(d.Body, "\r\n"); err != nil && len(trimmed) < len(d.Body) {
+		if VerifySignature(trimmed, signature, secret) == nil {
+			return nil
+		}
+	}
+	return err
+}
+
+// Sign sets the signature headers for secret
+func (d *Delivery) Sign(secret []byte) {
+	d.Header.Set(SignatureHeader, SignPayload(d.Body, secret))
+	d.Header.Set(SignatureSHA1Header, signPayload(sha1.New, "sha1=", d.Body, secret))
+}
+
+// SignPayload returns the X-Hub-Signature-256 value for a payload: "sha256=" and the
+// hex HMAC-SHA256 of the payload
+func SignPayload(payload, secret []byte) string {
+	return signPayload(sha256.New, "sha256=", payload, secret)
+}
+
+func signPayload(h func() hash.Hash, prefix string, payload, secret []byte) string {
+	mac := hmac.New(h, secret)
+	mac.Write(payload)
+	return prefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature checks a "sha256=..." (or "sha1=...") signature of a payload
+func VerifySignature(payload []byte, signature string, secret []byte) error {
+	var expected string
+	switch {
+	case strings.HasPrefix(signature, "sha256="):
+		expected = SignPayload(payload, secret)
+	case strings.HasPrefix(signature, "sha1="):
+		expected = signPayload(sha1.New, "sha1=", payload, secret)
+	default:
+		return fmt.Errorf("unknown signature format \"%s\"", signature)
+	}
+	if !hmac.Equal([]byte(expected), []byte(strings.ToLower(signature))) {
+		return errors.New("signature doesn't match payload")
+	}
+	return nil
+}
+
+// GuessEventType tells what X-Github-Event an event payload probably came with, from
+// the fields it has. It returns "" if it can't tell
+func GuessEventType(payload []byte) string {
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(payload, &fields); err != nil {
+		return ""
+	}
+	has := func(names ...string) bool {
+		for _, name := range names {
+			if _, ok := fields[name]; !ok {
+				return false
+			}
+		}
+		return true
+	}
+	switch {
+	case has("zen", "hook_id"):
+		return "ping"
+	case has("pusher"):
+		return "push"
+	case has("forkee"):
+		return "fork"
+	case has("issue", "comment"):
+		return "issue_comment"
+	case has("pull_request"):
+		return "pull_request"
+	case has("issue"):
+		return "issues"
+	case has("repository_selection", "installation") && (has("repositories_added") || has("repositories_removed")):
+		return "installation_repositories"
+	case has("label"):
+		return "label"
+	case has("milestone"):
+		return "milestone"
+	case has("installation", "action") && !has("repository"):
+		return "installation"
+	}
+	return ""
+}
@@ -0,0 +1,100 @@
+package ghevent
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestReadDelivery(t *testing.T) {
+	payload := `{"action":"opened","issue":{"number":1}}`
+	input := "Request method: POST\nAccept: */*\nX-GitHub-Delivery: 72d3162e\nX-GitHub-Event: issues\n\n" + payload
+	d, err := ReadDelivery(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.EventType() != "issues" || d.GUID() != "72d3162e" || d.Header.Get("Accept") != "*/*" {
+		t.Errorf("wrong headers: %v", d.Header)
+	}
+	if string(d.Body) != payload {
+		t.Errorf("body is %s (should be %s)", d.Body, payload)
+	}
+	event, err := d.Decode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event.(*IssuesEvent).GetIssue().GetNumber() != 1 {
+		t.Errorf("wrong decoded event: %+v", event)
+	}
+
+	if d, err = ReadDelivery(strings.NewReader(payload)); err != nil || d.EventType() != "issues" || len(d.Header) != 0 {
+		t.Errorf("payload without headers: %v, %v", d, err)
+	}
+	for _, input := range []string{"", "X-GitHub-Event: issues\n", "X-GitHub-Event: issues\n\n{\"action\":"} {
+		if _, err := ReadDelivery(strings.NewReader(input)); err == nil {
+			t.Errorf("reading %q should have failed", input)
+		}
+	}
+}
+
+func TestDeliverySignature(t *testing.T) {
+	secret := []byte("It's a Secret to Everybody")
+	payload := []byte("Hello, World!")
+	// From Github's documentation on validating webhook deliveries
+	want := "sha256=757107ea0eb2509fc211221cce984b8a37570b6d7586c22c46f4379c8b043e17"
+	if got := SignPayload(payload, secret); got != want {
+		t.Errorf("SignPayload() returned %s (should be %s)", got, want)
+	}
+	if err := VerifySignature(payload, want, secret); err != nil {
+		t.Error(err)
+	}
+	if err := VerifySignature(payload, want, []byte("wrong")); err == nil {
+		t.Error("wrong secret should fail")
+	}
+	if err := VerifySignature(payload, "md5=abc", secret); err == nil {
+		t.Error("unknown signature format should fail")
+	}
+
+	d := &Delivery{Header: map[string][]string{}, Body: []byte("{}")}
+	if err := d.Verify(secret); err != ErrNoSignature {
+		t.Errorf("Verify() of unsigned delivery returned %v", err)
+	}
+	d.Sign(secret)
+	if err := d.Verify(secret); err != nil {
+		t.Error(err)
+	}
+	d.Header.Del(SignatureHeader)
+	if err := d.Verify(secret); err != nil {
+		t.Errorf("SHA-1 signature: %v", err)
+	}
+	d.Body = []byte("{}\n")
+	if err := d.Verify(secret); err != nil {
+		t.Errorf("extra final newline: %v", err)
+	}
+	d.Body = []byte("{ }")
+	if err := d.Verify(secret); err == nil {
+		t.Error("modified body should fail")
+	}
+}
+
+func TestGuessEventType(t *testing.T) {
+	files, err := filepath.Glob("testdata/*/*.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range files {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := filepath.Base(filepath.Dir(file))
+		if got := GuessEventType(data); got != want {
+			t.Errorf("%s: guessed %s (should be %s)", file, got, want)
+		}
+	}
+	if got := GuessEventType(bytes.Repeat([]byte("x"), 3)); got != "" {
+		t.Errorf("guessed %s for invalid JSON", got)
+	}
+}
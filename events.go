@@ -0,0 +1,103 @@
+package ghevent
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+//
+// The Events API (/events, /repos/{owner}/{repo}/events, /orgs/{org}/events, ...)
+// returns envelopes with a type like "PushEvent", the actor, the repo, created_at,
+// and a payload which is a trimmed version of the webhook payload: it has no
+// repository, sender or organization, and push payloads call "after" "head".
+// APIEvent.Decode fills those in from the envelope, so the result looks like what a
+// webhook would have delivered.
+//
+
+// apiEventTypes maps Events API types to X-Github-Event names
+var apiEventTypes = map[string]string{
+	"ForkEvent":         "fork",
+	"IssueCommentEvent": "issue_comment",
+	"IssuesEvent":       "issues",
+	"PullRequestEvent":  "pull_request",
+	"PushEvent":         "push",
+}
+
+// EventType returns the X-Github-Event name for the envelope's type, e.g. "push"
+// for "PushEvent", or "" if the type isn't one this package can decode
+func (e *APIEvent) EventType() string {
+	return apiEventTypes[e.GetType()]
+}
+
+// Decode returns the payload as an event struct, e.g. a *PushEvent for a
+// "PushEvent", with the repository, sender and organization taken from the envelope
+func (e *APIEvent) Decode() (interface{}, error) {
+	eventType := e.EventType()
+	if eventType == "" {
+		return nil, fmt.Errorf("unsupported Events API type \"%s\"", e.GetType())
+	}
+	payload := e.Payload
+	if len(payload) == 0 {
+		payload = []byte("{}")
+	}
+	event, err := ParseWebHook(eventType, payload)
+	if err != nil {
+		return nil, fmt.Errorf("decoding %s %s: %v", e.GetType(), e.GetID(), err)
+	}
+	if push, ok := event.(*PushEvent); ok {
+		if err := fillAPIPush(push, payload); err != nil {
+			return nil, fmt.Errorf("decoding %s %s: %v", e.GetType(), e.GetID(), err)
+		}
+	}
+	setIfNil(event, "Repository", e.repository())
+	setIfNil(event, "Sender", e.Actor)
+	setIfNil(event, "Organization", e.Org)
+	return event, nil
+}
+
+// fillAPIPush fills in the push event fields the Events API names differently
+func fillAPIPush(push *PushEvent, payload []byte) error {
+	extra := struct {
+		Head *string `json:"head"`
+	}{}
+	if err := json.Unmarshal(payload, &extra); err != nil {
+		return err
+	}
+	if push.After == nil {
+		push.After = extra.Head
+	}
+	for i := range push.Commits {
+		if push.Commits[i].ID == nil {
+			push.Commits[i].ID = push.Commits[i].SHA
+		}
+	}
+	return nil
+}
+
+// repository returns a Repository made from the envelope's repo
+func (e *APIEvent) repository() *Repository {
+	if e.Repo == nil {
+		return nil
+	}
+	repo := &Repository{ID: e.Repo.ID, FullName: e.Repo.Name, URL: e.Repo.URL}
+	if owner, name, ok := strings.Cut(e.Repo.GetName(), "/"); ok {
+		repo.Name = &name
+		repo.Owner = &Account{Login: &owner}
+	}
+	return repo
+}
+
+// setIfNil sets the named pointer field of the struct event points to, if it
+// exists and is nil
+func setIfNil(event interface{}, field string, value interface{}) {
+	v := reflect.ValueOf(value)
+	if !v.IsValid() || v.IsNil() {
+		return
+	}
+	f := reflect.ValueOf(event).Elem().FieldByName(field)
+	if f.IsValid() && f.IsNil() && f.Type() == v.Type() {
+		f.Set(v)
+	}
+}
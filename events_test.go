@@ -0,0 +1,61 @@
+package ghevent
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+const apiPushEvent = `{
+  "id": "22249084947",
+  "type": "PushEvent",
+  "actor": {"id": 21031067, "login": "Codertocat", "display_login": "Codertocat", "url": "https://api.github.com/users/Codertocat"},
+  "repo": {"id": 186853002, "name": "Codertocat/Hello-World", "url": "https://api.github.com/repos/Codertocat/Hello-World"},
+  "payload": {
+    "repository_id": 186853002,
+    "push_id": 10115855396,
+    "size": 1,
+    "distinct_size": 1,
+    "ref": "refs/heads/master",
+    "head": "7b8f8b1c4c2b1e5a3f0e1d2c3b4a5968778695a4",
+    "before": "6113728f27ae82c7b1a177c8d03f9e96e0adf246",
+    "commits": [{"sha": "7b8f8b1c4c2b1e5a3f0e1d2c3b4a5968778695a4", "author": {"email": "21031067+Codertocat@users.noreply.github.com", "name": "Codertocat"}, "message": "Update README.md", "distinct": true}]
+  },
+  "public": true,
+  "created_at": "2021-01-14T07:35:08Z"
+}`
+
+func TestAPIEventDecode(t *testing.T) {
+	apiEvent := &APIEvent{}
+	if err := json.Unmarshal([]byte(apiPushEvent), apiEvent); err != nil {
+		t.Fatal(err)
+	}
+	if apiEvent.EventType() != "push" || !apiEvent.GetCreatedAt().Equal(builderTime) {
+		t.Errorf("envelope decoded as %s at %v", apiEvent.EventType(), apiEvent.GetCreatedAt())
+	}
+	event, err := apiEvent.Decode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	push, ok := event.(*PushEvent)
+	if !ok {
+		t.Fatalf("decoded a %T (should be *PushEvent)", event)
+	}
+	expectString(t, "after", push.After, "7b8f8b1c4c2b1e5a3f0e1d2c3b4a5968778695a4")
+	expectString(t, "commits[0].id", push.Commits[0].ID, "7b8f8b1c4c2b1e5a3f0e1d2c3b4a5968778695a4")
+	expectString(t, "repository.full_name", push.Repository.FullName, "Codertocat/Hello-World")
+	expectString(t, "repository.name", push.Repository.Name, "Hello-World")
+	expectString(t, "repository.owner.login", push.Repository.Owner.Login, "Codertocat")
+	expectString(t, "sender.login", push.Sender.Login, "Codertocat")
+	if push.Organization != nil {
+		t.Errorf("organization was set without an org in the envelope")
+	}
+
+	// Payloads that have a repository keep it
+	issues := &APIEvent{Type: Ptr("IssuesEvent"), Repo: apiEvent.Repo, Payload: json.RawMessage(`{"action":"opened","repository":{"full_name":"x/y"}}`)}
+	if event, err := issues.Decode(); err != nil || event.(*IssuesEvent).GetRepository().GetFullName() != "x/y" {
+		t.Errorf("decoding an issues event returned %+v, %v", event, err)
+	}
+	if _, err := (&APIEvent{Type: Ptr("WatchEvent")}).Decode(); err == nil {
+		t.Errorf("decoding a WatchEvent should fail")
+	}
+}
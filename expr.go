@@ -0,0 +1,802 @@
+package ghevent
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+//
+// A small expression language for filtering decoded events, e.g.
+//
+//   event == "issues" && action == "labeled" && label.name startsWith "sev"
+//
+// - Field paths use the JSON names, joined with dots: repository.owner.login. Array
+//   elements can be indexed (commits[0].id); without an index a path through an
+//   array gives all the values, e.g. issue.labels.name is a list of label names
+// - "event" is the X-Github-Event name
+// - Literals: "strings" (or 'strings'), numbers, true, false, null and [lists]
+// - Operators: == != < <= > >= in contains matches startsWith endsWith, && || !
+//   and parentheses. String operators on a list are true if true for any element,
+//   "x in list" and "list contains x" test membership, and "matches" takes a
+//   regular expression
+// - Missing fields, and nil pointers, are null. Only == and != are true for null
+//
+// Paths are checked against the Go types when compiling, so typos are caught early.
+//
+
+// Expr is a compiled expression
+type Expr struct {
+	src  string
+	root exprNode
+}
+
+type exprNode interface {
+	eval(ctx *exprContext) (interface{}, error)
+}
+
+type exprContext struct {
+	eventType string
+	event     reflect.Value
+}
+
+// CompileExpr compiles an expression. Field paths must exist in at least one of the
+// given event types (X-Github-Event names), or in any known event type if none are
+// given
+func CompileExpr(src string, events ...string) (*Expr, error) {
+	p := &exprParser{src: src}
+	if err := p.tokenize(); err != nil {
+		return nil, err
+	}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, p.errorf("unexpected %s", p.tokens[p.pos])
+	}
+	if len(events) == 0 {
+		events = EventTypes()
+	}
+	types := []reflect.Type{}
+	for _, eventType := range events {
+		newFunc, ok := eventTypes[eventType]
+		if !ok {
+			return nil, fmt.Errorf("unknown event type \"%s\"", eventType)
+		}
+		types = append(types, reflect.TypeOf(newFunc()))
+	}
+	for _, path := range p.paths {
+		if !pathExistsInAny(path.segments, types) {
+			return nil, fmt.Errorf("field \"%s\" doesn't exist in %s", path.String(), strings.Join(events, ", "))
+		}
+	}
+	return &Expr{src: src, root: root}, nil
+}
+
+func (e *Expr) String() string {
+	return e.src
+}
+
+// Eval evaluates the expression against an event. The result has to be a boolean
+func (e *Expr) Eval(eventType string, event interface{}) (bool, error) {
+	value, err := e.root.eval(&exprContext{eventType: eventType, event: reflect.ValueOf(event)})
+	if err != nil {
+		return false, err
+	}
+	b, ok := value.(bool)
+	if !ok {
+		return false, fmt.Errorf("expression \"%s\" gave %s, not a boolean", e.src, describeValue(value))
+	}
+	return b, nil
+}
+
+//
+// Tokenizer and parser
+//
+
+type exprToken struct {
+	kind  string // "string", "number", "ident", "op" or "punct"
+	text  string
+	value interface{}
+	pos   int
+}
+
+func (t exprToken) String() string {
+	if t.kind == "string" {
+		return strconv.Quote(t.text)
+	}
+	return "\"" + t.text + "\""
+}
+
+type exprParser struct {
+	src    string
+	tokens []exprToken
+	pos    int
+	paths  []*pathNode
+}
+
+func (p *exprParser) errorf(format string, args ...interface{}) error {
+	offset := len(p.src)
+	if p.pos < len(p.tokens) {
+		offset = p.tokens[p.pos].pos
+	}
+	return fmt.Errorf("expression \"%s\", at offset %d: %s", p.src, offset, fmt.Sprintf(format, args...))
+}
+
+var wordOperators = map[string]bool{"in": true, "contains": true, "matches": true, "startsWith": true, "endsWith": true}
+
+func (p *exprParser) tokenize() error {
+	s := p.src
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '"' || c == '\'':
+			j := i + 1
+			var sb strings.Builder
+			for ; j < len(s) && s[j] != c; j++ {
+				if s[j] == '\\' && j+1 < len(s) {
+					j++
+				}
+				sb.WriteByte(s[j])
+			}
+			if j >= len(s) {
+				return fmt.Errorf("expression \"%s\", at offset %d: unterminated string", s, i)
+			}
+			p.tokens = append(p.tokens, exprToken{kind: "string", text: sb.String(), value: sb.String(), pos: i})
+			i = j + 1
+		case c >= '0' && c <= '9' || (c == '-' && i+1 < len(s) && s[i+1] >= '0' && s[i+1] <= '9'):
+			j := i + 1
+			for j < len(s) && (s[j] >= '0' && s[j] <= '9' || s[j] == '.') {
+				j++
+			}
+			f, err := strconv.ParseFloat(s[i:j], 64)
+			if err != nil {
+				return fmt.Errorf("expression \"%s\", at offset %d: invalid number \"%s\"", s, i, s[i:j])
+			}
+			p.tokens = append(p.tokens, exprToken{kind: "number", text: s[i:j], value: f, pos: i})
+			i = j
+		case isIdentByte(c):
+			j := i
+			for j < len(s) && (isIdentByte(s[j]) || s[j] >= '0' && s[j] <= '9') {
+				j++
+			}
+			word := s[i:j]
+			kind := "ident"
+			if wordOperators[word] {
+				kind = "op"
+			}
+			p.tokens = append(p.tokens, exprToken{kind: kind, text: word, pos: i})
+			i = j
+		default:
+			op := ""
+			for _, candidate := range []string{"==", "!=", "<=", ">=", "&&", "||", "<", ">", "!"} {
+				if strings.HasPrefix(s[i:], candidate) {
+					op = candidate
+					break
+				}
+			}
+			if op != "" {
+				p.tokens = append(p.tokens, exprToken{kind: "op", text: op, pos: i})
+				i += len(op)
+			} else if strings.IndexByte("().[],", c) >= 0 {
+				p.tokens = append(p.tokens, exprToken{kind: "punct", text: string(c), pos: i})
+				i++
+			} else {
+				return fmt.Errorf("expression \"%s\", at offset %d: unexpected character '%c'", s, i, c)
+			}
+		}
+	}
+	return nil
+}
+
+func isIdentByte(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '_'
+}
+
+func (p *exprParser) peek(kind, text string) bool {
+	return p.pos < len(p.tokens) && p.tokens[p.pos].kind == kind && p.tokens[p.pos].text == text
+}
+
+func (p *exprParser) expect(kind, text string) error {
+	if !p.peek(kind, text) {
+		if p.pos >= len(p.tokens) {
+			return p.errorf("expected \"%s\" at end of expression", text)
+		}
+		return p.errorf("expected \"%s\", got %s", text, p.tokens[p.pos])
+	}
+	p.pos++
+	return nil
+}
+
+func (p *exprParser) parseOr() (exprNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek("op", "||") {
+		p.pos++
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &logicalNode{op: "||", left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *exprParser) parseAnd() (exprNode, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek("op", "&&") {
+		p.pos++
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = &logicalNode{op: "&&", left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *exprParser) parseNot() (exprNode, error) {
+	if p.peek("op", "!") {
+		p.pos++
+		operand, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return &notNode{operand: operand}, nil
+	}
+	return p.parseComparison()
+}
+
+func (p *exprParser) parseComparison() (exprNode, error) {
+	left, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) && p.tokens[p.pos].kind == "op" {
+		op := p.tokens[p.pos].text
+		if op == "&&" || op == "||" || op == "!" {
+			return left, nil
+		}
+		p.pos++
+		right, err := p.parsePrimary()
+		if err != nil {
+			return nil, err
+		}
+		node := &comparisonNode{op: op, left: left, right: right}
+		if op == "matches" {
+			lit, ok := right.(*literalNode)
+			if !ok {
+				return nil, p.errorf("\"matches\" needs a string literal pattern")
+			}
+			pattern, ok := lit.value.(string)
+			if !ok {
+				return nil, p.errorf("\"matches\" needs a string literal pattern")
+			}
+			if node.re, err = regexp.Compile(pattern); err != nil {
+				return nil, p.errorf("invalid pattern: %v", err)
+			}
+		}
+		return node, nil
+	}
+	return left, nil
+}
+
+func (p *exprParser) parsePrimary() (exprNode, error) {
+	if p.pos >= len(p.tokens) {
+		return nil, p.errorf("unexpected end of expression")
+	}
+	t := p.tokens[p.pos]
+	switch {
+	case t.kind == "string" || t.kind == "number":
+		p.pos++
+		return &literalNode{value: t.value}, nil
+	case t.kind == "punct" && t.text == "(":
+		p.pos++
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		return node, p.expect("punct", ")")
+	case t.kind == "punct" && t.text == "[":
+		p.pos++
+		list := &listNode{}
+		for !p.peek("punct", "]") {
+			if len(list.items) > 0 {
+				if err := p.expect("punct", ","); err != nil {
+					return nil, err
+				}
+			}
+			item, err := p.parsePrimary()
+			if err != nil {
+				return nil, err
+			}
+			list.items = append(list.items, item)
+		}
+		p.pos++
+		return list, nil
+	case t.kind == "ident":
+		switch t.text {
+		case "true", "false":
+			p.pos++
+			return &literalNode{value: t.text == "true"}, nil
+		case "null":
+			p.pos++
+			return &literalNode{value: nil}, nil
+		case "event":
+			p.pos++
+			return &eventTypeNode{}, nil
+		}
+		return p.parsePath()
+	}
+	return nil, p.errorf("unexpected %s", t)
+}
+
+func (p *exprParser) parsePath() (exprNode, error) {
+	path := &pathNode{}
+	for {
+		if p.pos >= len(p.tokens) || p.tokens[p.pos].kind != "ident" {
+			return nil, p.errorf("expected a field name")
+		}
+		path.segments = append(path.segments, pathSegment{name: p.tokens[p.pos].text, index: -1})
+		p.pos++
+		for p.peek("punct", "[") {
+			p.pos++
+			if p.pos >= len(p.tokens) || p.tokens[p.pos].kind != "number" {
+				return nil, p.errorf("expected an array index")
+			}
+			index := p.tokens[p.pos].value.(float64)
+			if index < 0 || index != float64(int(index)) {
+				return nil, p.errorf("invalid array index %s", p.tokens[p.pos].text)
+			}
+			path.segments = append(path.segments, pathSegment{index: int(index)})
+			p.pos++
+			if err := p.expect("punct", "]"); err != nil {
+				return nil, err
+			}
+		}
+		if !p.peek("punct", ".") {
+			break
+		}
+		p.pos++
+	}
+	p.paths = append(p.paths, path)
+	return path, nil
+}
+
+//
+// Nodes
+//
+
+type literalNode struct {
+	value interface{}
+}
+
+func (n *literalNode) eval(*exprContext) (interface{}, error) {
+	return n.value, nil
+}
+
+type listNode struct {
+	items []exprNode
+}
+
+func (n *listNode) eval(ctx *exprContext) (interface{}, error) {
+	list := make([]interface{}, len(n.items))
+	for i, item := range n.items {
+		value, err := item.eval(ctx)
+		if err != nil {
+			return nil, err
+		}
+		list[i] = value
+	}
+	return list, nil
+}
+
+type eventTypeNode struct{}
+
+func (n *eventTypeNode) eval(ctx *exprContext) (interface{}, error) {
+	return ctx.eventType, nil
+}
+
+type notNode struct {
+	operand exprNode
+}
+
+func (n *notNode) eval(ctx *exprContext) (interface{}, error) {
+	b, err := evalBool(ctx, "!", n.operand)
+	if err != nil {
+		return nil, err
+	}
+	return !b, nil
+}
+
+type logicalNode struct {
+	op          string
+	left, right exprNode
+}
+
+func (n *logicalNode) eval(ctx *exprContext) (interface{}, error) {
+	left, err := evalBool(ctx, n.op, n.left)
+	if err != nil {
+		return nil, err
+	}
+	// Short circuit
+	if (n.op == "&&" && !left) || (n.op == "||" && left) {
+		return left, nil
+	}
+	return evalBool(ctx, n.op, n.right)
+}
+
+func evalBool(ctx *exprContext, op string, node exprNode) (bool, error) {
+	value, err := node.eval(ctx)
+	if err != nil {
+		return false, err
+	}
+	b, ok := value.(bool)
+	if !ok {
+		return false, fmt.Errorf("\"%s\" needs booleans, not %s", op, describeValue(value))
+	}
+	return b, nil
+}
+
+type comparisonNode struct {
+	op          string
+	left, right exprNode
+	re          *regexp.Regexp
+}
+
+func (n *comparisonNode) eval(ctx *exprContext) (interface{}, error) {
+	left, err := n.left.eval(ctx)
+	if err != nil {
+		return nil, err
+	}
+	right, err := n.right.eval(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch n.op {
+	case "==":
+		return valuesEqual(left, right), nil
+	case "!=":
+		return !valuesEqual(left, right), nil
+	case "in":
+		return contains(right, left), nil
+	case "contains":
+		return contains(left, right), nil
+	case "<", "<=", ">", ">=":
+		return compareOrdered(n.op, left, right)
+	case "matches", "startsWith", "endsWith":
+		return anyString(left, func(s string) bool {
+			switch n.op {
+			case "matches":
+				return n.re.MatchString(s)
+			case "startsWith":
+				prefix, _ := right.(string)
+				return right != nil && strings.HasPrefix(s, prefix)
+			}
+			suffix, _ := right.(string)
+			return right != nil && strings.HasSuffix(s, suffix)
+		}), nil
+	}
+	return nil, fmt.Errorf("unknown operator \"%s\"", n.op)
+}
+
+func valuesEqual(a, b interface{}) bool {
+	if t, ok := a.(time.Time); ok {
+		if s, ok := b.(string); ok {
+			parsed, err := time.Parse(time.RFC3339, s)
+			return err == nil && parsed.Equal(t)
+		}
+	}
+	if t, ok := b.(time.Time); ok {
+		return valuesEqual(t, a)
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// contains tests list membership, or substrings
+func contains(container, item interface{}) bool {
+	switch c := container.(type) {
+	case []interface{}:
+		for _, element := range c {
+			if valuesEqual(element, item) {
+				return true
+			}
+		}
+	case string:
+		s, ok := item.(string)
+		return ok && strings.Contains(c, s)
+	}
+	return false
+}
+
+func anyString(v interface{}, f func(string) bool) bool {
+	switch v := v.(type) {
+	case string:
+		return f(v)
+	case []interface{}:
+		for _, element := range v {
+			if s, ok := element.(string); ok && f(s) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func compareOrdered(op string, left, right interface{}) (bool, error) {
+	var cmp int
+	switch l := left.(type) {
+	case nil:
+		return false, nil
+	case float64:
+		r, ok := right.(float64)
+		if !ok {
+			return false, fmt.Errorf("can't compare %s with %s", describeValue(left), describeValue(right))
+		}
+		cmp = compareFloats(l, r)
+	case string:
+		r, ok := right.(string)
+		if !ok {
+			return false, fmt.Errorf("can't compare %s with %s", describeValue(left), describeValue(right))
+		}
+		cmp = strings.Compare(l, r)
+	case time.Time:
+		var r time.Time
+		switch rv := right.(type) {
+		case time.Time:
+			r = rv
+		case string:
+			parsed, err := time.Parse(time.RFC3339, rv)
+			if err != nil {
+				return false, fmt.Errorf("can't compare a time with \"%s\": %v", rv, err)
+			}
+			r = parsed
+		default:
+			return false, fmt.Errorf("can't compare %s with %s", describeValue(left), describeValue(right))
+		}
+		cmp = compareFloats(float64(l.UnixNano()), float64(r.UnixNano()))
+	default:
+		if right == nil {
+			return false, nil
+		}
+		return false, fmt.Errorf("can't compare %s with %s", describeValue(left), describeValue(right))
+	}
+	switch op {
+	case "<":
+		return cmp < 0, nil
+	case "<=":
+		return cmp <= 0, nil
+	case ">":
+		return cmp > 0, nil
+	}
+	return cmp >= 0, nil
+}
+
+func compareFloats(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func describeValue(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return "null"
+	case string:
+		return "string " + strconv.Quote(v)
+	case []interface{}:
+		return "a list"
+	case time.Time:
+		return "time " + v.Format(time.RFC3339)
+	}
+	return fmt.Sprintf("%T %v", v, v)
+}
+
+//
+// Field paths
+//
+
+type pathSegment struct {
+	name  string // JSON field name, or "" for an index
+	index int    // Array index, or -1
+}
+
+type pathNode struct {
+	segments []pathSegment
+}
+
+func (n *pathNode) String() string {
+	var sb strings.Builder
+	for i, segment := range n.segments {
+		if segment.name == "" {
+			fmt.Fprintf(&sb, "[%d]", segment.index)
+			continue
+		}
+		if i > 0 {
+			sb.WriteByte('.')
+		}
+		sb.WriteString(segment.name)
+	}
+	return sb.String()
+}
+
+var timeWrapperType = reflect.TypeOf(TimeWrapper{})
+
+func (n *pathNode) eval(ctx *exprContext) (interface{}, error) {
+	values := []reflect.Value{ctx.event}
+	flattened := false
+	for _, segment := range n.segments {
+		next := []reflect.Value{}
+		for _, v := range values {
+			v = indirectValue(v)
+			if !v.IsValid() {
+				continue
+			}
+			switch {
+			case segment.name == "":
+				if v.Kind() == reflect.Slice && segment.index < v.Len() {
+					next = append(next, v.Index(segment.index))
+				}
+			case v.Kind() == reflect.Slice:
+				// A path through an array without an index gives all values
+				flattened = true
+				for i := 0; i < v.Len(); i++ {
+					if field := jsonField(indirectValue(v.Index(i)), segment.name); field.IsValid() {
+						next = append(next, field)
+					}
+				}
+			default:
+				if field := jsonField(v, segment.name); field.IsValid() {
+					next = append(next, field)
+				}
+			}
+		}
+		values = next
+	}
+	if !flattened {
+		if len(values) == 0 {
+			return nil, nil
+		}
+		return exprValue(values[0]), nil
+	}
+	list := []interface{}{}
+	for _, v := range values {
+		switch value := exprValue(v).(type) {
+		case nil:
+		case []interface{}:
+			// commits.added is all the added files, not a list of lists
+			list = append(list, value...)
+		default:
+			list = append(list, value)
+		}
+	}
+	return list, nil
+}
+
+func indirectValue(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+// jsonField returns the struct field (or map value) with the given JSON name
+func jsonField(v reflect.Value, name string) reflect.Value {
+	switch v.Kind() {
+	case reflect.Struct:
+		if i, ok := jsonFieldIndex(v.Type(), name); ok {
+			return v.Field(i)
+		}
+	case reflect.Map:
+		if v.Type().Key().Kind() == reflect.String {
+			return v.MapIndex(reflect.ValueOf(name).Convert(v.Type().Key()))
+		}
+	}
+	return reflect.Value{}
+}
+
+func jsonFieldIndex(t reflect.Type, name string) (int, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		tag := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		if tag == name {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// exprValue converts a field to what expressions work with: string, float64, bool,
+// time.Time, []interface{} or nil
+func exprValue(v reflect.Value) interface{} {
+	v = indirectValue(v)
+	if !v.IsValid() {
+		return nil
+	}
+	if v.Type() == timeWrapperType {
+		tw := v.Interface().(TimeWrapper)
+		return tw.Time()
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return v.String()
+	case reflect.Bool:
+		return v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		return v.Float()
+	case reflect.Slice:
+		list := []interface{}{}
+		for i := 0; i < v.Len(); i++ {
+			list = append(list, exprValue(v.Index(i)))
+		}
+		return list
+	}
+	// Objects can only be compared with null
+	return v.Interface()
+}
+
+// pathExistsInAny tells if the path exists in at least one of the types
+func pathExistsInAny(segments []pathSegment, types []reflect.Type) bool {
+	for _, t := range types {
+		if pathExists(segments, t) {
+			return true
+		}
+	}
+	return false
+}
+
+func pathExists(segments []pathSegment, t reflect.Type) bool {
+	for _, segment := range segments {
+		for t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+		if segment.name == "" {
+			if t.Kind() != reflect.Slice {
+				return false
+			}
+			t = t.Elem()
+			continue
+		}
+		// Paths go through arrays without an index
+		for t.Kind() == reflect.Slice || t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+		switch t.Kind() {
+		case reflect.Struct:
+			if t == timeWrapperType {
+				return false
+			}
+			i, ok := jsonFieldIndex(t, segment.name)
+			if !ok {
+				return false
+			}
+			t = t.Field(i).Type
+		case reflect.Map:
+			t = t.Elem()
+		default:
+			return false
+		}
+	}
+	return true
+}
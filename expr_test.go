@@ -0,0 +1,122 @@
+package ghevent
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestExprEval(t *testing.T) {
+	owner := NewAccountBuilder("Codertocat").Build()
+	repo := NewRepositoryBuilder(owner, "Hello-World").Build()
+	labeled := NewIssuesEventBuilder("labeled", repo, 7).Title("Crash on start").Labels("bug", "sev2").Label("sev1").Build()
+	push := NewPushEventBuilder(repo).Commit("Add docs", []string{"docs/a.md"}, nil, nil).Commit("Fix", nil, nil, []string{"main.go"}).Build()
+	tests := []struct {
+		src       string
+		eventType string
+		event     interface{}
+		want      bool
+	}{
+		{`event == "issues" && action == "labeled" && label.name startsWith "sev"`, "issues", labeled, true},
+		{`event == "issues" && action == "opened"`, "issues", labeled, false},
+		{`label.name endsWith "1"`, "issues", labeled, true},
+		{`issue.number == 7 && issue.number >= 7 && issue.number < 8`, "issues", labeled, true},
+		{`issue.title contains "Crash"`, "issues", labeled, true},
+		{`issue.title matches "^crash"`, "issues", labeled, false},
+		{`issue.title matches "(?i)^crash"`, "issues", labeled, true},
+		{`"bug" in issue.labels.name`, "issues", labeled, true},
+		{`issue.labels.name contains "wontfix"`, "issues", labeled, false},
+		{`issue.labels[1].name == "sev2"`, "issues", labeled, true},
+		{`issue.labels[5].name == null`, "issues", labeled, true},
+		{`issue.labels.name startsWith "sev"`, "issues", labeled, true},
+		{`action in ["opened", "labeled"]`, "issues", labeled, true},
+		{`!(action == "labeled") || repository.owner.login == "Codertocat"`, "issues", labeled, true},
+		{`repository.private == false && issue.milestone == null`, "issues", labeled, true},
+		{`issue.milestone.title == "v1"`, "issues", labeled, false},
+		{`issue.milestone.number > 1`, "issues", labeled, false},
+		{`sender.login != null`, "issues", labeled, true},
+		{`repository.created_at < "2030-01-01T00:00:00Z"`, "issues", labeled, true},
+		{`ref == "refs/heads/master" && commits.modified contains "main.go"`, "push", push, true},
+		{`commits[0].added contains "docs/a.md"`, "push", push, true},
+		{`commits[0].message == 'Add docs'`, "push", push, true},
+		{`label.name == "sev1"`, "push", push, false},
+	}
+	for _, test := range tests {
+		expr, err := CompileExpr(test.src)
+		if err != nil {
+			t.Errorf("%s: %v", test.src, err)
+			continue
+		}
+		got, err := expr.Eval(test.eventType, test.event)
+		if err != nil {
+			t.Errorf("%s: %v", test.src, err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("%s: got %v (should be %v)", test.src, got, test.want)
+		}
+	}
+}
+
+func TestExprErrors(t *testing.T) {
+	tests := []struct {
+		src    string
+		events []string
+		err    string
+	}{
+		{`action ==`, nil, "unexpected end"},
+		{`action == "opened`, nil, "unterminated string"},
+		{`(action == "opened"`, nil, "expected \")\""},
+		{`action == "opened" "closed"`, nil, "unexpected \"closed\""},
+		{`action # 1`, nil, "unexpected character"},
+		{`lable.name == "bug"`, nil, "field \"lable.name\" doesn't exist"},
+		{`issue.labels[0].nme == "bug"`, nil, "field \"issue.labels[0].nme\" doesn't exist"},
+		{`label.name == "bug"`, []string{"push"}, "doesn't exist in push"},
+		{`issue.title matches "("`, nil, "invalid pattern"},
+		{`issue.title matches issue.body`, nil, "string literal pattern"},
+		{`action == "opened"`, []string{"nope"}, "unknown event type"},
+	}
+	for _, test := range tests {
+		_, err := CompileExpr(test.src, test.events...)
+		if err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("%s: got error %v (should contain %q)", test.src, err, test.err)
+		}
+	}
+
+	// Type errors are only found when evaluating
+	expr, err := CompileExpr(`issue.number > "7"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	event := NewIssuesEventBuilder("opened", NewRepositoryBuilder(NewAccountBuilder("Codertocat").Build(), "Hello-World").Build(), 7).Build()
+	if _, err := expr.Eval("issues", event); err == nil {
+		t.Error("comparing a number with a string should fail")
+	}
+	if expr, err = CompileExpr(`issue.title`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := expr.Eval("issues", event); err == nil {
+		t.Error("a non-boolean result should fail")
+	}
+}
+
+func TestRouterIf(t *testing.T) {
+	router, err := NewRouter([]Rule{
+		{Name: "busy", Handler: "h", Events: []string{"issues"}, If: `issue.title contains "urgent" || "sev1" in issue.labels.name`},
+		{Name: "rest", Handler: "h"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo := NewRepositoryBuilder(NewAccountBuilder("Codertocat").Build(), "Hello-World").Build()
+	if got := matchedRules(router.Match("issues", NewIssuesEventBuilder("opened", repo, 1).Labels("sev1").Build())); got[0] != "busy" {
+		t.Errorf("matched %v (should have matched busy)", got)
+	}
+	results := router.Explain("issues", NewIssuesEventBuilder("opened", repo, 1).Title("later").Build())
+	if results[0].Matched || !strings.Contains(results[0].Reason, "is false") {
+		t.Errorf("got %+v (should explain that the expression is false)", results[0])
+	}
+
+	if _, err := NewRouter([]Rule{{Handler: "h", Events: []string{"push"}, If: `issue.title == "x"`}}); err == nil {
+		t.Error("a path that doesn't exist in the rule's events should fail")
+	}
+}
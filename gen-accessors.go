@@ -0,0 +1,290 @@
+//go:build ignore
+// +build ignore
+
+//
+// gen-accessors generates nil-safe GetX() accessors for every field of the data types
+// in ghevent.go, along with tests for them. Run it with "go generate".
+//
+// - *T fields, where T is a basic type, get a getter returning T (zero value if nil)
+// - *TimeWrapper fields get a getter returning time.Time (zero time if nil)
+// - Struct pointers, slices (including json.RawMessage) and maps are returned as-is
+//   (nil if the receiver is nil),
+//   which means getters can be chained: e.GetRepository().GetOwner().GetLogin()
+//
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"sort"
+	"strings"
+)
+
+const (
+	sourceFile = "ghevent.go"
+	outputFile = "ghevent-accessors.go"
+	testFile   = "ghevent-accessors_test.go"
+)
+
+// Types in ghevent.go that aren't part of the data model
+var skipTypes = map[string]bool{
+	"TestStruct":  true,
+	"TimeWrapper": true,
+}
+
+// Named slice types from other packages, which are treated like slices
+var sliceTypes = map[string]string{
+	"json.RawMessage": "encoding/json",
+}
+
+type getter struct {
+	Receiver   string // struct type name
+	Field      string
+	Kind       string // "basic", "time", "pointer", "slice", "map" or "value"
+	ReturnType string
+	ElemType   string // for "basic" and "pointer": the pointed-to type
+}
+
+func main() {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, sourceFile, nil, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+	getters := []getter{}
+	for _, decl := range file.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			ts := spec.(*ast.TypeSpec)
+			st, ok := ts.Type.(*ast.StructType)
+			if !ok || !ts.Name.IsExported() || skipTypes[ts.Name.Name] {
+				continue
+			}
+			for _, field := range st.Fields.List {
+				for _, name := range field.Names {
+					if !name.IsExported() {
+						continue
+					}
+					getters = append(getters, newGetter(ts.Name.Name, name.Name, field.Type))
+				}
+			}
+		}
+	}
+	sort.SliceStable(getters, func(i, j int) bool {
+		if getters[i].Receiver != getters[j].Receiver {
+			return getters[i].Receiver < getters[j].Receiver
+		}
+		return getters[i].Field < getters[j].Field
+	})
+	write(outputFile, generateAccessors(getters))
+	write(testFile, generateTests(getters))
+}
+
+func newGetter(receiver, field string, expr ast.Expr) getter {
+	g := getter{Receiver: receiver, Field: field, ReturnType: typeString(expr)}
+	switch t := expr.(type) {
+	case *ast.StarExpr:
+		g.ElemType = typeString(t.X)
+		switch {
+		case g.ElemType == "TimeWrapper":
+			g.Kind = "time"
+			g.ReturnType = "time.Time"
+		case isBasic(g.ElemType):
+			g.Kind = "basic"
+			g.ReturnType = g.ElemType
+		default:
+			g.Kind = "pointer"
+		}
+	case *ast.ArrayType:
+		g.Kind = "slice"
+	case *ast.MapType:
+		g.Kind = "map"
+	case *ast.SelectorExpr:
+		if _, ok := sliceTypes[g.ReturnType]; ok {
+			g.Kind = "slice"
+		} else {
+			g.Kind = "value"
+		}
+	default:
+		g.Kind = "value"
+	}
+	return g
+}
+
+func isBasic(t string) bool {
+	switch t {
+	case "string", "int", "int64", "bool", "float64":
+		return true
+	}
+	return false
+}
+
+func typeString(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		return t.Name
+	case *ast.StarExpr:
+		return "*" + typeString(t.X)
+	case *ast.ArrayType:
+		return "[]" + typeString(t.Elt)
+	case *ast.MapType:
+		return "map[" + typeString(t.Key) + "]" + typeString(t.Value)
+	case *ast.SelectorExpr:
+		return typeString(t.X) + "." + t.Sel.Name
+	}
+	log.Fatalf("unsupported field type %T", expr)
+	return ""
+}
+
+func receiverName(typeName string) string {
+	return strings.ToLower(typeName[:1])
+}
+
+func zeroValue(t string) string {
+	switch t {
+	case "string":
+		return `""`
+	case "int", "int64", "float64":
+		return "0"
+	case "bool":
+		return "false"
+	}
+	return "nil"
+}
+
+func sampleValue(t string) string {
+	switch t {
+	case "string":
+		return `"x"`
+	case "int", "int64":
+		return "1"
+	case "float64":
+		return "1.5"
+	case "bool":
+		return "true"
+	}
+	return t + "{}"
+}
+
+// imports returns an import declaration for packages, and those of the sliceTypes
+// used by getters
+func imports(getters []getter, packages ...string) string {
+	for _, g := range getters {
+		if pkg, ok := sliceTypes[g.ReturnType]; ok && !containsString(packages, pkg) {
+			packages = append(packages, pkg)
+		}
+	}
+	sort.Strings(packages)
+	return "import (\n\t\"" + strings.Join(packages, "\"\n\t\"") + "\"\n)\n"
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+func generateAccessors(getters []getter) []byte {
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "// Code generated by gen-accessors.go; DO NOT EDIT.\n\n")
+	fmt.Fprintf(buf, "package ghevent\n\n%s", imports(getters, "time"))
+	for _, g := range getters {
+		r := receiverName(g.Receiver)
+		fmt.Fprintf(buf, "\n// Get%s returns the %s field", g.Field, g.Field)
+		switch g.Kind {
+		case "basic":
+			fmt.Fprintf(buf, " if it's non-nil, zero value otherwise.\n")
+		case "time":
+			fmt.Fprintf(buf, " as a time.Time if it's non-nil, zero time otherwise.\n")
+		default:
+			fmt.Fprintf(buf, ", or %s if %s is nil.\n", zeroValue(g.ReturnType), r)
+		}
+		fmt.Fprintf(buf, "func (%s *%s) Get%s() %s {\n", r, g.Receiver, g.Field, g.ReturnType)
+		switch g.Kind {
+		case "basic":
+			fmt.Fprintf(buf, "\tif %s == nil || %s.%s == nil {\n\t\treturn %s\n\t}\n", r, r, g.Field, zeroValue(g.ElemType))
+			fmt.Fprintf(buf, "\treturn *%s.%s\n", r, g.Field)
+		case "time":
+			fmt.Fprintf(buf, "\tif %s == nil || %s.%s == nil {\n\t\treturn time.Time{}\n\t}\n", r, r, g.Field)
+			fmt.Fprintf(buf, "\treturn %s.%s.Time()\n", r, g.Field)
+		case "value":
+			fmt.Fprintf(buf, "\tif %s == nil {\n\t\treturn %s\n\t}\n", r, zeroValue(g.ReturnType))
+			fmt.Fprintf(buf, "\treturn %s.%s\n", r, g.Field)
+		default:
+			fmt.Fprintf(buf, "\tif %s == nil {\n\t\treturn nil\n\t}\n", r)
+			fmt.Fprintf(buf, "\treturn %s.%s\n", r, g.Field)
+		}
+		fmt.Fprintf(buf, "}\n")
+	}
+	return buf.Bytes()
+}
+
+func generateTests(getters []getter) []byte {
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "// Code generated by gen-accessors.go; DO NOT EDIT.\n\n")
+	fmt.Fprintf(buf, "package ghevent\n\n%s", imports(getters, "testing", "time"))
+	for _, g := range getters {
+		r := "s" // not receiverName(), which could clash with t or v
+		fmt.Fprintf(buf, "\nfunc Test%s_Get%s(t *testing.T) {\n", g.Receiver, g.Field)
+		switch g.Kind {
+		case "basic":
+			fmt.Fprintf(buf, "\tv := %s\n", sampleValue(g.ElemType))
+			fmt.Fprintf(buf, "\t%s := &%s{%s: &v}\n", r, g.Receiver, g.Field)
+			fmt.Fprintf(buf, "\tif got := %s.Get%s(); got != v {\n\t\tt.Errorf(\"Get%s() was %%v (should have been %%v)\", got, v)\n\t}\n", r, g.Field, g.Field)
+			fmt.Fprintf(buf, "\t%s = &%s{}\n", r, g.Receiver)
+			fmt.Fprintf(buf, "\tif got := %s.Get%s(); got != %s {\n\t\tt.Errorf(\"Get%s() was %%v when field was nil\", got)\n\t}\n", r, g.Field, zeroValue(g.ElemType), g.Field)
+			fmt.Fprintf(buf, "\t%s = nil\n", r)
+			fmt.Fprintf(buf, "\tif got := %s.Get%s(); got != %s {\n\t\tt.Errorf(\"Get%s() was %%v when receiver was nil\", got)\n\t}\n", r, g.Field, zeroValue(g.ElemType), g.Field)
+		case "time":
+			fmt.Fprintf(buf, "\tv := time.Unix(1557933565, 0)\n")
+			fmt.Fprintf(buf, "\t%s := &%s{%s: &TimeWrapper{t: v}}\n", r, g.Receiver, g.Field)
+			fmt.Fprintf(buf, "\tif got := %s.Get%s(); !got.Equal(v) {\n\t\tt.Errorf(\"Get%s() was %%v (should have been %%v)\", got, v)\n\t}\n", r, g.Field, g.Field)
+			fmt.Fprintf(buf, "\t%s = &%s{}\n", r, g.Receiver)
+			fmt.Fprintf(buf, "\tif got := %s.Get%s(); !got.IsZero() {\n\t\tt.Errorf(\"Get%s() was %%v when field was nil\", got)\n\t}\n", r, g.Field, g.Field)
+			fmt.Fprintf(buf, "\t%s = nil\n", r)
+			fmt.Fprintf(buf, "\tif got := %s.Get%s(); !got.IsZero() {\n\t\tt.Errorf(\"Get%s() was %%v when receiver was nil\", got)\n\t}\n", r, g.Field, g.Field)
+		case "pointer":
+			fmt.Fprintf(buf, "\tv := &%s{}\n", g.ElemType)
+			fmt.Fprintf(buf, "\t%s := &%s{%s: v}\n", r, g.Receiver, g.Field)
+			fmt.Fprintf(buf, "\tif got := %s.Get%s(); got != v {\n\t\tt.Errorf(\"Get%s() didn't return the field\")\n\t}\n", r, g.Field, g.Field)
+			fmt.Fprintf(buf, "\t%s = nil\n", r)
+			fmt.Fprintf(buf, "\tif got := %s.Get%s(); got != nil {\n\t\tt.Errorf(\"Get%s() was %%v when receiver was nil\", got)\n\t}\n", r, g.Field, g.Field)
+		case "slice", "map":
+			fmt.Fprintf(buf, "\tv := %s{}\n", g.ReturnType)
+			fmt.Fprintf(buf, "\t%s := &%s{%s: v}\n", r, g.Receiver, g.Field)
+			fmt.Fprintf(buf, "\tif got := %s.Get%s(); got == nil {\n\t\tt.Errorf(\"Get%s() was nil when field was non-nil\")\n\t}\n", r, g.Field, g.Field)
+			fmt.Fprintf(buf, "\t%s = nil\n", r)
+			fmt.Fprintf(buf, "\tif got := %s.Get%s(); got != nil {\n\t\tt.Errorf(\"Get%s() was %%v when receiver was nil\", got)\n\t}\n", r, g.Field, g.Field)
+		case "value":
+			fmt.Fprintf(buf, "\t%s := &%s{%s: %s}\n", r, g.Receiver, g.Field, sampleValue(g.ReturnType))
+			fmt.Fprintf(buf, "\tif got := %s.Get%s(); got != %s {\n\t\tt.Errorf(\"Get%s() was %%v (should have been %s)\", got)\n\t}\n", r, g.Field, sampleValue(g.ReturnType), g.Field, strings.Replace(sampleValue(g.ReturnType), `"`, `\"`, -1))
+			fmt.Fprintf(buf, "\t%s = nil\n", r)
+			fmt.Fprintf(buf, "\tif got := %s.Get%s(); got != %s {\n\t\tt.Errorf(\"Get%s() was %%v when receiver was nil\", got)\n\t}\n", r, g.Field, zeroValue(g.ReturnType), g.Field)
+		}
+		fmt.Fprintf(buf, "}\n")
+	}
+	return buf.Bytes()
+}
+
+func write(filename string, src []byte) {
+	formatted, err := format.Source(src)
+	if err != nil {
+		log.Fatalf("formatting %s: %v\n%s", filename, err, src)
+	}
+	if err := ioutil.WriteFile(filename, formatted, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,3912 @@
+// Code generated by gen-accessors.go; DO NOT EDIT.
+
+package ghevent
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// GetActor returns the Actor field, or nil if a is nil.
+func (a *APIEvent) GetActor() *Account {
+	if a == nil {
+		return nil
+	}
+	return a.Actor
+}
+
+// GetCreatedAt returns the CreatedAt field as a time.Time if it's non-nil, zero time otherwise.
+func (a *APIEvent) GetCreatedAt() time.Time {
+	if a == nil || a.CreatedAt == nil {
+		return time.Time{}
+	}
+	return a.CreatedAt.Time()
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (a *APIEvent) GetID() string {
+	if a == nil || a.ID == nil {
+		return ""
+	}
+	return *a.ID
+}
+
+// GetOrg returns the Org field, or nil if a is nil.
+func (a *APIEvent) GetOrg() *Account {
+	if a == nil {
+		return nil
+	}
+	return a.Org
+}
+
+// GetPayload returns the Payload field, or nil if a is nil.
+func (a *APIEvent) GetPayload() json.RawMessage {
+	if a == nil {
+		return nil
+	}
+	return a.Payload
+}
+
+// GetPublic returns the Public field if it's non-nil, zero value otherwise.
+func (a *APIEvent) GetPublic() bool {
+	if a == nil || a.Public == nil {
+		return false
+	}
+	return *a.Public
+}
+
+// GetRepo returns the Repo field, or nil if a is nil.
+func (a *APIEvent) GetRepo() *APIEventRepo {
+	if a == nil {
+		return nil
+	}
+	return a.Repo
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (a *APIEvent) GetType() string {
+	if a == nil || a.Type == nil {
+		return ""
+	}
+	return *a.Type
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (a *APIEventRepo) GetID() int {
+	if a == nil || a.ID == nil {
+		return 0
+	}
+	return *a.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (a *APIEventRepo) GetName() string {
+	if a == nil || a.Name == nil {
+		return ""
+	}
+	return *a.Name
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (a *APIEventRepo) GetURL() string {
+	if a == nil || a.URL == nil {
+		return ""
+	}
+	return *a.URL
+}
+
+// GetAvatarURL returns the AvatarURL field if it's non-nil, zero value otherwise.
+func (a *Account) GetAvatarURL() string {
+	if a == nil || a.AvatarURL == nil {
+		return ""
+	}
+	return *a.AvatarURL
+}
+
+// GetBio returns the Bio field if it's non-nil, zero value otherwise.
+func (a *Account) GetBio() string {
+	if a == nil || a.Bio == nil {
+		return ""
+	}
+	return *a.Bio
+}
+
+// GetBlog returns the Blog field if it's non-nil, zero value otherwise.
+func (a *Account) GetBlog() string {
+	if a == nil || a.Blog == nil {
+		return ""
+	}
+	return *a.Blog
+}
+
+// GetCompany returns the Company field if it's non-nil, zero value otherwise.
+func (a *Account) GetCompany() string {
+	if a == nil || a.Company == nil {
+		return ""
+	}
+	return *a.Company
+}
+
+// GetCreatedAt returns the CreatedAt field as a time.Time if it's non-nil, zero time otherwise.
+func (a *Account) GetCreatedAt() time.Time {
+	if a == nil || a.CreatedAt == nil {
+		return time.Time{}
+	}
+	return a.CreatedAt.Time()
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (a *Account) GetDescription() string {
+	if a == nil || a.Description == nil {
+		return ""
+	}
+	return *a.Description
+}
+
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (a *Account) GetEmail() string {
+	if a == nil || a.Email == nil {
+		return ""
+	}
+	return *a.Email
+}
+
+// GetEventsURL returns the EventsURL field if it's non-nil, zero value otherwise.
+func (a *Account) GetEventsURL() string {
+	if a == nil || a.EventsURL == nil {
+		return ""
+	}
+	return *a.EventsURL
+}
+
+// GetFollowers returns the Followers field if it's non-nil, zero value otherwise.
+func (a *Account) GetFollowers() int {
+	if a == nil || a.Followers == nil {
+		return 0
+	}
+	return *a.Followers
+}
+
+// GetFollowersURL returns the FollowersURL field if it's non-nil, zero value otherwise.
+func (a *Account) GetFollowersURL() string {
+	if a == nil || a.FollowersURL == nil {
+		return ""
+	}
+	return *a.FollowersURL
+}
+
+// GetFollowing returns the Following field if it's non-nil, zero value otherwise.
+func (a *Account) GetFollowing() int {
+	if a == nil || a.Following == nil {
+		return 0
+	}
+	return *a.Following
+}
+
+// GetFollowingURL returns the FollowingURL field if it's non-nil, zero value otherwise.
+func (a *Account) GetFollowingURL() string {
+	if a == nil || a.FollowingURL == nil {
+		return ""
+	}
+	return *a.FollowingURL
+}
+
+// GetGistsURL returns the GistsURL field if it's non-nil, zero value otherwise.
+func (a *Account) GetGistsURL() string {
+	if a == nil || a.GistsURL == nil {
+		return ""
+	}
+	return *a.GistsURL
+}
+
+// GetGravatarID returns the GravatarID field if it's non-nil, zero value otherwise.
+func (a *Account) GetGravatarID() string {
+	if a == nil || a.GravatarID == nil {
+		return ""
+	}
+	return *a.GravatarID
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (a *Account) GetHTMLURL() string {
+	if a == nil || a.HTMLURL == nil {
+		return ""
+	}
+	return *a.HTMLURL
+}
+
+// GetHireable returns the Hireable field if it's non-nil, zero value otherwise.
+func (a *Account) GetHireable() bool {
+	if a == nil || a.Hireable == nil {
+		return false
+	}
+	return *a.Hireable
+}
+
+// GetHooksURL returns the HooksURL field if it's non-nil, zero value otherwise.
+func (a *Account) GetHooksURL() string {
+	if a == nil || a.HooksURL == nil {
+		return ""
+	}
+	return *a.HooksURL
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (a *Account) GetID() int {
+	if a == nil || a.ID == nil {
+		return 0
+	}
+	return *a.ID
+}
+
+// GetIssuesURL returns the IssuesURL field if it's non-nil, zero value otherwise.
+func (a *Account) GetIssuesURL() string {
+	if a == nil || a.IssuesURL == nil {
+		return ""
+	}
+	return *a.IssuesURL
+}
+
+// GetLocation returns the Location field if it's non-nil, zero value otherwise.
+func (a *Account) GetLocation() string {
+	if a == nil || a.Location == nil {
+		return ""
+	}
+	return *a.Location
+}
+
+// GetLogin returns the Login field if it's non-nil, zero value otherwise.
+func (a *Account) GetLogin() string {
+	if a == nil || a.Login == nil {
+		return ""
+	}
+	return *a.Login
+}
+
+// GetMarketplacePendingChange returns the MarketplacePendingChange field, or nil if a is nil.
+func (a *Account) GetMarketplacePendingChange() *MarketplacePendingChange {
+	if a == nil {
+		return nil
+	}
+	return a.MarketplacePendingChange
+}
+
+// GetMarketplacePurchase returns the MarketplacePurchase field, or nil if a is nil.
+func (a *Account) GetMarketplacePurchase() *MarketplacePurchase {
+	if a == nil {
+		return nil
+	}
+	return a.MarketplacePurchase
+}
+
+// GetMembersURL returns the MembersURL field if it's non-nil, zero value otherwise.
+func (a *Account) GetMembersURL() string {
+	if a == nil || a.MembersURL == nil {
+		return ""
+	}
+	return *a.MembersURL
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (a *Account) GetName() string {
+	if a == nil || a.Name == nil {
+		return ""
+	}
+	return *a.Name
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (a *Account) GetNodeID() string {
+	if a == nil || a.NodeID == nil {
+		return ""
+	}
+	return *a.NodeID
+}
+
+// GetOrganizationBillingEmail returns the OrganizationBillingEmail field if it's non-nil, zero value otherwise.
+func (a *Account) GetOrganizationBillingEmail() string {
+	if a == nil || a.OrganizationBillingEmail == nil {
+		return ""
+	}
+	return *a.OrganizationBillingEmail
+}
+
+// GetOrganizationsURL returns the OrganizationsURL field if it's non-nil, zero value otherwise.
+func (a *Account) GetOrganizationsURL() string {
+	if a == nil || a.OrganizationsURL == nil {
+		return ""
+	}
+	return *a.OrganizationsURL
+}
+
+// GetPublicGists returns the PublicGists field if it's non-nil, zero value otherwise.
+func (a *Account) GetPublicGists() int {
+	if a == nil || a.PublicGists == nil {
+		return 0
+	}
+	return *a.PublicGists
+}
+
+// GetPublicMembersURL returns the PublicMembersURL field if it's non-nil, zero value otherwise.
+func (a *Account) GetPublicMembersURL() string {
+	if a == nil || a.PublicMembersURL == nil {
+		return ""
+	}
+	return *a.PublicMembersURL
+}
+
+// GetPublicRepos returns the PublicRepos field if it's non-nil, zero value otherwise.
+func (a *Account) GetPublicRepos() int {
+	if a == nil || a.PublicRepos == nil {
+		return 0
+	}
+	return *a.PublicRepos
+}
+
+// GetReceivedEventsURL returns the ReceivedEventsURL field if it's non-nil, zero value otherwise.
+func (a *Account) GetReceivedEventsURL() string {
+	if a == nil || a.ReceivedEventsURL == nil {
+		return ""
+	}
+	return *a.ReceivedEventsURL
+}
+
+// GetReposURL returns the ReposURL field if it's non-nil, zero value otherwise.
+func (a *Account) GetReposURL() string {
+	if a == nil || a.ReposURL == nil {
+		return ""
+	}
+	return *a.ReposURL
+}
+
+// GetSiteAdmin returns the SiteAdmin field if it's non-nil, zero value otherwise.
+func (a *Account) GetSiteAdmin() bool {
+	if a == nil || a.SiteAdmin == nil {
+		return false
+	}
+	return *a.SiteAdmin
+}
+
+// GetStarredURL returns the StarredURL field if it's non-nil, zero value otherwise.
+func (a *Account) GetStarredURL() string {
+	if a == nil || a.StarredURL == nil {
+		return ""
+	}
+	return *a.StarredURL
+}
+
+// GetSubscriptionsURL returns the SubscriptionsURL field if it's non-nil, zero value otherwise.
+func (a *Account) GetSubscriptionsURL() string {
+	if a == nil || a.SubscriptionsURL == nil {
+		return ""
+	}
+	return *a.SubscriptionsURL
+}
+
+// GetTwitterUsername returns the TwitterUsername field if it's non-nil, zero value otherwise.
+func (a *Account) GetTwitterUsername() string {
+	if a == nil || a.TwitterUsername == nil {
+		return ""
+	}
+	return *a.TwitterUsername
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (a *Account) GetType() string {
+	if a == nil || a.Type == nil {
+		return ""
+	}
+	return *a.Type
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (a *Account) GetURL() string {
+	if a == nil || a.URL == nil {
+		return ""
+	}
+	return *a.URL
+}
+
+// GetUpdatedAt returns the UpdatedAt field as a time.Time if it's non-nil, zero time otherwise.
+func (a *Account) GetUpdatedAt() time.Time {
+	if a == nil || a.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return a.UpdatedAt.Time()
+}
+
+// GetUsername returns the Username field if it's non-nil, zero value otherwise.
+func (a *Account) GetUsername() string {
+	if a == nil || a.Username == nil {
+		return ""
+	}
+	return *a.Username
+}
+
+// GetCreatedAt returns the CreatedAt field as a time.Time if it's non-nil, zero time otherwise.
+func (a *App) GetCreatedAt() time.Time {
+	if a == nil || a.CreatedAt == nil {
+		return time.Time{}
+	}
+	return a.CreatedAt.Time()
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (a *App) GetDescription() string {
+	if a == nil || a.Description == nil {
+		return ""
+	}
+	return *a.Description
+}
+
+// GetEvents returns the Events field, or nil if a is nil.
+func (a *App) GetEvents() []string {
+	if a == nil {
+		return nil
+	}
+	return a.Events
+}
+
+// GetExternalURL returns the ExternalURL field if it's non-nil, zero value otherwise.
+func (a *App) GetExternalURL() string {
+	if a == nil || a.ExternalURL == nil {
+		return ""
+	}
+	return *a.ExternalURL
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (a *App) GetHTMLURL() string {
+	if a == nil || a.HTMLURL == nil {
+		return ""
+	}
+	return *a.HTMLURL
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (a *App) GetID() int {
+	if a == nil || a.ID == nil {
+		return 0
+	}
+	return *a.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (a *App) GetName() string {
+	if a == nil || a.Name == nil {
+		return ""
+	}
+	return *a.Name
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (a *App) GetNodeID() string {
+	if a == nil || a.NodeID == nil {
+		return ""
+	}
+	return *a.NodeID
+}
+
+// GetOwner returns the Owner field, or nil if a is nil.
+func (a *App) GetOwner() *Account {
+	if a == nil {
+		return nil
+	}
+	return a.Owner
+}
+
+// GetPermissions returns the Permissions field, or nil if a is nil.
+func (a *App) GetPermissions() map[string]string {
+	if a == nil {
+		return nil
+	}
+	return a.Permissions
+}
+
+// GetSlug returns the Slug field if it's non-nil, zero value otherwise.
+func (a *App) GetSlug() string {
+	if a == nil || a.Slug == nil {
+		return ""
+	}
+	return *a.Slug
+}
+
+// GetUpdatedAt returns the UpdatedAt field as a time.Time if it's non-nil, zero time otherwise.
+func (a *App) GetUpdatedAt() time.Time {
+	if a == nil || a.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return a.UpdatedAt.Time()
+}
+
+// GetCommitMessage returns the CommitMessage field if it's non-nil, zero value otherwise.
+func (a *AutoMerge) GetCommitMessage() string {
+	if a == nil || a.CommitMessage == nil {
+		return ""
+	}
+	return *a.CommitMessage
+}
+
+// GetCommitTitle returns the CommitTitle field if it's non-nil, zero value otherwise.
+func (a *AutoMerge) GetCommitTitle() string {
+	if a == nil || a.CommitTitle == nil {
+		return ""
+	}
+	return *a.CommitTitle
+}
+
+// GetEnabledBy returns the EnabledBy field, or nil if a is nil.
+func (a *AutoMerge) GetEnabledBy() *Account {
+	if a == nil {
+		return nil
+	}
+	return a.EnabledBy
+}
+
+// GetMergeMethod returns the MergeMethod field if it's non-nil, zero value otherwise.
+func (a *AutoMerge) GetMergeMethod() string {
+	if a == nil || a.MergeMethod == nil {
+		return ""
+	}
+	return *a.MergeMethod
+}
+
+// GetRef returns the Ref field, or nil if b is nil.
+func (b *BaseChanges) GetRef() *ChangeFrom {
+	if b == nil {
+		return nil
+	}
+	return b.Ref
+}
+
+// GetSHA returns the SHA field, or nil if b is nil.
+func (b *BaseChanges) GetSHA() *ChangeFrom {
+	if b == nil {
+		return nil
+	}
+	return b.SHA
+}
+
+// GetFrom returns the From field if it's non-nil, zero value otherwise.
+func (c *ChangeFrom) GetFrom() string {
+	if c == nil || c.From == nil {
+		return ""
+	}
+	return *c.From
+}
+
+// GetBase returns the Base field, or nil if c is nil.
+func (c *Changes) GetBase() *BaseChanges {
+	if c == nil {
+		return nil
+	}
+	return c.Base
+}
+
+// GetBody returns the Body field, or nil if c is nil.
+func (c *Changes) GetBody() *ChangeFrom {
+	if c == nil {
+		return nil
+	}
+	return c.Body
+}
+
+// GetColor returns the Color field, or nil if c is nil.
+func (c *Changes) GetColor() *ChangeFrom {
+	if c == nil {
+		return nil
+	}
+	return c.Color
+}
+
+// GetDescription returns the Description field, or nil if c is nil.
+func (c *Changes) GetDescription() *ChangeFrom {
+	if c == nil {
+		return nil
+	}
+	return c.Description
+}
+
+// GetName returns the Name field, or nil if c is nil.
+func (c *Changes) GetName() *ChangeFrom {
+	if c == nil {
+		return nil
+	}
+	return c.Name
+}
+
+// GetTitle returns the Title field, or nil if c is nil.
+func (c *Changes) GetTitle() *ChangeFrom {
+	if c == nil {
+		return nil
+	}
+	return c.Title
+}
+
+// GetAdded returns the Added field, or nil if c is nil.
+func (c *Commit) GetAdded() []string {
+	if c == nil {
+		return nil
+	}
+	return c.Added
+}
+
+// GetAuthor returns the Author field, or nil if c is nil.
+func (c *Commit) GetAuthor() *Account {
+	if c == nil {
+		return nil
+	}
+	return c.Author
+}
+
+// GetCommentsURL returns the CommentsURL field if it's non-nil, zero value otherwise.
+func (c *Commit) GetCommentsURL() string {
+	if c == nil || c.CommentsURL == nil {
+		return ""
+	}
+	return *c.CommentsURL
+}
+
+// GetCommit returns the Commit field, or nil if c is nil.
+func (c *Commit) GetCommit() *CommitData {
+	if c == nil {
+		return nil
+	}
+	return c.Commit
+}
+
+// GetCommitter returns the Committer field, or nil if c is nil.
+func (c *Commit) GetCommitter() *Account {
+	if c == nil {
+		return nil
+	}
+	return c.Committer
+}
+
+// GetDistinct returns the Distinct field if it's non-nil, zero value otherwise.
+func (c *Commit) GetDistinct() bool {
+	if c == nil || c.Distinct == nil {
+		return false
+	}
+	return *c.Distinct
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (c *Commit) GetHTMLURL() string {
+	if c == nil || c.HTMLURL == nil {
+		return ""
+	}
+	return *c.HTMLURL
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (c *Commit) GetID() string {
+	if c == nil || c.ID == nil {
+		return ""
+	}
+	return *c.ID
+}
+
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (c *Commit) GetMessage() string {
+	if c == nil || c.Message == nil {
+		return ""
+	}
+	return *c.Message
+}
+
+// GetModified returns the Modified field, or nil if c is nil.
+func (c *Commit) GetModified() []string {
+	if c == nil {
+		return nil
+	}
+	return c.Modified
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (c *Commit) GetNodeID() string {
+	if c == nil || c.NodeID == nil {
+		return ""
+	}
+	return *c.NodeID
+}
+
+// GetParents returns the Parents field, or nil if c is nil.
+func (c *Commit) GetParents() []TreeObject {
+	if c == nil {
+		return nil
+	}
+	return c.Parents
+}
+
+// GetRemoved returns the Removed field, or nil if c is nil.
+func (c *Commit) GetRemoved() []string {
+	if c == nil {
+		return nil
+	}
+	return c.Removed
+}
+
+// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
+func (c *Commit) GetSHA() string {
+	if c == nil || c.SHA == nil {
+		return ""
+	}
+	return *c.SHA
+}
+
+// GetTimestamp returns the Timestamp field as a time.Time if it's non-nil, zero time otherwise.
+func (c *Commit) GetTimestamp() time.Time {
+	if c == nil || c.Timestamp == nil {
+		return time.Time{}
+	}
+	return c.Timestamp.Time()
+}
+
+// GetTreeID returns the TreeID field if it's non-nil, zero value otherwise.
+func (c *Commit) GetTreeID() string {
+	if c == nil || c.TreeID == nil {
+		return ""
+	}
+	return *c.TreeID
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (c *Commit) GetURL() string {
+	if c == nil || c.URL == nil {
+		return ""
+	}
+	return *c.URL
+}
+
+// GetAuthor returns the Author field, or nil if c is nil.
+func (c *CommitData) GetAuthor() *CommitUser {
+	if c == nil {
+		return nil
+	}
+	return c.Author
+}
+
+// GetCommentCount returns the CommentCount field if it's non-nil, zero value otherwise.
+func (c *CommitData) GetCommentCount() int {
+	if c == nil || c.CommentCount == nil {
+		return 0
+	}
+	return *c.CommentCount
+}
+
+// GetCommitter returns the Committer field, or nil if c is nil.
+func (c *CommitData) GetCommitter() *CommitUser {
+	if c == nil {
+		return nil
+	}
+	return c.Committer
+}
+
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (c *CommitData) GetMessage() string {
+	if c == nil || c.Message == nil {
+		return ""
+	}
+	return *c.Message
+}
+
+// GetTree returns the Tree field, or nil if c is nil.
+func (c *CommitData) GetTree() *TreeObject {
+	if c == nil {
+		return nil
+	}
+	return c.Tree
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (c *CommitData) GetURL() string {
+	if c == nil || c.URL == nil {
+		return ""
+	}
+	return *c.URL
+}
+
+// GetVerification returns the Verification field, or nil if c is nil.
+func (c *CommitData) GetVerification() *VerificationObject {
+	if c == nil {
+		return nil
+	}
+	return c.Verification
+}
+
+// GetDate returns the Date field as a time.Time if it's non-nil, zero time otherwise.
+func (c *CommitUser) GetDate() time.Time {
+	if c == nil || c.Date == nil {
+		return time.Time{}
+	}
+	return c.Date.Time()
+}
+
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (c *CommitUser) GetEmail() string {
+	if c == nil || c.Email == nil {
+		return ""
+	}
+	return *c.Email
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (c *CommitUser) GetName() string {
+	if c == nil || c.Name == nil {
+		return ""
+	}
+	return *c.Name
+}
+
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (e *EmailUser) GetEmail() string {
+	if e == nil || e.Email == nil {
+		return ""
+	}
+	return *e.Email
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (e *EmailUser) GetName() string {
+	if e == nil || e.Name == nil {
+		return ""
+	}
+	return *e.Name
+}
+
+// GetForkee returns the Forkee field, or nil if f is nil.
+func (f *ForkEvent) GetForkee() *Repository {
+	if f == nil {
+		return nil
+	}
+	return f.Forkee
+}
+
+// GetInstallation returns the Installation field, or nil if f is nil.
+func (f *ForkEvent) GetInstallation() *Installation {
+	if f == nil {
+		return nil
+	}
+	return f.Installation
+}
+
+// GetOrganization returns the Organization field, or nil if f is nil.
+func (f *ForkEvent) GetOrganization() *Account {
+	if f == nil {
+		return nil
+	}
+	return f.Organization
+}
+
+// GetRepository returns the Repository field, or nil if f is nil.
+func (f *ForkEvent) GetRepository() *Repository {
+	if f == nil {
+		return nil
+	}
+	return f.Repository
+}
+
+// GetSender returns the Sender field, or nil if f is nil.
+func (f *ForkEvent) GetSender() *Account {
+	if f == nil {
+		return nil
+	}
+	return f.Sender
+}
+
+// GetActive returns the Active field if it's non-nil, zero value otherwise.
+func (h *Hook) GetActive() bool {
+	if h == nil || h.Active == nil {
+		return false
+	}
+	return *h.Active
+}
+
+// GetAppID returns the AppID field if it's non-nil, zero value otherwise.
+func (h *Hook) GetAppID() int {
+	if h == nil || h.AppID == nil {
+		return 0
+	}
+	return *h.AppID
+}
+
+// GetConfig returns the Config field, or nil if h is nil.
+func (h *Hook) GetConfig() *HookConfig {
+	if h == nil {
+		return nil
+	}
+	return h.Config
+}
+
+// GetCreatedAt returns the CreatedAt field as a time.Time if it's non-nil, zero time otherwise.
+func (h *Hook) GetCreatedAt() time.Time {
+	if h == nil || h.CreatedAt == nil {
+		return time.Time{}
+	}
+	return h.CreatedAt.Time()
+}
+
+// GetDeliveriesURL returns the DeliveriesURL field if it's non-nil, zero value otherwise.
+func (h *Hook) GetDeliveriesURL() string {
+	if h == nil || h.DeliveriesURL == nil {
+		return ""
+	}
+	return *h.DeliveriesURL
+}
+
+// GetEvents returns the Events field, or nil if h is nil.
+func (h *Hook) GetEvents() []string {
+	if h == nil {
+		return nil
+	}
+	return h.Events
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (h *Hook) GetID() int {
+	if h == nil || h.ID == nil {
+		return 0
+	}
+	return *h.ID
+}
+
+// GetLastResponse returns the LastResponse field, or nil if h is nil.
+func (h *Hook) GetLastResponse() *HookResponse {
+	if h == nil {
+		return nil
+	}
+	return h.LastResponse
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (h *Hook) GetName() string {
+	if h == nil || h.Name == nil {
+		return ""
+	}
+	return *h.Name
+}
+
+// GetPingURL returns the PingURL field if it's non-nil, zero value otherwise.
+func (h *Hook) GetPingURL() string {
+	if h == nil || h.PingURL == nil {
+		return ""
+	}
+	return *h.PingURL
+}
+
+// GetTestURL returns the TestURL field if it's non-nil, zero value otherwise.
+func (h *Hook) GetTestURL() string {
+	if h == nil || h.TestURL == nil {
+		return ""
+	}
+	return *h.TestURL
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (h *Hook) GetType() string {
+	if h == nil || h.Type == nil {
+		return ""
+	}
+	return *h.Type
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (h *Hook) GetURL() string {
+	if h == nil || h.URL == nil {
+		return ""
+	}
+	return *h.URL
+}
+
+// GetUpdatedAt returns the UpdatedAt field as a time.Time if it's non-nil, zero time otherwise.
+func (h *Hook) GetUpdatedAt() time.Time {
+	if h == nil || h.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return h.UpdatedAt.Time()
+}
+
+// GetContentType returns the ContentType field if it's non-nil, zero value otherwise.
+func (h *HookConfig) GetContentType() string {
+	if h == nil || h.ContentType == nil {
+		return ""
+	}
+	return *h.ContentType
+}
+
+// GetInsecureSSL returns the InsecureSSL field if it's non-nil, zero value otherwise.
+func (h *HookConfig) GetInsecureSSL() string {
+	if h == nil || h.InsecureSSL == nil {
+		return ""
+	}
+	return *h.InsecureSSL
+}
+
+// GetSecret returns the Secret field if it's non-nil, zero value otherwise.
+func (h *HookConfig) GetSecret() string {
+	if h == nil || h.Secret == nil {
+		return ""
+	}
+	return *h.Secret
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (h *HookConfig) GetURL() string {
+	if h == nil || h.URL == nil {
+		return ""
+	}
+	return *h.URL
+}
+
+// GetCode returns the Code field if it's non-nil, zero value otherwise.
+func (h *HookResponse) GetCode() int {
+	if h == nil || h.Code == nil {
+		return 0
+	}
+	return *h.Code
+}
+
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (h *HookResponse) GetMessage() string {
+	if h == nil || h.Message == nil {
+		return ""
+	}
+	return *h.Message
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (h *HookResponse) GetStatus() string {
+	if h == nil || h.Status == nil {
+		return ""
+	}
+	return *h.Status
+}
+
+// GetAccessTokensURL returns the AccessTokensURL field if it's non-nil, zero value otherwise.
+func (i *Installation) GetAccessTokensURL() string {
+	if i == nil || i.AccessTokensURL == nil {
+		return ""
+	}
+	return *i.AccessTokensURL
+}
+
+// GetAccount returns the Account field, or nil if i is nil.
+func (i *Installation) GetAccount() *Account {
+	if i == nil {
+		return nil
+	}
+	return i.Account
+}
+
+// GetAppID returns the AppID field if it's non-nil, zero value otherwise.
+func (i *Installation) GetAppID() int {
+	if i == nil || i.AppID == nil {
+		return 0
+	}
+	return *i.AppID
+}
+
+// GetAppSlug returns the AppSlug field if it's non-nil, zero value otherwise.
+func (i *Installation) GetAppSlug() string {
+	if i == nil || i.AppSlug == nil {
+		return ""
+	}
+	return *i.AppSlug
+}
+
+// GetCreatedAt returns the CreatedAt field as a time.Time if it's non-nil, zero time otherwise.
+func (i *Installation) GetCreatedAt() time.Time {
+	if i == nil || i.CreatedAt == nil {
+		return time.Time{}
+	}
+	return i.CreatedAt.Time()
+}
+
+// GetEvents returns the Events field, or nil if i is nil.
+func (i *Installation) GetEvents() []string {
+	if i == nil {
+		return nil
+	}
+	return i.Events
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (i *Installation) GetHTMLURL() string {
+	if i == nil || i.HTMLURL == nil {
+		return ""
+	}
+	return *i.HTMLURL
+}
+
+// GetHasMultipleSingleFiles returns the HasMultipleSingleFiles field if it's non-nil, zero value otherwise.
+func (i *Installation) GetHasMultipleSingleFiles() bool {
+	if i == nil || i.HasMultipleSingleFiles == nil {
+		return false
+	}
+	return *i.HasMultipleSingleFiles
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (i *Installation) GetID() int {
+	if i == nil || i.ID == nil {
+		return 0
+	}
+	return *i.ID
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (i *Installation) GetNodeID() string {
+	if i == nil || i.NodeID == nil {
+		return ""
+	}
+	return *i.NodeID
+}
+
+// GetPermissions returns the Permissions field, or nil if i is nil.
+func (i *Installation) GetPermissions() map[string]string {
+	if i == nil {
+		return nil
+	}
+	return i.Permissions
+}
+
+// GetRepositoriesURL returns the RepositoriesURL field if it's non-nil, zero value otherwise.
+func (i *Installation) GetRepositoriesURL() string {
+	if i == nil || i.RepositoriesURL == nil {
+		return ""
+	}
+	return *i.RepositoriesURL
+}
+
+// GetRepositorySelection returns the RepositorySelection field if it's non-nil, zero value otherwise.
+func (i *Installation) GetRepositorySelection() string {
+	if i == nil || i.RepositorySelection == nil {
+		return ""
+	}
+	return *i.RepositorySelection
+}
+
+// GetSingleFileName returns the SingleFileName field if it's non-nil, zero value otherwise.
+func (i *Installation) GetSingleFileName() string {
+	if i == nil || i.SingleFileName == nil {
+		return ""
+	}
+	return *i.SingleFileName
+}
+
+// GetSingleFilePaths returns the SingleFilePaths field, or nil if i is nil.
+func (i *Installation) GetSingleFilePaths() []string {
+	if i == nil {
+		return nil
+	}
+	return i.SingleFilePaths
+}
+
+// GetSuspendedAt returns the SuspendedAt field as a time.Time if it's non-nil, zero time otherwise.
+func (i *Installation) GetSuspendedAt() time.Time {
+	if i == nil || i.SuspendedAt == nil {
+		return time.Time{}
+	}
+	return i.SuspendedAt.Time()
+}
+
+// GetSuspendedBy returns the SuspendedBy field if it's non-nil, zero value otherwise.
+func (i *Installation) GetSuspendedBy() string {
+	if i == nil || i.SuspendedBy == nil {
+		return ""
+	}
+	return *i.SuspendedBy
+}
+
+// GetTargetID returns the TargetID field if it's non-nil, zero value otherwise.
+func (i *Installation) GetTargetID() int {
+	if i == nil || i.TargetID == nil {
+		return 0
+	}
+	return *i.TargetID
+}
+
+// GetTargetType returns the TargetType field if it's non-nil, zero value otherwise.
+func (i *Installation) GetTargetType() string {
+	if i == nil || i.TargetType == nil {
+		return ""
+	}
+	return *i.TargetType
+}
+
+// GetUpdatedAt returns the UpdatedAt field as a time.Time if it's non-nil, zero time otherwise.
+func (i *Installation) GetUpdatedAt() time.Time {
+	if i == nil || i.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return i.UpdatedAt.Time()
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (i *InstallationEvent) GetAction() string {
+	if i == nil || i.Action == nil {
+		return ""
+	}
+	return *i.Action
+}
+
+// GetInstallation returns the Installation field, or nil if i is nil.
+func (i *InstallationEvent) GetInstallation() *Installation {
+	if i == nil {
+		return nil
+	}
+	return i.Installation
+}
+
+// GetRepositories returns the Repositories field, or nil if i is nil.
+func (i *InstallationEvent) GetRepositories() []Repository {
+	if i == nil {
+		return nil
+	}
+	return i.Repositories
+}
+
+// GetRequester returns the Requester field, or nil if i is nil.
+func (i *InstallationEvent) GetRequester() *Account {
+	if i == nil {
+		return nil
+	}
+	return i.Requester
+}
+
+// GetSender returns the Sender field, or nil if i is nil.
+func (i *InstallationEvent) GetSender() *Account {
+	if i == nil {
+		return nil
+	}
+	return i.Sender
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (i *InstallationRepositoriesEvent) GetAction() string {
+	if i == nil || i.Action == nil {
+		return ""
+	}
+	return *i.Action
+}
+
+// GetInstallation returns the Installation field, or nil if i is nil.
+func (i *InstallationRepositoriesEvent) GetInstallation() *Installation {
+	if i == nil {
+		return nil
+	}
+	return i.Installation
+}
+
+// GetRepositoriesAdded returns the RepositoriesAdded field, or nil if i is nil.
+func (i *InstallationRepositoriesEvent) GetRepositoriesAdded() []Repository {
+	if i == nil {
+		return nil
+	}
+	return i.RepositoriesAdded
+}
+
+// GetRepositoriesRemoved returns the RepositoriesRemoved field, or nil if i is nil.
+func (i *InstallationRepositoriesEvent) GetRepositoriesRemoved() []Repository {
+	if i == nil {
+		return nil
+	}
+	return i.RepositoriesRemoved
+}
+
+// GetRepositorySelection returns the RepositorySelection field if it's non-nil, zero value otherwise.
+func (i *InstallationRepositoriesEvent) GetRepositorySelection() string {
+	if i == nil || i.RepositorySelection == nil {
+		return ""
+	}
+	return *i.RepositorySelection
+}
+
+// GetRequester returns the Requester field, or nil if i is nil.
+func (i *InstallationRepositoriesEvent) GetRequester() *Account {
+	if i == nil {
+		return nil
+	}
+	return i.Requester
+}
+
+// GetSender returns the Sender field, or nil if i is nil.
+func (i *InstallationRepositoriesEvent) GetSender() *Account {
+	if i == nil {
+		return nil
+	}
+	return i.Sender
+}
+
+// GetRepositories returns the Repositories field, or nil if i is nil.
+func (i *InstallationRepositoriesResponse) GetRepositories() []Repository {
+	if i == nil {
+		return nil
+	}
+	return i.Repositories
+}
+
+// GetRepositorySelection returns the RepositorySelection field if it's non-nil, zero value otherwise.
+func (i *InstallationRepositoriesResponse) GetRepositorySelection() string {
+	if i == nil || i.RepositorySelection == nil {
+		return ""
+	}
+	return *i.RepositorySelection
+}
+
+// GetTotalCount returns the TotalCount field, or 0 if i is nil.
+func (i *InstallationRepositoriesResponse) GetTotalCount() int {
+	if i == nil {
+		return 0
+	}
+	return i.TotalCount
+}
+
+// GetExpiresAt returns the ExpiresAt field as a time.Time if it's non-nil, zero time otherwise.
+func (i *InstallationToken) GetExpiresAt() time.Time {
+	if i == nil || i.ExpiresAt == nil {
+		return time.Time{}
+	}
+	return i.ExpiresAt.Time()
+}
+
+// GetPermissions returns the Permissions field, or nil if i is nil.
+func (i *InstallationToken) GetPermissions() map[string]string {
+	if i == nil {
+		return nil
+	}
+	return i.Permissions
+}
+
+// GetRepositories returns the Repositories field, or nil if i is nil.
+func (i *InstallationToken) GetRepositories() []Repository {
+	if i == nil {
+		return nil
+	}
+	return i.Repositories
+}
+
+// GetRepositorySelection returns the RepositorySelection field if it's non-nil, zero value otherwise.
+func (i *InstallationToken) GetRepositorySelection() string {
+	if i == nil || i.RepositorySelection == nil {
+		return ""
+	}
+	return *i.RepositorySelection
+}
+
+// GetToken returns the Token field if it's non-nil, zero value otherwise.
+func (i *InstallationToken) GetToken() string {
+	if i == nil || i.Token == nil {
+		return ""
+	}
+	return *i.Token
+}
+
+// GetActiveLockReason returns the ActiveLockReason field if it's non-nil, zero value otherwise.
+func (i *Issue) GetActiveLockReason() string {
+	if i == nil || i.ActiveLockReason == nil {
+		return ""
+	}
+	return *i.ActiveLockReason
+}
+
+// GetAssignee returns the Assignee field, or nil if i is nil.
+func (i *Issue) GetAssignee() *Account {
+	if i == nil {
+		return nil
+	}
+	return i.Assignee
+}
+
+// GetAssignees returns the Assignees field, or nil if i is nil.
+func (i *Issue) GetAssignees() []Account {
+	if i == nil {
+		return nil
+	}
+	return i.Assignees
+}
+
+// GetAuthorAssociation returns the AuthorAssociation field if it's non-nil, zero value otherwise.
+func (i *Issue) GetAuthorAssociation() string {
+	if i == nil || i.AuthorAssociation == nil {
+		return ""
+	}
+	return *i.AuthorAssociation
+}
+
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (i *Issue) GetBody() string {
+	if i == nil || i.Body == nil {
+		return ""
+	}
+	return *i.Body
+}
+
+// GetClosedAt returns the ClosedAt field as a time.Time if it's non-nil, zero time otherwise.
+func (i *Issue) GetClosedAt() time.Time {
+	if i == nil || i.ClosedAt == nil {
+		return time.Time{}
+	}
+	return i.ClosedAt.Time()
+}
+
+// GetClosedBy returns the ClosedBy field, or nil if i is nil.
+func (i *Issue) GetClosedBy() *Account {
+	if i == nil {
+		return nil
+	}
+	return i.ClosedBy
+}
+
+// GetComments returns the Comments field if it's non-nil, zero value otherwise.
+func (i *Issue) GetComments() int {
+	if i == nil || i.Comments == nil {
+		return 0
+	}
+	return *i.Comments
+}
+
+// GetCommentsURL returns the CommentsURL field if it's non-nil, zero value otherwise.
+func (i *Issue) GetCommentsURL() string {
+	if i == nil || i.CommentsURL == nil {
+		return ""
+	}
+	return *i.CommentsURL
+}
+
+// GetCreatedAt returns the CreatedAt field as a time.Time if it's non-nil, zero time otherwise.
+func (i *Issue) GetCreatedAt() time.Time {
+	if i == nil || i.CreatedAt == nil {
+		return time.Time{}
+	}
+	return i.CreatedAt.Time()
+}
+
+// GetEventsURL returns the EventsURL field if it's non-nil, zero value otherwise.
+func (i *Issue) GetEventsURL() string {
+	if i == nil || i.EventsURL == nil {
+		return ""
+	}
+	return *i.EventsURL
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (i *Issue) GetHTMLURL() string {
+	if i == nil || i.HTMLURL == nil {
+		return ""
+	}
+	return *i.HTMLURL
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (i *Issue) GetID() int {
+	if i == nil || i.ID == nil {
+		return 0
+	}
+	return *i.ID
+}
+
+// GetLabels returns the Labels field, or nil if i is nil.
+func (i *Issue) GetLabels() []Label {
+	if i == nil {
+		return nil
+	}
+	return i.Labels
+}
+
+// GetLabelsURL returns the LabelsURL field if it's non-nil, zero value otherwise.
+func (i *Issue) GetLabelsURL() string {
+	if i == nil || i.LabelsURL == nil {
+		return ""
+	}
+	return *i.LabelsURL
+}
+
+// GetLocked returns the Locked field if it's non-nil, zero value otherwise.
+func (i *Issue) GetLocked() bool {
+	if i == nil || i.Locked == nil {
+		return false
+	}
+	return *i.Locked
+}
+
+// GetMilestone returns the Milestone field, or nil if i is nil.
+func (i *Issue) GetMilestone() *Milestone {
+	if i == nil {
+		return nil
+	}
+	return i.Milestone
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (i *Issue) GetNodeID() string {
+	if i == nil || i.NodeID == nil {
+		return ""
+	}
+	return *i.NodeID
+}
+
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (i *Issue) GetNumber() int {
+	if i == nil || i.Number == nil {
+		return 0
+	}
+	return *i.Number
+}
+
+// GetPerformedViaGithubApp returns the PerformedViaGithubApp field, or nil if i is nil.
+func (i *Issue) GetPerformedViaGithubApp() *App {
+	if i == nil {
+		return nil
+	}
+	return i.PerformedViaGithubApp
+}
+
+// GetPullRequest returns the PullRequest field, or nil if i is nil.
+func (i *Issue) GetPullRequest() *IssuePullRequest {
+	if i == nil {
+		return nil
+	}
+	return i.PullRequest
+}
+
+// GetRepositoryURL returns the RepositoryURL field if it's non-nil, zero value otherwise.
+func (i *Issue) GetRepositoryURL() string {
+	if i == nil || i.RepositoryURL == nil {
+		return ""
+	}
+	return *i.RepositoryURL
+}
+
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (i *Issue) GetState() string {
+	if i == nil || i.State == nil {
+		return ""
+	}
+	return *i.State
+}
+
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (i *Issue) GetTitle() string {
+	if i == nil || i.Title == nil {
+		return ""
+	}
+	return *i.Title
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (i *Issue) GetURL() string {
+	if i == nil || i.URL == nil {
+		return ""
+	}
+	return *i.URL
+}
+
+// GetUpdatedAt returns the UpdatedAt field as a time.Time if it's non-nil, zero time otherwise.
+func (i *Issue) GetUpdatedAt() time.Time {
+	if i == nil || i.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return i.UpdatedAt.Time()
+}
+
+// GetUser returns the User field, or nil if i is nil.
+func (i *Issue) GetUser() *Account {
+	if i == nil {
+		return nil
+	}
+	return i.User
+}
+
+// GetAuthorAssociation returns the AuthorAssociation field if it's non-nil, zero value otherwise.
+func (i *IssueComment) GetAuthorAssociation() string {
+	if i == nil || i.AuthorAssociation == nil {
+		return ""
+	}
+	return *i.AuthorAssociation
+}
+
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (i *IssueComment) GetBody() string {
+	if i == nil || i.Body == nil {
+		return ""
+	}
+	return *i.Body
+}
+
+// GetCreatedAt returns the CreatedAt field as a time.Time if it's non-nil, zero time otherwise.
+func (i *IssueComment) GetCreatedAt() time.Time {
+	if i == nil || i.CreatedAt == nil {
+		return time.Time{}
+	}
+	return i.CreatedAt.Time()
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (i *IssueComment) GetHTMLURL() string {
+	if i == nil || i.HTMLURL == nil {
+		return ""
+	}
+	return *i.HTMLURL
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (i *IssueComment) GetID() int {
+	if i == nil || i.ID == nil {
+		return 0
+	}
+	return *i.ID
+}
+
+// GetIssueURL returns the IssueURL field if it's non-nil, zero value otherwise.
+func (i *IssueComment) GetIssueURL() string {
+	if i == nil || i.IssueURL == nil {
+		return ""
+	}
+	return *i.IssueURL
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (i *IssueComment) GetNodeID() string {
+	if i == nil || i.NodeID == nil {
+		return ""
+	}
+	return *i.NodeID
+}
+
+// GetPerformedViaGithubApp returns the PerformedViaGithubApp field, or nil if i is nil.
+func (i *IssueComment) GetPerformedViaGithubApp() *App {
+	if i == nil {
+		return nil
+	}
+	return i.PerformedViaGithubApp
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (i *IssueComment) GetURL() string {
+	if i == nil || i.URL == nil {
+		return ""
+	}
+	return *i.URL
+}
+
+// GetUpdatedAt returns the UpdatedAt field as a time.Time if it's non-nil, zero time otherwise.
+func (i *IssueComment) GetUpdatedAt() time.Time {
+	if i == nil || i.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return i.UpdatedAt.Time()
+}
+
+// GetUser returns the User field, or nil if i is nil.
+func (i *IssueComment) GetUser() *Account {
+	if i == nil {
+		return nil
+	}
+	return i.User
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (i *IssueCommentEvent) GetAction() string {
+	if i == nil || i.Action == nil {
+		return ""
+	}
+	return *i.Action
+}
+
+// GetChanges returns the Changes field, or nil if i is nil.
+func (i *IssueCommentEvent) GetChanges() *Changes {
+	if i == nil {
+		return nil
+	}
+	return i.Changes
+}
+
+// GetComment returns the Comment field, or nil if i is nil.
+func (i *IssueCommentEvent) GetComment() *IssueComment {
+	if i == nil {
+		return nil
+	}
+	return i.Comment
+}
+
+// GetInstallation returns the Installation field, or nil if i is nil.
+func (i *IssueCommentEvent) GetInstallation() *Installation {
+	if i == nil {
+		return nil
+	}
+	return i.Installation
+}
+
+// GetIssue returns the Issue field, or nil if i is nil.
+func (i *IssueCommentEvent) GetIssue() *Issue {
+	if i == nil {
+		return nil
+	}
+	return i.Issue
+}
+
+// GetOrganization returns the Organization field, or nil if i is nil.
+func (i *IssueCommentEvent) GetOrganization() *Account {
+	if i == nil {
+		return nil
+	}
+	return i.Organization
+}
+
+// GetRepository returns the Repository field, or nil if i is nil.
+func (i *IssueCommentEvent) GetRepository() *Repository {
+	if i == nil {
+		return nil
+	}
+	return i.Repository
+}
+
+// GetSender returns the Sender field, or nil if i is nil.
+func (i *IssueCommentEvent) GetSender() *Account {
+	if i == nil {
+		return nil
+	}
+	return i.Sender
+}
+
+// GetDiffURL returns the DiffURL field if it's non-nil, zero value otherwise.
+func (i *IssuePullRequest) GetDiffURL() string {
+	if i == nil || i.DiffURL == nil {
+		return ""
+	}
+	return *i.DiffURL
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (i *IssuePullRequest) GetHTMLURL() string {
+	if i == nil || i.HTMLURL == nil {
+		return ""
+	}
+	return *i.HTMLURL
+}
+
+// GetPatchURL returns the PatchURL field if it's non-nil, zero value otherwise.
+func (i *IssuePullRequest) GetPatchURL() string {
+	if i == nil || i.PatchURL == nil {
+		return ""
+	}
+	return *i.PatchURL
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (i *IssuePullRequest) GetURL() string {
+	if i == nil || i.URL == nil {
+		return ""
+	}
+	return *i.URL
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (i *IssuesEvent) GetAction() string {
+	if i == nil || i.Action == nil {
+		return ""
+	}
+	return *i.Action
+}
+
+// GetAssignee returns the Assignee field, or nil if i is nil.
+func (i *IssuesEvent) GetAssignee() *Account {
+	if i == nil {
+		return nil
+	}
+	return i.Assignee
+}
+
+// GetChanges returns the Changes field, or nil if i is nil.
+func (i *IssuesEvent) GetChanges() *Changes {
+	if i == nil {
+		return nil
+	}
+	return i.Changes
+}
+
+// GetInstallation returns the Installation field, or nil if i is nil.
+func (i *IssuesEvent) GetInstallation() *Installation {
+	if i == nil {
+		return nil
+	}
+	return i.Installation
+}
+
+// GetIssue returns the Issue field, or nil if i is nil.
+func (i *IssuesEvent) GetIssue() *Issue {
+	if i == nil {
+		return nil
+	}
+	return i.Issue
+}
+
+// GetLabel returns the Label field, or nil if i is nil.
+func (i *IssuesEvent) GetLabel() *Label {
+	if i == nil {
+		return nil
+	}
+	return i.Label
+}
+
+// GetMilestone returns the Milestone field, or nil if i is nil.
+func (i *IssuesEvent) GetMilestone() *Milestone {
+	if i == nil {
+		return nil
+	}
+	return i.Milestone
+}
+
+// GetOrganization returns the Organization field, or nil if i is nil.
+func (i *IssuesEvent) GetOrganization() *Account {
+	if i == nil {
+		return nil
+	}
+	return i.Organization
+}
+
+// GetRepository returns the Repository field, or nil if i is nil.
+func (i *IssuesEvent) GetRepository() *Repository {
+	if i == nil {
+		return nil
+	}
+	return i.Repository
+}
+
+// GetSender returns the Sender field, or nil if i is nil.
+func (i *IssuesEvent) GetSender() *Account {
+	if i == nil {
+		return nil
+	}
+	return i.Sender
+}
+
+// GetColor returns the Color field if it's non-nil, zero value otherwise.
+func (l *Label) GetColor() string {
+	if l == nil || l.Color == nil {
+		return ""
+	}
+	return *l.Color
+}
+
+// GetDefault returns the Default field if it's non-nil, zero value otherwise.
+func (l *Label) GetDefault() bool {
+	if l == nil || l.Default == nil {
+		return false
+	}
+	return *l.Default
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (l *Label) GetDescription() string {
+	if l == nil || l.Description == nil {
+		return ""
+	}
+	return *l.Description
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (l *Label) GetID() int {
+	if l == nil || l.ID == nil {
+		return 0
+	}
+	return *l.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (l *Label) GetName() string {
+	if l == nil || l.Name == nil {
+		return ""
+	}
+	return *l.Name
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (l *Label) GetNodeID() string {
+	if l == nil || l.NodeID == nil {
+		return ""
+	}
+	return *l.NodeID
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (l *Label) GetURL() string {
+	if l == nil || l.URL == nil {
+		return ""
+	}
+	return *l.URL
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (l *LabelEvent) GetAction() string {
+	if l == nil || l.Action == nil {
+		return ""
+	}
+	return *l.Action
+}
+
+// GetChanges returns the Changes field, or nil if l is nil.
+func (l *LabelEvent) GetChanges() *Changes {
+	if l == nil {
+		return nil
+	}
+	return l.Changes
+}
+
+// GetInstallation returns the Installation field, or nil if l is nil.
+func (l *LabelEvent) GetInstallation() *Installation {
+	if l == nil {
+		return nil
+	}
+	return l.Installation
+}
+
+// GetLabel returns the Label field, or nil if l is nil.
+func (l *LabelEvent) GetLabel() *Label {
+	if l == nil {
+		return nil
+	}
+	return l.Label
+}
+
+// GetOrganization returns the Organization field, or nil if l is nil.
+func (l *LabelEvent) GetOrganization() *Account {
+	if l == nil {
+		return nil
+	}
+	return l.Organization
+}
+
+// GetRepository returns the Repository field, or nil if l is nil.
+func (l *LabelEvent) GetRepository() *Repository {
+	if l == nil {
+		return nil
+	}
+	return l.Repository
+}
+
+// GetSender returns the Sender field, or nil if l is nil.
+func (l *LabelEvent) GetSender() *Account {
+	if l == nil {
+		return nil
+	}
+	return l.Sender
+}
+
+// GetKey returns the Key field if it's non-nil, zero value otherwise.
+func (l *License) GetKey() string {
+	if l == nil || l.Key == nil {
+		return ""
+	}
+	return *l.Key
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (l *License) GetName() string {
+	if l == nil || l.Name == nil {
+		return ""
+	}
+	return *l.Name
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (l *License) GetNodeID() string {
+	if l == nil || l.NodeID == nil {
+		return ""
+	}
+	return *l.NodeID
+}
+
+// GetSpdxID returns the SpdxID field if it's non-nil, zero value otherwise.
+func (l *License) GetSpdxID() string {
+	if l == nil || l.SpdxID == nil {
+		return ""
+	}
+	return *l.SpdxID
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (l *License) GetURL() string {
+	if l == nil || l.URL == nil {
+		return ""
+	}
+	return *l.URL
+}
+
+// GetHref returns the Href field if it's non-nil, zero value otherwise.
+func (l *Link) GetHref() string {
+	if l == nil || l.Href == nil {
+		return ""
+	}
+	return *l.Href
+}
+
+// GetEffectiveDate returns the EffectiveDate field as a time.Time if it's non-nil, zero time otherwise.
+func (m *MarketplacePendingChange) GetEffectiveDate() time.Time {
+	if m == nil || m.EffectiveDate == nil {
+		return time.Time{}
+	}
+	return m.EffectiveDate.Time()
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (m *MarketplacePendingChange) GetID() int {
+	if m == nil || m.ID == nil {
+		return 0
+	}
+	return *m.ID
+}
+
+// GetPlan returns the Plan field, or nil if m is nil.
+func (m *MarketplacePendingChange) GetPlan() *Plan {
+	if m == nil {
+		return nil
+	}
+	return m.Plan
+}
+
+// GetUnitCount returns the UnitCount field if it's non-nil, zero value otherwise.
+func (m *MarketplacePendingChange) GetUnitCount() int {
+	if m == nil || m.UnitCount == nil {
+		return 0
+	}
+	return *m.UnitCount
+}
+
+// GetBillingCycle returns the BillingCycle field if it's non-nil, zero value otherwise.
+func (m *MarketplacePurchase) GetBillingCycle() string {
+	if m == nil || m.BillingCycle == nil {
+		return ""
+	}
+	return *m.BillingCycle
+}
+
+// GetFreeTrialEndsOn returns the FreeTrialEndsOn field as a time.Time if it's non-nil, zero time otherwise.
+func (m *MarketplacePurchase) GetFreeTrialEndsOn() time.Time {
+	if m == nil || m.FreeTrialEndsOn == nil {
+		return time.Time{}
+	}
+	return m.FreeTrialEndsOn.Time()
+}
+
+// GetNextBillingDate returns the NextBillingDate field as a time.Time if it's non-nil, zero time otherwise.
+func (m *MarketplacePurchase) GetNextBillingDate() time.Time {
+	if m == nil || m.NextBillingDate == nil {
+		return time.Time{}
+	}
+	return m.NextBillingDate.Time()
+}
+
+// GetOnFreeTrial returns the OnFreeTrial field if it's non-nil, zero value otherwise.
+func (m *MarketplacePurchase) GetOnFreeTrial() bool {
+	if m == nil || m.OnFreeTrial == nil {
+		return false
+	}
+	return *m.OnFreeTrial
+}
+
+// GetPlan returns the Plan field, or nil if m is nil.
+func (m *MarketplacePurchase) GetPlan() *Plan {
+	if m == nil {
+		return nil
+	}
+	return m.Plan
+}
+
+// GetUnitCount returns the UnitCount field if it's non-nil, zero value otherwise.
+func (m *MarketplacePurchase) GetUnitCount() int {
+	if m == nil || m.UnitCount == nil {
+		return 0
+	}
+	return *m.UnitCount
+}
+
+// GetUpdatedAt returns the UpdatedAt field as a time.Time if it's non-nil, zero time otherwise.
+func (m *MarketplacePurchase) GetUpdatedAt() time.Time {
+	if m == nil || m.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return m.UpdatedAt.Time()
+}
+
+// GetClosedAt returns the ClosedAt field as a time.Time if it's non-nil, zero time otherwise.
+func (m *Milestone) GetClosedAt() time.Time {
+	if m == nil || m.ClosedAt == nil {
+		return time.Time{}
+	}
+	return m.ClosedAt.Time()
+}
+
+// GetClosedIssues returns the ClosedIssues field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetClosedIssues() int {
+	if m == nil || m.ClosedIssues == nil {
+		return 0
+	}
+	return *m.ClosedIssues
+}
+
+// GetCreatedAt returns the CreatedAt field as a time.Time if it's non-nil, zero time otherwise.
+func (m *Milestone) GetCreatedAt() time.Time {
+	if m == nil || m.CreatedAt == nil {
+		return time.Time{}
+	}
+	return m.CreatedAt.Time()
+}
+
+// GetCreator returns the Creator field, or nil if m is nil.
+func (m *Milestone) GetCreator() *Account {
+	if m == nil {
+		return nil
+	}
+	return m.Creator
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetDescription() string {
+	if m == nil || m.Description == nil {
+		return ""
+	}
+	return *m.Description
+}
+
+// GetDueOn returns the DueOn field as a time.Time if it's non-nil, zero time otherwise.
+func (m *Milestone) GetDueOn() time.Time {
+	if m == nil || m.DueOn == nil {
+		return time.Time{}
+	}
+	return m.DueOn.Time()
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetHTMLURL() string {
+	if m == nil || m.HTMLURL == nil {
+		return ""
+	}
+	return *m.HTMLURL
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetID() int {
+	if m == nil || m.ID == nil {
+		return 0
+	}
+	return *m.ID
+}
+
+// GetLabelsURL returns the LabelsURL field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetLabelsURL() string {
+	if m == nil || m.LabelsURL == nil {
+		return ""
+	}
+	return *m.LabelsURL
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetNodeID() string {
+	if m == nil || m.NodeID == nil {
+		return ""
+	}
+	return *m.NodeID
+}
+
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetNumber() int {
+	if m == nil || m.Number == nil {
+		return 0
+	}
+	return *m.Number
+}
+
+// GetOpenIssues returns the OpenIssues field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetOpenIssues() int {
+	if m == nil || m.OpenIssues == nil {
+		return 0
+	}
+	return *m.OpenIssues
+}
+
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetState() string {
+	if m == nil || m.State == nil {
+		return ""
+	}
+	return *m.State
+}
+
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetTitle() string {
+	if m == nil || m.Title == nil {
+		return ""
+	}
+	return *m.Title
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetURL() string {
+	if m == nil || m.URL == nil {
+		return ""
+	}
+	return *m.URL
+}
+
+// GetUpdatedAt returns the UpdatedAt field as a time.Time if it's non-nil, zero time otherwise.
+func (m *Milestone) GetUpdatedAt() time.Time {
+	if m == nil || m.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return m.UpdatedAt.Time()
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (m *MilestoneEvent) GetAction() string {
+	if m == nil || m.Action == nil {
+		return ""
+	}
+	return *m.Action
+}
+
+// GetChanges returns the Changes field, or nil if m is nil.
+func (m *MilestoneEvent) GetChanges() *Changes {
+	if m == nil {
+		return nil
+	}
+	return m.Changes
+}
+
+// GetInstallation returns the Installation field, or nil if m is nil.
+func (m *MilestoneEvent) GetInstallation() *Installation {
+	if m == nil {
+		return nil
+	}
+	return m.Installation
+}
+
+// GetMilestone returns the Milestone field, or nil if m is nil.
+func (m *MilestoneEvent) GetMilestone() *Milestone {
+	if m == nil {
+		return nil
+	}
+	return m.Milestone
+}
+
+// GetOrganization returns the Organization field, or nil if m is nil.
+func (m *MilestoneEvent) GetOrganization() *Account {
+	if m == nil {
+		return nil
+	}
+	return m.Organization
+}
+
+// GetRepository returns the Repository field, or nil if m is nil.
+func (m *MilestoneEvent) GetRepository() *Repository {
+	if m == nil {
+		return nil
+	}
+	return m.Repository
+}
+
+// GetSender returns the Sender field, or nil if m is nil.
+func (m *MilestoneEvent) GetSender() *Account {
+	if m == nil {
+		return nil
+	}
+	return m.Sender
+}
+
+// GetHook returns the Hook field, or nil if p is nil.
+func (p *PingEvent) GetHook() *Hook {
+	if p == nil {
+		return nil
+	}
+	return p.Hook
+}
+
+// GetHookID returns the HookID field if it's non-nil, zero value otherwise.
+func (p *PingEvent) GetHookID() int {
+	if p == nil || p.HookID == nil {
+		return 0
+	}
+	return *p.HookID
+}
+
+// GetOrganization returns the Organization field, or nil if p is nil.
+func (p *PingEvent) GetOrganization() *Account {
+	if p == nil {
+		return nil
+	}
+	return p.Organization
+}
+
+// GetRepository returns the Repository field, or nil if p is nil.
+func (p *PingEvent) GetRepository() *Repository {
+	if p == nil {
+		return nil
+	}
+	return p.Repository
+}
+
+// GetSender returns the Sender field, or nil if p is nil.
+func (p *PingEvent) GetSender() *Account {
+	if p == nil {
+		return nil
+	}
+	return p.Sender
+}
+
+// GetZen returns the Zen field if it's non-nil, zero value otherwise.
+func (p *PingEvent) GetZen() string {
+	if p == nil || p.Zen == nil {
+		return ""
+	}
+	return *p.Zen
+}
+
+// GetAccountsURL returns the AccountsURL field if it's non-nil, zero value otherwise.
+func (p *Plan) GetAccountsURL() string {
+	if p == nil || p.AccountsURL == nil {
+		return ""
+	}
+	return *p.AccountsURL
+}
+
+// GetBullets returns the Bullets field, or nil if p is nil.
+func (p *Plan) GetBullets() []string {
+	if p == nil {
+		return nil
+	}
+	return p.Bullets
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (p *Plan) GetDescription() string {
+	if p == nil || p.Description == nil {
+		return ""
+	}
+	return *p.Description
+}
+
+// GetHasFreeTrial returns the HasFreeTrial field if it's non-nil, zero value otherwise.
+func (p *Plan) GetHasFreeTrial() bool {
+	if p == nil || p.HasFreeTrial == nil {
+		return false
+	}
+	return *p.HasFreeTrial
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *Plan) GetID() int {
+	if p == nil || p.ID == nil {
+		return 0
+	}
+	return *p.ID
+}
+
+// GetMonthlyPriceInCents returns the MonthlyPriceInCents field if it's non-nil, zero value otherwise.
+func (p *Plan) GetMonthlyPriceInCents() int {
+	if p == nil || p.MonthlyPriceInCents == nil {
+		return 0
+	}
+	return *p.MonthlyPriceInCents
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *Plan) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (p *Plan) GetNumber() int {
+	if p == nil || p.Number == nil {
+		return 0
+	}
+	return *p.Number
+}
+
+// GetPriceModel returns the PriceModel field if it's non-nil, zero value otherwise.
+func (p *Plan) GetPriceModel() string {
+	if p == nil || p.PriceModel == nil {
+		return ""
+	}
+	return *p.PriceModel
+}
+
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (p *Plan) GetState() string {
+	if p == nil || p.State == nil {
+		return ""
+	}
+	return *p.State
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (p *Plan) GetURL() string {
+	if p == nil || p.URL == nil {
+		return ""
+	}
+	return *p.URL
+}
+
+// GetUnitName returns the UnitName field if it's non-nil, zero value otherwise.
+func (p *Plan) GetUnitName() string {
+	if p == nil || p.UnitName == nil {
+		return ""
+	}
+	return *p.UnitName
+}
+
+// GetYearlyPriceInCents returns the YearlyPriceInCents field if it's non-nil, zero value otherwise.
+func (p *Plan) GetYearlyPriceInCents() int {
+	if p == nil || p.YearlyPriceInCents == nil {
+		return 0
+	}
+	return *p.YearlyPriceInCents
+}
+
+// GetActiveLockReason returns the ActiveLockReason field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetActiveLockReason() string {
+	if p == nil || p.ActiveLockReason == nil {
+		return ""
+	}
+	return *p.ActiveLockReason
+}
+
+// GetAdditions returns the Additions field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetAdditions() int {
+	if p == nil || p.Additions == nil {
+		return 0
+	}
+	return *p.Additions
+}
+
+// GetAssignee returns the Assignee field, or nil if p is nil.
+func (p *PullRequest) GetAssignee() *Account {
+	if p == nil {
+		return nil
+	}
+	return p.Assignee
+}
+
+// GetAssignees returns the Assignees field, or nil if p is nil.
+func (p *PullRequest) GetAssignees() []Account {
+	if p == nil {
+		return nil
+	}
+	return p.Assignees
+}
+
+// GetAuthorAssociation returns the AuthorAssociation field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetAuthorAssociation() string {
+	if p == nil || p.AuthorAssociation == nil {
+		return ""
+	}
+	return *p.AuthorAssociation
+}
+
+// GetAutoMerge returns the AutoMerge field, or nil if p is nil.
+func (p *PullRequest) GetAutoMerge() *AutoMerge {
+	if p == nil {
+		return nil
+	}
+	return p.AutoMerge
+}
+
+// GetBase returns the Base field, or nil if p is nil.
+func (p *PullRequest) GetBase() *PullRequestBranch {
+	if p == nil {
+		return nil
+	}
+	return p.Base
+}
+
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetBody() string {
+	if p == nil || p.Body == nil {
+		return ""
+	}
+	return *p.Body
+}
+
+// GetChangedFiles returns the ChangedFiles field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetChangedFiles() int {
+	if p == nil || p.ChangedFiles == nil {
+		return 0
+	}
+	return *p.ChangedFiles
+}
+
+// GetClosedAt returns the ClosedAt field as a time.Time if it's non-nil, zero time otherwise.
+func (p *PullRequest) GetClosedAt() time.Time {
+	if p == nil || p.ClosedAt == nil {
+		return time.Time{}
+	}
+	return p.ClosedAt.Time()
+}
+
+// GetComments returns the Comments field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetComments() int {
+	if p == nil || p.Comments == nil {
+		return 0
+	}
+	return *p.Comments
+}
+
+// GetCommentsURL returns the CommentsURL field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetCommentsURL() string {
+	if p == nil || p.CommentsURL == nil {
+		return ""
+	}
+	return *p.CommentsURL
+}
+
+// GetCommits returns the Commits field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetCommits() int {
+	if p == nil || p.Commits == nil {
+		return 0
+	}
+	return *p.Commits
+}
+
+// GetCommitsURL returns the CommitsURL field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetCommitsURL() string {
+	if p == nil || p.CommitsURL == nil {
+		return ""
+	}
+	return *p.CommitsURL
+}
+
+// GetCreatedAt returns the CreatedAt field as a time.Time if it's non-nil, zero time otherwise.
+func (p *PullRequest) GetCreatedAt() time.Time {
+	if p == nil || p.CreatedAt == nil {
+		return time.Time{}
+	}
+	return p.CreatedAt.Time()
+}
+
+// GetDeletions returns the Deletions field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetDeletions() int {
+	if p == nil || p.Deletions == nil {
+		return 0
+	}
+	return *p.Deletions
+}
+
+// GetDiffURL returns the DiffURL field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetDiffURL() string {
+	if p == nil || p.DiffURL == nil {
+		return ""
+	}
+	return *p.DiffURL
+}
+
+// GetDraft returns the Draft field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetDraft() bool {
+	if p == nil || p.Draft == nil {
+		return false
+	}
+	return *p.Draft
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetHTMLURL() string {
+	if p == nil || p.HTMLURL == nil {
+		return ""
+	}
+	return *p.HTMLURL
+}
+
+// GetHead returns the Head field, or nil if p is nil.
+func (p *PullRequest) GetHead() *PullRequestBranch {
+	if p == nil {
+		return nil
+	}
+	return p.Head
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetID() int {
+	if p == nil || p.ID == nil {
+		return 0
+	}
+	return *p.ID
+}
+
+// GetIssueURL returns the IssueURL field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetIssueURL() string {
+	if p == nil || p.IssueURL == nil {
+		return ""
+	}
+	return *p.IssueURL
+}
+
+// GetLabels returns the Labels field, or nil if p is nil.
+func (p *PullRequest) GetLabels() []Label {
+	if p == nil {
+		return nil
+	}
+	return p.Labels
+}
+
+// GetLinks returns the Links field, or nil if p is nil.
+func (p *PullRequest) GetLinks() *PullRequestLinks {
+	if p == nil {
+		return nil
+	}
+	return p.Links
+}
+
+// GetLocked returns the Locked field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetLocked() bool {
+	if p == nil || p.Locked == nil {
+		return false
+	}
+	return *p.Locked
+}
+
+// GetMaintainerCanModify returns the MaintainerCanModify field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetMaintainerCanModify() bool {
+	if p == nil || p.MaintainerCanModify == nil {
+		return false
+	}
+	return *p.MaintainerCanModify
+}
+
+// GetMergeCommitSHA returns the MergeCommitSHA field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetMergeCommitSHA() string {
+	if p == nil || p.MergeCommitSHA == nil {
+		return ""
+	}
+	return *p.MergeCommitSHA
+}
+
+// GetMergeable returns the Mergeable field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetMergeable() bool {
+	if p == nil || p.Mergeable == nil {
+		return false
+	}
+	return *p.Mergeable
+}
+
+// GetMergeableState returns the MergeableState field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetMergeableState() string {
+	if p == nil || p.MergeableState == nil {
+		return ""
+	}
+	return *p.MergeableState
+}
+
+// GetMerged returns the Merged field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetMerged() bool {
+	if p == nil || p.Merged == nil {
+		return false
+	}
+	return *p.Merged
+}
+
+// GetMergedAt returns the MergedAt field as a time.Time if it's non-nil, zero time otherwise.
+func (p *PullRequest) GetMergedAt() time.Time {
+	if p == nil || p.MergedAt == nil {
+		return time.Time{}
+	}
+	return p.MergedAt.Time()
+}
+
+// GetMergedBy returns the MergedBy field, or nil if p is nil.
+func (p *PullRequest) GetMergedBy() *Account {
+	if p == nil {
+		return nil
+	}
+	return p.MergedBy
+}
+
+// GetMilestone returns the Milestone field, or nil if p is nil.
+func (p *PullRequest) GetMilestone() *Milestone {
+	if p == nil {
+		return nil
+	}
+	return p.Milestone
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetNodeID() string {
+	if p == nil || p.NodeID == nil {
+		return ""
+	}
+	return *p.NodeID
+}
+
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetNumber() int {
+	if p == nil || p.Number == nil {
+		return 0
+	}
+	return *p.Number
+}
+
+// GetPatchURL returns the PatchURL field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetPatchURL() string {
+	if p == nil || p.PatchURL == nil {
+		return ""
+	}
+	return *p.PatchURL
+}
+
+// GetRebaseable returns the Rebaseable field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetRebaseable() bool {
+	if p == nil || p.Rebaseable == nil {
+		return false
+	}
+	return *p.Rebaseable
+}
+
+// GetRequestedReviewers returns the RequestedReviewers field, or nil if p is nil.
+func (p *PullRequest) GetRequestedReviewers() []Account {
+	if p == nil {
+		return nil
+	}
+	return p.RequestedReviewers
+}
+
+// GetRequestedTeams returns the RequestedTeams field, or nil if p is nil.
+func (p *PullRequest) GetRequestedTeams() []Team {
+	if p == nil {
+		return nil
+	}
+	return p.RequestedTeams
+}
+
+// GetReviewCommentURL returns the ReviewCommentURL field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetReviewCommentURL() string {
+	if p == nil || p.ReviewCommentURL == nil {
+		return ""
+	}
+	return *p.ReviewCommentURL
+}
+
+// GetReviewComments returns the ReviewComments field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetReviewComments() int {
+	if p == nil || p.ReviewComments == nil {
+		return 0
+	}
+	return *p.ReviewComments
+}
+
+// GetReviewCommentsURL returns the ReviewCommentsURL field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetReviewCommentsURL() string {
+	if p == nil || p.ReviewCommentsURL == nil {
+		return ""
+	}
+	return *p.ReviewCommentsURL
+}
+
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetState() string {
+	if p == nil || p.State == nil {
+		return ""
+	}
+	return *p.State
+}
+
+// GetStatusesURL returns the StatusesURL field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetStatusesURL() string {
+	if p == nil || p.StatusesURL == nil {
+		return ""
+	}
+	return *p.StatusesURL
+}
+
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetTitle() string {
+	if p == nil || p.Title == nil {
+		return ""
+	}
+	return *p.Title
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetURL() string {
+	if p == nil || p.URL == nil {
+		return ""
+	}
+	return *p.URL
+}
+
+// GetUpdatedAt returns the UpdatedAt field as a time.Time if it's non-nil, zero time otherwise.
+func (p *PullRequest) GetUpdatedAt() time.Time {
+	if p == nil || p.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return p.UpdatedAt.Time()
+}
+
+// GetUser returns the User field, or nil if p is nil.
+func (p *PullRequest) GetUser() *Account {
+	if p == nil {
+		return nil
+	}
+	return p.User
+}
+
+// GetLabel returns the Label field if it's non-nil, zero value otherwise.
+func (p *PullRequestBranch) GetLabel() string {
+	if p == nil || p.Label == nil {
+		return ""
+	}
+	return *p.Label
+}
+
+// GetRef returns the Ref field if it's non-nil, zero value otherwise.
+func (p *PullRequestBranch) GetRef() string {
+	if p == nil || p.Ref == nil {
+		return ""
+	}
+	return *p.Ref
+}
+
+// GetRepo returns the Repo field, or nil if p is nil.
+func (p *PullRequestBranch) GetRepo() *Repository {
+	if p == nil {
+		return nil
+	}
+	return p.Repo
+}
+
+// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
+func (p *PullRequestBranch) GetSHA() string {
+	if p == nil || p.SHA == nil {
+		return ""
+	}
+	return *p.SHA
+}
+
+// GetUser returns the User field, or nil if p is nil.
+func (p *PullRequestBranch) GetUser() *Account {
+	if p == nil {
+		return nil
+	}
+	return p.User
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (p *PullRequestEvent) GetAction() string {
+	if p == nil || p.Action == nil {
+		return ""
+	}
+	return *p.Action
+}
+
+// GetAfter returns the After field if it's non-nil, zero value otherwise.
+func (p *PullRequestEvent) GetAfter() string {
+	if p == nil || p.After == nil {
+		return ""
+	}
+	return *p.After
+}
+
+// GetAssignee returns the Assignee field, or nil if p is nil.
+func (p *PullRequestEvent) GetAssignee() *Account {
+	if p == nil {
+		return nil
+	}
+	return p.Assignee
+}
+
+// GetBefore returns the Before field if it's non-nil, zero value otherwise.
+func (p *PullRequestEvent) GetBefore() string {
+	if p == nil || p.Before == nil {
+		return ""
+	}
+	return *p.Before
+}
+
+// GetChanges returns the Changes field, or nil if p is nil.
+func (p *PullRequestEvent) GetChanges() *Changes {
+	if p == nil {
+		return nil
+	}
+	return p.Changes
+}
+
+// GetInstallation returns the Installation field, or nil if p is nil.
+func (p *PullRequestEvent) GetInstallation() *Installation {
+	if p == nil {
+		return nil
+	}
+	return p.Installation
+}
+
+// GetLabel returns the Label field, or nil if p is nil.
+func (p *PullRequestEvent) GetLabel() *Label {
+	if p == nil {
+		return nil
+	}
+	return p.Label
+}
+
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (p *PullRequestEvent) GetNumber() int {
+	if p == nil || p.Number == nil {
+		return 0
+	}
+	return *p.Number
+}
+
+// GetOrganization returns the Organization field, or nil if p is nil.
+func (p *PullRequestEvent) GetOrganization() *Account {
+	if p == nil {
+		return nil
+	}
+	return p.Organization
+}
+
+// GetPullRequest returns the PullRequest field, or nil if p is nil.
+func (p *PullRequestEvent) GetPullRequest() *PullRequest {
+	if p == nil {
+		return nil
+	}
+	return p.PullRequest
+}
+
+// GetRepository returns the Repository field, or nil if p is nil.
+func (p *PullRequestEvent) GetRepository() *Repository {
+	if p == nil {
+		return nil
+	}
+	return p.Repository
+}
+
+// GetRequestedReviewer returns the RequestedReviewer field, or nil if p is nil.
+func (p *PullRequestEvent) GetRequestedReviewer() *Account {
+	if p == nil {
+		return nil
+	}
+	return p.RequestedReviewer
+}
+
+// GetSender returns the Sender field, or nil if p is nil.
+func (p *PullRequestEvent) GetSender() *Account {
+	if p == nil {
+		return nil
+	}
+	return p.Sender
+}
+
+// GetComments returns the Comments field, or nil if p is nil.
+func (p *PullRequestLinks) GetComments() *Link {
+	if p == nil {
+		return nil
+	}
+	return p.Comments
+}
+
+// GetCommits returns the Commits field, or nil if p is nil.
+func (p *PullRequestLinks) GetCommits() *Link {
+	if p == nil {
+		return nil
+	}
+	return p.Commits
+}
+
+// GetHTML returns the HTML field, or nil if p is nil.
+func (p *PullRequestLinks) GetHTML() *Link {
+	if p == nil {
+		return nil
+	}
+	return p.HTML
+}
+
+// GetIssue returns the Issue field, or nil if p is nil.
+func (p *PullRequestLinks) GetIssue() *Link {
+	if p == nil {
+		return nil
+	}
+	return p.Issue
+}
+
+// GetReviewComment returns the ReviewComment field, or nil if p is nil.
+func (p *PullRequestLinks) GetReviewComment() *Link {
+	if p == nil {
+		return nil
+	}
+	return p.ReviewComment
+}
+
+// GetReviewComments returns the ReviewComments field, or nil if p is nil.
+func (p *PullRequestLinks) GetReviewComments() *Link {
+	if p == nil {
+		return nil
+	}
+	return p.ReviewComments
+}
+
+// GetSelf returns the Self field, or nil if p is nil.
+func (p *PullRequestLinks) GetSelf() *Link {
+	if p == nil {
+		return nil
+	}
+	return p.Self
+}
+
+// GetStatuses returns the Statuses field, or nil if p is nil.
+func (p *PullRequestLinks) GetStatuses() *Link {
+	if p == nil {
+		return nil
+	}
+	return p.Statuses
+}
+
+// GetAfter returns the After field if it's non-nil, zero value otherwise.
+func (p *PushEvent) GetAfter() string {
+	if p == nil || p.After == nil {
+		return ""
+	}
+	return *p.After
+}
+
+// GetBaseRef returns the BaseRef field if it's non-nil, zero value otherwise.
+func (p *PushEvent) GetBaseRef() string {
+	if p == nil || p.BaseRef == nil {
+		return ""
+	}
+	return *p.BaseRef
+}
+
+// GetBefore returns the Before field if it's non-nil, zero value otherwise.
+func (p *PushEvent) GetBefore() string {
+	if p == nil || p.Before == nil {
+		return ""
+	}
+	return *p.Before
+}
+
+// GetCommits returns the Commits field, or nil if p is nil.
+func (p *PushEvent) GetCommits() []Commit {
+	if p == nil {
+		return nil
+	}
+	return p.Commits
+}
+
+// GetCompare returns the Compare field if it's non-nil, zero value otherwise.
+func (p *PushEvent) GetCompare() string {
+	if p == nil || p.Compare == nil {
+		return ""
+	}
+	return *p.Compare
+}
+
+// GetCreated returns the Created field if it's non-nil, zero value otherwise.
+func (p *PushEvent) GetCreated() bool {
+	if p == nil || p.Created == nil {
+		return false
+	}
+	return *p.Created
+}
+
+// GetDeleted returns the Deleted field if it's non-nil, zero value otherwise.
+func (p *PushEvent) GetDeleted() bool {
+	if p == nil || p.Deleted == nil {
+		return false
+	}
+	return *p.Deleted
+}
+
+// GetForced returns the Forced field if it's non-nil, zero value otherwise.
+func (p *PushEvent) GetForced() bool {
+	if p == nil || p.Forced == nil {
+		return false
+	}
+	return *p.Forced
+}
+
+// GetHeadCommit returns the HeadCommit field, or nil if p is nil.
+func (p *PushEvent) GetHeadCommit() *Commit {
+	if p == nil {
+		return nil
+	}
+	return p.HeadCommit
+}
+
+// GetInstallation returns the Installation field, or nil if p is nil.
+func (p *PushEvent) GetInstallation() *Installation {
+	if p == nil {
+		return nil
+	}
+	return p.Installation
+}
+
+// GetOrganization returns the Organization field, or nil if p is nil.
+func (p *PushEvent) GetOrganization() *Account {
+	if p == nil {
+		return nil
+	}
+	return p.Organization
+}
+
+// GetPusher returns the Pusher field, or nil if p is nil.
+func (p *PushEvent) GetPusher() *EmailUser {
+	if p == nil {
+		return nil
+	}
+	return p.Pusher
+}
+
+// GetRef returns the Ref field if it's non-nil, zero value otherwise.
+func (p *PushEvent) GetRef() string {
+	if p == nil || p.Ref == nil {
+		return ""
+	}
+	return *p.Ref
+}
+
+// GetRepository returns the Repository field, or nil if p is nil.
+func (p *PushEvent) GetRepository() *Repository {
+	if p == nil {
+		return nil
+	}
+	return p.Repository
+}
+
+// GetSender returns the Sender field, or nil if p is nil.
+func (p *PushEvent) GetSender() *Account {
+	if p == nil {
+		return nil
+	}
+	return p.Sender
+}
+
+// GetArchiveURL returns the ArchiveURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetArchiveURL() string {
+	if r == nil || r.ArchiveURL == nil {
+		return ""
+	}
+	return *r.ArchiveURL
+}
+
+// GetArchived returns the Archived field if it's non-nil, zero value otherwise.
+func (r *Repository) GetArchived() bool {
+	if r == nil || r.Archived == nil {
+		return false
+	}
+	return *r.Archived
+}
+
+// GetAssigneesURL returns the AssigneesURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetAssigneesURL() string {
+	if r == nil || r.AssigneesURL == nil {
+		return ""
+	}
+	return *r.AssigneesURL
+}
+
+// GetBlobsURL returns the BlobsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetBlobsURL() string {
+	if r == nil || r.BlobsURL == nil {
+		return ""
+	}
+	return *r.BlobsURL
+}
+
+// GetBranchesURL returns the BranchesURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetBranchesURL() string {
+	if r == nil || r.BranchesURL == nil {
+		return ""
+	}
+	return *r.BranchesURL
+}
+
+// GetCloneURL returns the CloneURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetCloneURL() string {
+	if r == nil || r.CloneURL == nil {
+		return ""
+	}
+	return *r.CloneURL
+}
+
+// GetCollaboratorsURL returns the CollaboratorsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetCollaboratorsURL() string {
+	if r == nil || r.CollaboratorsURL == nil {
+		return ""
+	}
+	return *r.CollaboratorsURL
+}
+
+// GetCommentsURL returns the CommentsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetCommentsURL() string {
+	if r == nil || r.CommentsURL == nil {
+		return ""
+	}
+	return *r.CommentsURL
+}
+
+// GetCommitsURL returns the CommitsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetCommitsURL() string {
+	if r == nil || r.CommitsURL == nil {
+		return ""
+	}
+	return *r.CommitsURL
+}
+
+// GetCompareURL returns the CompareURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetCompareURL() string {
+	if r == nil || r.CompareURL == nil {
+		return ""
+	}
+	return *r.CompareURL
+}
+
+// GetContentsURL returns the ContentsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetContentsURL() string {
+	if r == nil || r.ContentsURL == nil {
+		return ""
+	}
+	return *r.ContentsURL
+}
+
+// GetContributorsURL returns the ContributorsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetContributorsURL() string {
+	if r == nil || r.ContributorsURL == nil {
+		return ""
+	}
+	return *r.ContributorsURL
+}
+
+// GetCreatedAt returns the CreatedAt field as a time.Time if it's non-nil, zero time otherwise.
+func (r *Repository) GetCreatedAt() time.Time {
+	if r == nil || r.CreatedAt == nil {
+		return time.Time{}
+	}
+	return r.CreatedAt.Time()
+}
+
+// GetDefaultBranch returns the DefaultBranch field if it's non-nil, zero value otherwise.
+func (r *Repository) GetDefaultBranch() string {
+	if r == nil || r.DefaultBranch == nil {
+		return ""
+	}
+	return *r.DefaultBranch
+}
+
+// GetDeploymentsURL returns the DeploymentsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetDeploymentsURL() string {
+	if r == nil || r.DeploymentsURL == nil {
+		return ""
+	}
+	return *r.DeploymentsURL
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (r *Repository) GetDescription() string {
+	if r == nil || r.Description == nil {
+		return ""
+	}
+	return *r.Description
+}
+
+// GetDisabled returns the Disabled field if it's non-nil, zero value otherwise.
+func (r *Repository) GetDisabled() bool {
+	if r == nil || r.Disabled == nil {
+		return false
+	}
+	return *r.Disabled
+}
+
+// GetDownloadsURL returns the DownloadsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetDownloadsURL() string {
+	if r == nil || r.DownloadsURL == nil {
+		return ""
+	}
+	return *r.DownloadsURL
+}
+
+// GetEventsURL returns the EventsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetEventsURL() string {
+	if r == nil || r.EventsURL == nil {
+		return ""
+	}
+	return *r.EventsURL
+}
+
+// GetFork returns the Fork field if it's non-nil, zero value otherwise.
+func (r *Repository) GetFork() bool {
+	if r == nil || r.Fork == nil {
+		return false
+	}
+	return *r.Fork
+}
+
+// GetForks returns the Forks field if it's non-nil, zero value otherwise.
+func (r *Repository) GetForks() int {
+	if r == nil || r.Forks == nil {
+		return 0
+	}
+	return *r.Forks
+}
+
+// GetForksCount returns the ForksCount field if it's non-nil, zero value otherwise.
+func (r *Repository) GetForksCount() int {
+	if r == nil || r.ForksCount == nil {
+		return 0
+	}
+	return *r.ForksCount
+}
+
+// GetForksURL returns the ForksURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetForksURL() string {
+	if r == nil || r.ForksURL == nil {
+		return ""
+	}
+	return *r.ForksURL
+}
+
+// GetFullName returns the FullName field if it's non-nil, zero value otherwise.
+func (r *Repository) GetFullName() string {
+	if r == nil || r.FullName == nil {
+		return ""
+	}
+	return *r.FullName
+}
+
+// GetGitCommitsURL returns the GitCommitsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetGitCommitsURL() string {
+	if r == nil || r.GitCommitsURL == nil {
+		return ""
+	}
+	return *r.GitCommitsURL
+}
+
+// GetGitRefsURL returns the GitRefsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetGitRefsURL() string {
+	if r == nil || r.GitRefsURL == nil {
+		return ""
+	}
+	return *r.GitRefsURL
+}
+
+// GetGitTagsURL returns the GitTagsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetGitTagsURL() string {
+	if r == nil || r.GitTagsURL == nil {
+		return ""
+	}
+	return *r.GitTagsURL
+}
+
+// GetGitURL returns the GitURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetGitURL() string {
+	if r == nil || r.GitURL == nil {
+		return ""
+	}
+	return *r.GitURL
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetHTMLURL() string {
+	if r == nil || r.HTMLURL == nil {
+		return ""
+	}
+	return *r.HTMLURL
+}
+
+// GetHasDownloads returns the HasDownloads field if it's non-nil, zero value otherwise.
+func (r *Repository) GetHasDownloads() bool {
+	if r == nil || r.HasDownloads == nil {
+		return false
+	}
+	return *r.HasDownloads
+}
+
+// GetHasIssues returns the HasIssues field if it's non-nil, zero value otherwise.
+func (r *Repository) GetHasIssues() bool {
+	if r == nil || r.HasIssues == nil {
+		return false
+	}
+	return *r.HasIssues
+}
+
+// GetHasPages returns the HasPages field if it's non-nil, zero value otherwise.
+func (r *Repository) GetHasPages() bool {
+	if r == nil || r.HasPages == nil {
+		return false
+	}
+	return *r.HasPages
+}
+
+// GetHasProjects returns the HasProjects field if it's non-nil, zero value otherwise.
+func (r *Repository) GetHasProjects() bool {
+	if r == nil || r.HasProjects == nil {
+		return false
+	}
+	return *r.HasProjects
+}
+
+// GetHasWiki returns the HasWiki field if it's non-nil, zero value otherwise.
+func (r *Repository) GetHasWiki() bool {
+	if r == nil || r.HasWiki == nil {
+		return false
+	}
+	return *r.HasWiki
+}
+
+// GetHomepage returns the Homepage field if it's non-nil, zero value otherwise.
+func (r *Repository) GetHomepage() string {
+	if r == nil || r.Homepage == nil {
+		return ""
+	}
+	return *r.Homepage
+}
+
+// GetHooksURL returns the HooksURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetHooksURL() string {
+	if r == nil || r.HooksURL == nil {
+		return ""
+	}
+	return *r.HooksURL
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (r *Repository) GetID() int {
+	if r == nil || r.ID == nil {
+		return 0
+	}
+	return *r.ID
+}
+
+// GetIssueCommentsURL returns the IssueCommentsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetIssueCommentsURL() string {
+	if r == nil || r.IssueCommentsURL == nil {
+		return ""
+	}
+	return *r.IssueCommentsURL
+}
+
+// GetIssueEventsURL returns the IssueEventsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetIssueEventsURL() string {
+	if r == nil || r.IssueEventsURL == nil {
+		return ""
+	}
+	return *r.IssueEventsURL
+}
+
+// GetIssuesURL returns the IssuesURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetIssuesURL() string {
+	if r == nil || r.IssuesURL == nil {
+		return ""
+	}
+	return *r.IssuesURL
+}
+
+// GetKeysURL returns the KeysURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetKeysURL() string {
+	if r == nil || r.KeysURL == nil {
+		return ""
+	}
+	return *r.KeysURL
+}
+
+// GetLabelsURL returns the LabelsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetLabelsURL() string {
+	if r == nil || r.LabelsURL == nil {
+		return ""
+	}
+	return *r.LabelsURL
+}
+
+// GetLanguages returns the Languages field if it's non-nil, zero value otherwise.
+func (r *Repository) GetLanguages() string {
+	if r == nil || r.Languages == nil {
+		return ""
+	}
+	return *r.Languages
+}
+
+// GetLanguagesURL returns the LanguagesURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetLanguagesURL() string {
+	if r == nil || r.LanguagesURL == nil {
+		return ""
+	}
+	return *r.LanguagesURL
+}
+
+// GetLicense returns the License field, or nil if r is nil.
+func (r *Repository) GetLicense() *License {
+	if r == nil {
+		return nil
+	}
+	return r.License
+}
+
+// GetMasterBranch returns the MasterBranch field if it's non-nil, zero value otherwise.
+func (r *Repository) GetMasterBranch() string {
+	if r == nil || r.MasterBranch == nil {
+		return ""
+	}
+	return *r.MasterBranch
+}
+
+// GetMergesURL returns the MergesURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetMergesURL() string {
+	if r == nil || r.MergesURL == nil {
+		return ""
+	}
+	return *r.MergesURL
+}
+
+// GetMilestonesURL returns the MilestonesURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetMilestonesURL() string {
+	if r == nil || r.MilestonesURL == nil {
+		return ""
+	}
+	return *r.MilestonesURL
+}
+
+// GetMirrorURL returns the MirrorURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetMirrorURL() string {
+	if r == nil || r.MirrorURL == nil {
+		return ""
+	}
+	return *r.MirrorURL
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *Repository) GetName() string {
+	if r == nil || r.Name == nil {
+		return ""
+	}
+	return *r.Name
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (r *Repository) GetNodeID() string {
+	if r == nil || r.NodeID == nil {
+		return ""
+	}
+	return *r.NodeID
+}
+
+// GetNotificationURL returns the NotificationURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetNotificationURL() string {
+	if r == nil || r.NotificationURL == nil {
+		return ""
+	}
+	return *r.NotificationURL
+}
+
+// GetOpenIssues returns the OpenIssues field if it's non-nil, zero value otherwise.
+func (r *Repository) GetOpenIssues() int {
+	if r == nil || r.OpenIssues == nil {
+		return 0
+	}
+	return *r.OpenIssues
+}
+
+// GetOpenIssuesCount returns the OpenIssuesCount field if it's non-nil, zero value otherwise.
+func (r *Repository) GetOpenIssuesCount() int {
+	if r == nil || r.OpenIssuesCount == nil {
+		return 0
+	}
+	return *r.OpenIssuesCount
+}
+
+// GetOwner returns the Owner field, or nil if r is nil.
+func (r *Repository) GetOwner() *Account {
+	if r == nil {
+		return nil
+	}
+	return r.Owner
+}
+
+// GetPrivate returns the Private field if it's non-nil, zero value otherwise.
+func (r *Repository) GetPrivate() bool {
+	if r == nil || r.Private == nil {
+		return false
+	}
+	return *r.Private
+}
+
+// GetPullsURL returns the PullsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetPullsURL() string {
+	if r == nil || r.PullsURL == nil {
+		return ""
+	}
+	return *r.PullsURL
+}
+
+// GetPushedAt returns the PushedAt field as a time.Time if it's non-nil, zero time otherwise.
+func (r *Repository) GetPushedAt() time.Time {
+	if r == nil || r.PushedAt == nil {
+		return time.Time{}
+	}
+	return r.PushedAt.Time()
+}
+
+// GetReleasesURL returns the ReleasesURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetReleasesURL() string {
+	if r == nil || r.ReleasesURL == nil {
+		return ""
+	}
+	return *r.ReleasesURL
+}
+
+// GetSSHURL returns the SSHURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetSSHURL() string {
+	if r == nil || r.SSHURL == nil {
+		return ""
+	}
+	return *r.SSHURL
+}
+
+// GetSize returns the Size field if it's non-nil, zero value otherwise.
+func (r *Repository) GetSize() int {
+	if r == nil || r.Size == nil {
+		return 0
+	}
+	return *r.Size
+}
+
+// GetStargazers returns the Stargazers field if it's non-nil, zero value otherwise.
+func (r *Repository) GetStargazers() int {
+	if r == nil || r.Stargazers == nil {
+		return 0
+	}
+	return *r.Stargazers
+}
+
+// GetStargazersCount returns the StargazersCount field if it's non-nil, zero value otherwise.
+func (r *Repository) GetStargazersCount() int {
+	if r == nil || r.StargazersCount == nil {
+		return 0
+	}
+	return *r.StargazersCount
+}
+
+// GetStargazersURL returns the StargazersURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetStargazersURL() string {
+	if r == nil || r.StargazersURL == nil {
+		return ""
+	}
+	return *r.StargazersURL
+}
+
+// GetStatusesURL returns the StatusesURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetStatusesURL() string {
+	if r == nil || r.StatusesURL == nil {
+		return ""
+	}
+	return *r.StatusesURL
+}
+
+// GetSubscribersURL returns the SubscribersURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetSubscribersURL() string {
+	if r == nil || r.SubscribersURL == nil {
+		return ""
+	}
+	return *r.SubscribersURL
+}
+
+// GetSubscriptionURL returns the SubscriptionURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetSubscriptionURL() string {
+	if r == nil || r.SubscriptionURL == nil {
+		return ""
+	}
+	return *r.SubscriptionURL
+}
+
+// GetSvnURL returns the SvnURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetSvnURL() string {
+	if r == nil || r.SvnURL == nil {
+		return ""
+	}
+	return *r.SvnURL
+}
+
+// GetTagsURL returns the TagsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetTagsURL() string {
+	if r == nil || r.TagsURL == nil {
+		return ""
+	}
+	return *r.TagsURL
+}
+
+// GetTeamsURL returns the TeamsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetTeamsURL() string {
+	if r == nil || r.TeamsURL == nil {
+		return ""
+	}
+	return *r.TeamsURL
+}
+
+// GetTreesURL returns the TreesURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetTreesURL() string {
+	if r == nil || r.TreesURL == nil {
+		return ""
+	}
+	return *r.TreesURL
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetURL() string {
+	if r == nil || r.URL == nil {
+		return ""
+	}
+	return *r.URL
+}
+
+// GetUpdatedAt returns the UpdatedAt field as a time.Time if it's non-nil, zero time otherwise.
+func (r *Repository) GetUpdatedAt() time.Time {
+	if r == nil || r.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return r.UpdatedAt.Time()
+}
+
+// GetWatchers returns the Watchers field if it's non-nil, zero value otherwise.
+func (r *Repository) GetWatchers() int {
+	if r == nil || r.Watchers == nil {
+		return 0
+	}
+	return *r.Watchers
+}
+
+// GetWatchersCount returns the WatchersCount field if it's non-nil, zero value otherwise.
+func (r *Repository) GetWatchersCount() int {
+	if r == nil || r.WatchersCount == nil {
+		return 0
+	}
+	return *r.WatchersCount
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (t *Team) GetDescription() string {
+	if t == nil || t.Description == nil {
+		return ""
+	}
+	return *t.Description
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (t *Team) GetHTMLURL() string {
+	if t == nil || t.HTMLURL == nil {
+		return ""
+	}
+	return *t.HTMLURL
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (t *Team) GetID() int {
+	if t == nil || t.ID == nil {
+		return 0
+	}
+	return *t.ID
+}
+
+// GetMembersURL returns the MembersURL field if it's non-nil, zero value otherwise.
+func (t *Team) GetMembersURL() string {
+	if t == nil || t.MembersURL == nil {
+		return ""
+	}
+	return *t.MembersURL
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (t *Team) GetName() string {
+	if t == nil || t.Name == nil {
+		return ""
+	}
+	return *t.Name
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (t *Team) GetNodeID() string {
+	if t == nil || t.NodeID == nil {
+		return ""
+	}
+	return *t.NodeID
+}
+
+// GetPermission returns the Permission field if it's non-nil, zero value otherwise.
+func (t *Team) GetPermission() string {
+	if t == nil || t.Permission == nil {
+		return ""
+	}
+	return *t.Permission
+}
+
+// GetPrivacy returns the Privacy field if it's non-nil, zero value otherwise.
+func (t *Team) GetPrivacy() string {
+	if t == nil || t.Privacy == nil {
+		return ""
+	}
+	return *t.Privacy
+}
+
+// GetRepositoriesURL returns the RepositoriesURL field if it's non-nil, zero value otherwise.
+func (t *Team) GetRepositoriesURL() string {
+	if t == nil || t.RepositoriesURL == nil {
+		return ""
+	}
+	return *t.RepositoriesURL
+}
+
+// GetSlug returns the Slug field if it's non-nil, zero value otherwise.
+func (t *Team) GetSlug() string {
+	if t == nil || t.Slug == nil {
+		return ""
+	}
+	return *t.Slug
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (t *Team) GetURL() string {
+	if t == nil || t.URL == nil {
+		return ""
+	}
+	return *t.URL
+}
+
+// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
+func (t *TreeObject) GetSHA() string {
+	if t == nil || t.SHA == nil {
+		return ""
+	}
+	return *t.SHA
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (t *TreeObject) GetURL() string {
+	if t == nil || t.URL == nil {
+		return ""
+	}
+	return *t.URL
+}
+
+// GetInstallations returns the Installations field, or nil if u is nil.
+func (u *UserInstallationsResponse) GetInstallations() []Installation {
+	if u == nil {
+		return nil
+	}
+	return u.Installations
+}
+
+// GetTotalCount returns the TotalCount field, or 0 if u is nil.
+func (u *UserInstallationsResponse) GetTotalCount() int {
+	if u == nil {
+		return 0
+	}
+	return u.TotalCount
+}
+
+// GetPayload returns the Payload field if it's non-nil, zero value otherwise.
+func (v *VerificationObject) GetPayload() string {
+	if v == nil || v.Payload == nil {
+		return ""
+	}
+	return *v.Payload
+}
+
+// GetReason returns the Reason field if it's non-nil, zero value otherwise.
+func (v *VerificationObject) GetReason() string {
+	if v == nil || v.Reason == nil {
+		return ""
+	}
+	return *v.Reason
+}
+
+// GetSignature returns the Signature field if it's non-nil, zero value otherwise.
+func (v *VerificationObject) GetSignature() string {
+	if v == nil || v.Signature == nil {
+		return ""
+	}
+	return *v.Signature
+}
+
+// GetVerified returns the Verified field if it's non-nil, zero value otherwise.
+func (v *VerificationObject) GetVerified() bool {
+	if v == nil || v.Verified == nil {
+		return false
+	}
+	return *v.Verified
+}
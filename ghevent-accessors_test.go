@@ -0,0 +1,7247 @@
+// Code generated by gen-accessors.go; DO NOT EDIT.
+
+package ghevent
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestAPIEvent_GetActor(t *testing.T) {
+	v := &Account{}
+	s := &APIEvent{Actor: v}
+	if got := s.GetActor(); got != v {
+		t.Errorf("GetActor() didn't return the field")
+	}
+	s = nil
+	if got := s.GetActor(); got != nil {
+		t.Errorf("GetActor() was %v when receiver was nil", got)
+	}
+}
+
+func TestAPIEvent_GetCreatedAt(t *testing.T) {
+	v := time.Unix(1557933565, 0)
+	s := &APIEvent{CreatedAt: &TimeWrapper{t: v}}
+	if got := s.GetCreatedAt(); !got.Equal(v) {
+		t.Errorf("GetCreatedAt() was %v (should have been %v)", got, v)
+	}
+	s = &APIEvent{}
+	if got := s.GetCreatedAt(); !got.IsZero() {
+		t.Errorf("GetCreatedAt() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetCreatedAt(); !got.IsZero() {
+		t.Errorf("GetCreatedAt() was %v when receiver was nil", got)
+	}
+}
+
+func TestAPIEvent_GetID(t *testing.T) {
+	v := "x"
+	s := &APIEvent{ID: &v}
+	if got := s.GetID(); got != v {
+		t.Errorf("GetID() was %v (should have been %v)", got, v)
+	}
+	s = &APIEvent{}
+	if got := s.GetID(); got != "" {
+		t.Errorf("GetID() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetID(); got != "" {
+		t.Errorf("GetID() was %v when receiver was nil", got)
+	}
+}
+
+func TestAPIEvent_GetOrg(t *testing.T) {
+	v := &Account{}
+	s := &APIEvent{Org: v}
+	if got := s.GetOrg(); got != v {
+		t.Errorf("GetOrg() didn't return the field")
+	}
+	s = nil
+	if got := s.GetOrg(); got != nil {
+		t.Errorf("GetOrg() was %v when receiver was nil", got)
+	}
+}
+
+func TestAPIEvent_GetPayload(t *testing.T) {
+	v := json.RawMessage{}
+	s := &APIEvent{Payload: v}
+	if got := s.GetPayload(); got == nil {
+		t.Errorf("GetPayload() was nil when field was non-nil")
+	}
+	s = nil
+	if got := s.GetPayload(); got != nil {
+		t.Errorf("GetPayload() was %v when receiver was nil", got)
+	}
+}
+
+func TestAPIEvent_GetPublic(t *testing.T) {
+	v := true
+	s := &APIEvent{Public: &v}
+	if got := s.GetPublic(); got != v {
+		t.Errorf("GetPublic() was %v (should have been %v)", got, v)
+	}
+	s = &APIEvent{}
+	if got := s.GetPublic(); got != false {
+		t.Errorf("GetPublic() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetPublic(); got != false {
+		t.Errorf("GetPublic() was %v when receiver was nil", got)
+	}
+}
+
+func TestAPIEvent_GetRepo(t *testing.T) {
+	v := &APIEventRepo{}
+	s := &APIEvent{Repo: v}
+	if got := s.GetRepo(); got != v {
+		t.Errorf("GetRepo() didn't return the field")
+	}
+	s = nil
+	if got := s.GetRepo(); got != nil {
+		t.Errorf("GetRepo() was %v when receiver was nil", got)
+	}
+}
+
+func TestAPIEvent_GetType(t *testing.T) {
+	v := "x"
+	s := &APIEvent{Type: &v}
+	if got := s.GetType(); got != v {
+		t.Errorf("GetType() was %v (should have been %v)", got, v)
+	}
+	s = &APIEvent{}
+	if got := s.GetType(); got != "" {
+		t.Errorf("GetType() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetType(); got != "" {
+		t.Errorf("GetType() was %v when receiver was nil", got)
+	}
+}
+
+func TestAPIEventRepo_GetID(t *testing.T) {
+	v := 1
+	s := &APIEventRepo{ID: &v}
+	if got := s.GetID(); got != v {
+		t.Errorf("GetID() was %v (should have been %v)", got, v)
+	}
+	s = &APIEventRepo{}
+	if got := s.GetID(); got != 0 {
+		t.Errorf("GetID() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetID(); got != 0 {
+		t.Errorf("GetID() was %v when receiver was nil", got)
+	}
+}
+
+func TestAPIEventRepo_GetName(t *testing.T) {
+	v := "x"
+	s := &APIEventRepo{Name: &v}
+	if got := s.GetName(); got != v {
+		t.Errorf("GetName() was %v (should have been %v)", got, v)
+	}
+	s = &APIEventRepo{}
+	if got := s.GetName(); got != "" {
+		t.Errorf("GetName() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetName(); got != "" {
+		t.Errorf("GetName() was %v when receiver was nil", got)
+	}
+}
+
+func TestAPIEventRepo_GetURL(t *testing.T) {
+	v := "x"
+	s := &APIEventRepo{URL: &v}
+	if got := s.GetURL(); got != v {
+		t.Errorf("GetURL() was %v (should have been %v)", got, v)
+	}
+	s = &APIEventRepo{}
+	if got := s.GetURL(); got != "" {
+		t.Errorf("GetURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetURL(); got != "" {
+		t.Errorf("GetURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestAccount_GetAvatarURL(t *testing.T) {
+	v := "x"
+	s := &Account{AvatarURL: &v}
+	if got := s.GetAvatarURL(); got != v {
+		t.Errorf("GetAvatarURL() was %v (should have been %v)", got, v)
+	}
+	s = &Account{}
+	if got := s.GetAvatarURL(); got != "" {
+		t.Errorf("GetAvatarURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetAvatarURL(); got != "" {
+		t.Errorf("GetAvatarURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestAccount_GetBio(t *testing.T) {
+	v := "x"
+	s := &Account{Bio: &v}
+	if got := s.GetBio(); got != v {
+		t.Errorf("GetBio() was %v (should have been %v)", got, v)
+	}
+	s = &Account{}
+	if got := s.GetBio(); got != "" {
+		t.Errorf("GetBio() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetBio(); got != "" {
+		t.Errorf("GetBio() was %v when receiver was nil", got)
+	}
+}
+
+func TestAccount_GetBlog(t *testing.T) {
+	v := "x"
+	s := &Account{Blog: &v}
+	if got := s.GetBlog(); got != v {
+		t.Errorf("GetBlog() was %v (should have been %v)", got, v)
+	}
+	s = &Account{}
+	if got := s.GetBlog(); got != "" {
+		t.Errorf("GetBlog() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetBlog(); got != "" {
+		t.Errorf("GetBlog() was %v when receiver was nil", got)
+	}
+}
+
+func TestAccount_GetCompany(t *testing.T) {
+	v := "x"
+	s := &Account{Company: &v}
+	if got := s.GetCompany(); got != v {
+		t.Errorf("GetCompany() was %v (should have been %v)", got, v)
+	}
+	s = &Account{}
+	if got := s.GetCompany(); got != "" {
+		t.Errorf("GetCompany() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetCompany(); got != "" {
+		t.Errorf("GetCompany() was %v when receiver was nil", got)
+	}
+}
+
+func TestAccount_GetCreatedAt(t *testing.T) {
+	v := time.Unix(1557933565, 0)
+	s := &Account{CreatedAt: &TimeWrapper{t: v}}
+	if got := s.GetCreatedAt(); !got.Equal(v) {
+		t.Errorf("GetCreatedAt() was %v (should have been %v)", got, v)
+	}
+	s = &Account{}
+	if got := s.GetCreatedAt(); !got.IsZero() {
+		t.Errorf("GetCreatedAt() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetCreatedAt(); !got.IsZero() {
+		t.Errorf("GetCreatedAt() was %v when receiver was nil", got)
+	}
+}
+
+func TestAccount_GetDescription(t *testing.T) {
+	v := "x"
+	s := &Account{Description: &v}
+	if got := s.GetDescription(); got != v {
+		t.Errorf("GetDescription() was %v (should have been %v)", got, v)
+	}
+	s = &Account{}
+	if got := s.GetDescription(); got != "" {
+		t.Errorf("GetDescription() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetDescription(); got != "" {
+		t.Errorf("GetDescription() was %v when receiver was nil", got)
+	}
+}
+
+func TestAccount_GetEmail(t *testing.T) {
+	v := "x"
+	s := &Account{Email: &v}
+	if got := s.GetEmail(); got != v {
+		t.Errorf("GetEmail() was %v (should have been %v)", got, v)
+	}
+	s = &Account{}
+	if got := s.GetEmail(); got != "" {
+		t.Errorf("GetEmail() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetEmail(); got != "" {
+		t.Errorf("GetEmail() was %v when receiver was nil", got)
+	}
+}
+
+func TestAccount_GetEventsURL(t *testing.T) {
+	v := "x"
+	s := &Account{EventsURL: &v}
+	if got := s.GetEventsURL(); got != v {
+		t.Errorf("GetEventsURL() was %v (should have been %v)", got, v)
+	}
+	s = &Account{}
+	if got := s.GetEventsURL(); got != "" {
+		t.Errorf("GetEventsURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetEventsURL(); got != "" {
+		t.Errorf("GetEventsURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestAccount_GetFollowers(t *testing.T) {
+	v := 1
+	s := &Account{Followers: &v}
+	if got := s.GetFollowers(); got != v {
+		t.Errorf("GetFollowers() was %v (should have been %v)", got, v)
+	}
+	s = &Account{}
+	if got := s.GetFollowers(); got != 0 {
+		t.Errorf("GetFollowers() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetFollowers(); got != 0 {
+		t.Errorf("GetFollowers() was %v when receiver was nil", got)
+	}
+}
+
+func TestAccount_GetFollowersURL(t *testing.T) {
+	v := "x"
+	s := &Account{FollowersURL: &v}
+	if got := s.GetFollowersURL(); got != v {
+		t.Errorf("GetFollowersURL() was %v (should have been %v)", got, v)
+	}
+	s = &Account{}
+	if got := s.GetFollowersURL(); got != "" {
+		t.Errorf("GetFollowersURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetFollowersURL(); got != "" {
+		t.Errorf("GetFollowersURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestAccount_GetFollowing(t *testing.T) {
+	v := 1
+	s := &Account{Following: &v}
+	if got := s.GetFollowing(); got != v {
+		t.Errorf("GetFollowing() was %v (should have been %v)", got, v)
+	}
+	s = &Account{}
+	if got := s.GetFollowing(); got != 0 {
+		t.Errorf("GetFollowing() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetFollowing(); got != 0 {
+		t.Errorf("GetFollowing() was %v when receiver was nil", got)
+	}
+}
+
+func TestAccount_GetFollowingURL(t *testing.T) {
+	v := "x"
+	s := &Account{FollowingURL: &v}
+	if got := s.GetFollowingURL(); got != v {
+		t.Errorf("GetFollowingURL() was %v (should have been %v)", got, v)
+	}
+	s = &Account{}
+	if got := s.GetFollowingURL(); got != "" {
+		t.Errorf("GetFollowingURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetFollowingURL(); got != "" {
+		t.Errorf("GetFollowingURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestAccount_GetGistsURL(t *testing.T) {
+	v := "x"
+	s := &Account{GistsURL: &v}
+	if got := s.GetGistsURL(); got != v {
+		t.Errorf("GetGistsURL() was %v (should have been %v)", got, v)
+	}
+	s = &Account{}
+	if got := s.GetGistsURL(); got != "" {
+		t.Errorf("GetGistsURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetGistsURL(); got != "" {
+		t.Errorf("GetGistsURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestAccount_GetGravatarID(t *testing.T) {
+	v := "x"
+	s := &Account{GravatarID: &v}
+	if got := s.GetGravatarID(); got != v {
+		t.Errorf("GetGravatarID() was %v (should have been %v)", got, v)
+	}
+	s = &Account{}
+	if got := s.GetGravatarID(); got != "" {
+		t.Errorf("GetGravatarID() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetGravatarID(); got != "" {
+		t.Errorf("GetGravatarID() was %v when receiver was nil", got)
+	}
+}
+
+func TestAccount_GetHTMLURL(t *testing.T) {
+	v := "x"
+	s := &Account{HTMLURL: &v}
+	if got := s.GetHTMLURL(); got != v {
+		t.Errorf("GetHTMLURL() was %v (should have been %v)", got, v)
+	}
+	s = &Account{}
+	if got := s.GetHTMLURL(); got != "" {
+		t.Errorf("GetHTMLURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetHTMLURL(); got != "" {
+		t.Errorf("GetHTMLURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestAccount_GetHireable(t *testing.T) {
+	v := true
+	s := &Account{Hireable: &v}
+	if got := s.GetHireable(); got != v {
+		t.Errorf("GetHireable() was %v (should have been %v)", got, v)
+	}
+	s = &Account{}
+	if got := s.GetHireable(); got != false {
+		t.Errorf("GetHireable() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetHireable(); got != false {
+		t.Errorf("GetHireable() was %v when receiver was nil", got)
+	}
+}
+
+func TestAccount_GetHooksURL(t *testing.T) {
+	v := "x"
+	s := &Account{HooksURL: &v}
+	if got := s.GetHooksURL(); got != v {
+		t.Errorf("GetHooksURL() was %v (should have been %v)", got, v)
+	}
+	s = &Account{}
+	if got := s.GetHooksURL(); got != "" {
+		t.Errorf("GetHooksURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetHooksURL(); got != "" {
+		t.Errorf("GetHooksURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestAccount_GetID(t *testing.T) {
+	v := 1
+	s := &Account{ID: &v}
+	if got := s.GetID(); got != v {
+		t.Errorf("GetID() was %v (should have been %v)", got, v)
+	}
+	s = &Account{}
+	if got := s.GetID(); got != 0 {
+		t.Errorf("GetID() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetID(); got != 0 {
+		t.Errorf("GetID() was %v when receiver was nil", got)
+	}
+}
+
+func TestAccount_GetIssuesURL(t *testing.T) {
+	v := "x"
+	s := &Account{IssuesURL: &v}
+	if got := s.GetIssuesURL(); got != v {
+		t.Errorf("GetIssuesURL() was %v (should have been %v)", got, v)
+	}
+	s = &Account{}
+	if got := s.GetIssuesURL(); got != "" {
+		t.Errorf("GetIssuesURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetIssuesURL(); got != "" {
+		t.Errorf("GetIssuesURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestAccount_GetLocation(t *testing.T) {
+	v := "x"
+	s := &Account{Location: &v}
+	if got := s.GetLocation(); got != v {
+		t.Errorf("GetLocation() was %v (should have been %v)", got, v)
+	}
+	s = &Account{}
+	if got := s.GetLocation(); got != "" {
+		t.Errorf("GetLocation() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetLocation(); got != "" {
+		t.Errorf("GetLocation() was %v when receiver was nil", got)
+	}
+}
+
+func TestAccount_GetLogin(t *testing.T) {
+	v := "x"
+	s := &Account{Login: &v}
+	if got := s.GetLogin(); got != v {
+		t.Errorf("GetLogin() was %v (should have been %v)", got, v)
+	}
+	s = &Account{}
+	if got := s.GetLogin(); got != "" {
+		t.Errorf("GetLogin() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetLogin(); got != "" {
+		t.Errorf("GetLogin() was %v when receiver was nil", got)
+	}
+}
+
+func TestAccount_GetMarketplacePendingChange(t *testing.T) {
+	v := &MarketplacePendingChange{}
+	s := &Account{MarketplacePendingChange: v}
+	if got := s.GetMarketplacePendingChange(); got != v {
+		t.Errorf("GetMarketplacePendingChange() didn't return the field")
+	}
+	s = nil
+	if got := s.GetMarketplacePendingChange(); got != nil {
+		t.Errorf("GetMarketplacePendingChange() was %v when receiver was nil", got)
+	}
+}
+
+func TestAccount_GetMarketplacePurchase(t *testing.T) {
+	v := &MarketplacePurchase{}
+	s := &Account{MarketplacePurchase: v}
+	if got := s.GetMarketplacePurchase(); got != v {
+		t.Errorf("GetMarketplacePurchase() didn't return the field")
+	}
+	s = nil
+	if got := s.GetMarketplacePurchase(); got != nil {
+		t.Errorf("GetMarketplacePurchase() was %v when receiver was nil", got)
+	}
+}
+
+func TestAccount_GetMembersURL(t *testing.T) {
+	v := "x"
+	s := &Account{MembersURL: &v}
+	if got := s.GetMembersURL(); got != v {
+		t.Errorf("GetMembersURL() was %v (should have been %v)", got, v)
+	}
+	s = &Account{}
+	if got := s.GetMembersURL(); got != "" {
+		t.Errorf("GetMembersURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetMembersURL(); got != "" {
+		t.Errorf("GetMembersURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestAccount_GetName(t *testing.T) {
+	v := "x"
+	s := &Account{Name: &v}
+	if got := s.GetName(); got != v {
+		t.Errorf("GetName() was %v (should have been %v)", got, v)
+	}
+	s = &Account{}
+	if got := s.GetName(); got != "" {
+		t.Errorf("GetName() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetName(); got != "" {
+		t.Errorf("GetName() was %v when receiver was nil", got)
+	}
+}
+
+func TestAccount_GetNodeID(t *testing.T) {
+	v := "x"
+	s := &Account{NodeID: &v}
+	if got := s.GetNodeID(); got != v {
+		t.Errorf("GetNodeID() was %v (should have been %v)", got, v)
+	}
+	s = &Account{}
+	if got := s.GetNodeID(); got != "" {
+		t.Errorf("GetNodeID() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetNodeID(); got != "" {
+		t.Errorf("GetNodeID() was %v when receiver was nil", got)
+	}
+}
+
+func TestAccount_GetOrganizationBillingEmail(t *testing.T) {
+	v := "x"
+	s := &Account{OrganizationBillingEmail: &v}
+	if got := s.GetOrganizationBillingEmail(); got != v {
+		t.Errorf("GetOrganizationBillingEmail() was %v (should have been %v)", got, v)
+	}
+	s = &Account{}
+	if got := s.GetOrganizationBillingEmail(); got != "" {
+		t.Errorf("GetOrganizationBillingEmail() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetOrganizationBillingEmail(); got != "" {
+		t.Errorf("GetOrganizationBillingEmail() was %v when receiver was nil", got)
+	}
+}
+
+func TestAccount_GetOrganizationsURL(t *testing.T) {
+	v := "x"
+	s := &Account{OrganizationsURL: &v}
+	if got := s.GetOrganizationsURL(); got != v {
+		t.Errorf("GetOrganizationsURL() was %v (should have been %v)", got, v)
+	}
+	s = &Account{}
+	if got := s.GetOrganizationsURL(); got != "" {
+		t.Errorf("GetOrganizationsURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetOrganizationsURL(); got != "" {
+		t.Errorf("GetOrganizationsURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestAccount_GetPublicGists(t *testing.T) {
+	v := 1
+	s := &Account{PublicGists: &v}
+	if got := s.GetPublicGists(); got != v {
+		t.Errorf("GetPublicGists() was %v (should have been %v)", got, v)
+	}
+	s = &Account{}
+	if got := s.GetPublicGists(); got != 0 {
+		t.Errorf("GetPublicGists() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetPublicGists(); got != 0 {
+		t.Errorf("GetPublicGists() was %v when receiver was nil", got)
+	}
+}
+
+func TestAccount_GetPublicMembersURL(t *testing.T) {
+	v := "x"
+	s := &Account{PublicMembersURL: &v}
+	if got := s.GetPublicMembersURL(); got != v {
+		t.Errorf("GetPublicMembersURL() was %v (should have been %v)", got, v)
+	}
+	s = &Account{}
+	if got := s.GetPublicMembersURL(); got != "" {
+		t.Errorf("GetPublicMembersURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetPublicMembersURL(); got != "" {
+		t.Errorf("GetPublicMembersURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestAccount_GetPublicRepos(t *testing.T) {
+	v := 1
+	s := &Account{PublicRepos: &v}
+	if got := s.GetPublicRepos(); got != v {
+		t.Errorf("GetPublicRepos() was %v (should have been %v)", got, v)
+	}
+	s = &Account{}
+	if got := s.GetPublicRepos(); got != 0 {
+		t.Errorf("GetPublicRepos() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetPublicRepos(); got != 0 {
+		t.Errorf("GetPublicRepos() was %v when receiver was nil", got)
+	}
+}
+
+func TestAccount_GetReceivedEventsURL(t *testing.T) {
+	v := "x"
+	s := &Account{ReceivedEventsURL: &v}
+	if got := s.GetReceivedEventsURL(); got != v {
+		t.Errorf("GetReceivedEventsURL() was %v (should have been %v)", got, v)
+	}
+	s = &Account{}
+	if got := s.GetReceivedEventsURL(); got != "" {
+		t.Errorf("GetReceivedEventsURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetReceivedEventsURL(); got != "" {
+		t.Errorf("GetReceivedEventsURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestAccount_GetReposURL(t *testing.T) {
+	v := "x"
+	s := &Account{ReposURL: &v}
+	if got := s.GetReposURL(); got != v {
+		t.Errorf("GetReposURL() was %v (should have been %v)", got, v)
+	}
+	s = &Account{}
+	if got := s.GetReposURL(); got != "" {
+		t.Errorf("GetReposURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetReposURL(); got != "" {
+		t.Errorf("GetReposURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestAccount_GetSiteAdmin(t *testing.T) {
+	v := true
+	s := &Account{SiteAdmin: &v}
+	if got := s.GetSiteAdmin(); got != v {
+		t.Errorf("GetSiteAdmin() was %v (should have been %v)", got, v)
+	}
+	s = &Account{}
+	if got := s.GetSiteAdmin(); got != false {
+		t.Errorf("GetSiteAdmin() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetSiteAdmin(); got != false {
+		t.Errorf("GetSiteAdmin() was %v when receiver was nil", got)
+	}
+}
+
+func TestAccount_GetStarredURL(t *testing.T) {
+	v := "x"
+	s := &Account{StarredURL: &v}
+	if got := s.GetStarredURL(); got != v {
+		t.Errorf("GetStarredURL() was %v (should have been %v)", got, v)
+	}
+	s = &Account{}
+	if got := s.GetStarredURL(); got != "" {
+		t.Errorf("GetStarredURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetStarredURL(); got != "" {
+		t.Errorf("GetStarredURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestAccount_GetSubscriptionsURL(t *testing.T) {
+	v := "x"
+	s := &Account{SubscriptionsURL: &v}
+	if got := s.GetSubscriptionsURL(); got != v {
+		t.Errorf("GetSubscriptionsURL() was %v (should have been %v)", got, v)
+	}
+	s = &Account{}
+	if got := s.GetSubscriptionsURL(); got != "" {
+		t.Errorf("GetSubscriptionsURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetSubscriptionsURL(); got != "" {
+		t.Errorf("GetSubscriptionsURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestAccount_GetTwitterUsername(t *testing.T) {
+	v := "x"
+	s := &Account{TwitterUsername: &v}
+	if got := s.GetTwitterUsername(); got != v {
+		t.Errorf("GetTwitterUsername() was %v (should have been %v)", got, v)
+	}
+	s = &Account{}
+	if got := s.GetTwitterUsername(); got != "" {
+		t.Errorf("GetTwitterUsername() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetTwitterUsername(); got != "" {
+		t.Errorf("GetTwitterUsername() was %v when receiver was nil", got)
+	}
+}
+
+func TestAccount_GetType(t *testing.T) {
+	v := "x"
+	s := &Account{Type: &v}
+	if got := s.GetType(); got != v {
+		t.Errorf("GetType() was %v (should have been %v)", got, v)
+	}
+	s = &Account{}
+	if got := s.GetType(); got != "" {
+		t.Errorf("GetType() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetType(); got != "" {
+		t.Errorf("GetType() was %v when receiver was nil", got)
+	}
+}
+
+func TestAccount_GetURL(t *testing.T) {
+	v := "x"
+	s := &Account{URL: &v}
+	if got := s.GetURL(); got != v {
+		t.Errorf("GetURL() was %v (should have been %v)", got, v)
+	}
+	s = &Account{}
+	if got := s.GetURL(); got != "" {
+		t.Errorf("GetURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetURL(); got != "" {
+		t.Errorf("GetURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestAccount_GetUpdatedAt(t *testing.T) {
+	v := time.Unix(1557933565, 0)
+	s := &Account{UpdatedAt: &TimeWrapper{t: v}}
+	if got := s.GetUpdatedAt(); !got.Equal(v) {
+		t.Errorf("GetUpdatedAt() was %v (should have been %v)", got, v)
+	}
+	s = &Account{}
+	if got := s.GetUpdatedAt(); !got.IsZero() {
+		t.Errorf("GetUpdatedAt() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetUpdatedAt(); !got.IsZero() {
+		t.Errorf("GetUpdatedAt() was %v when receiver was nil", got)
+	}
+}
+
+func TestAccount_GetUsername(t *testing.T) {
+	v := "x"
+	s := &Account{Username: &v}
+	if got := s.GetUsername(); got != v {
+		t.Errorf("GetUsername() was %v (should have been %v)", got, v)
+	}
+	s = &Account{}
+	if got := s.GetUsername(); got != "" {
+		t.Errorf("GetUsername() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetUsername(); got != "" {
+		t.Errorf("GetUsername() was %v when receiver was nil", got)
+	}
+}
+
+func TestApp_GetCreatedAt(t *testing.T) {
+	v := time.Unix(1557933565, 0)
+	s := &App{CreatedAt: &TimeWrapper{t: v}}
+	if got := s.GetCreatedAt(); !got.Equal(v) {
+		t.Errorf("GetCreatedAt() was %v (should have been %v)", got, v)
+	}
+	s = &App{}
+	if got := s.GetCreatedAt(); !got.IsZero() {
+		t.Errorf("GetCreatedAt() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetCreatedAt(); !got.IsZero() {
+		t.Errorf("GetCreatedAt() was %v when receiver was nil", got)
+	}
+}
+
+func TestApp_GetDescription(t *testing.T) {
+	v := "x"
+	s := &App{Description: &v}
+	if got := s.GetDescription(); got != v {
+		t.Errorf("GetDescription() was %v (should have been %v)", got, v)
+	}
+	s = &App{}
+	if got := s.GetDescription(); got != "" {
+		t.Errorf("GetDescription() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetDescription(); got != "" {
+		t.Errorf("GetDescription() was %v when receiver was nil", got)
+	}
+}
+
+func TestApp_GetEvents(t *testing.T) {
+	v := []string{}
+	s := &App{Events: v}
+	if got := s.GetEvents(); got == nil {
+		t.Errorf("GetEvents() was nil when field was non-nil")
+	}
+	s = nil
+	if got := s.GetEvents(); got != nil {
+		t.Errorf("GetEvents() was %v when receiver was nil", got)
+	}
+}
+
+func TestApp_GetExternalURL(t *testing.T) {
+	v := "x"
+	s := &App{ExternalURL: &v}
+	if got := s.GetExternalURL(); got != v {
+		t.Errorf("GetExternalURL() was %v (should have been %v)", got, v)
+	}
+	s = &App{}
+	if got := s.GetExternalURL(); got != "" {
+		t.Errorf("GetExternalURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetExternalURL(); got != "" {
+		t.Errorf("GetExternalURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestApp_GetHTMLURL(t *testing.T) {
+	v := "x"
+	s := &App{HTMLURL: &v}
+	if got := s.GetHTMLURL(); got != v {
+		t.Errorf("GetHTMLURL() was %v (should have been %v)", got, v)
+	}
+	s = &App{}
+	if got := s.GetHTMLURL(); got != "" {
+		t.Errorf("GetHTMLURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetHTMLURL(); got != "" {
+		t.Errorf("GetHTMLURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestApp_GetID(t *testing.T) {
+	v := 1
+	s := &App{ID: &v}
+	if got := s.GetID(); got != v {
+		t.Errorf("GetID() was %v (should have been %v)", got, v)
+	}
+	s = &App{}
+	if got := s.GetID(); got != 0 {
+		t.Errorf("GetID() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetID(); got != 0 {
+		t.Errorf("GetID() was %v when receiver was nil", got)
+	}
+}
+
+func TestApp_GetName(t *testing.T) {
+	v := "x"
+	s := &App{Name: &v}
+	if got := s.GetName(); got != v {
+		t.Errorf("GetName() was %v (should have been %v)", got, v)
+	}
+	s = &App{}
+	if got := s.GetName(); got != "" {
+		t.Errorf("GetName() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetName(); got != "" {
+		t.Errorf("GetName() was %v when receiver was nil", got)
+	}
+}
+
+func TestApp_GetNodeID(t *testing.T) {
+	v := "x"
+	s := &App{NodeID: &v}
+	if got := s.GetNodeID(); got != v {
+		t.Errorf("GetNodeID() was %v (should have been %v)", got, v)
+	}
+	s = &App{}
+	if got := s.GetNodeID(); got != "" {
+		t.Errorf("GetNodeID() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetNodeID(); got != "" {
+		t.Errorf("GetNodeID() was %v when receiver was nil", got)
+	}
+}
+
+func TestApp_GetOwner(t *testing.T) {
+	v := &Account{}
+	s := &App{Owner: v}
+	if got := s.GetOwner(); got != v {
+		t.Errorf("GetOwner() didn't return the field")
+	}
+	s = nil
+	if got := s.GetOwner(); got != nil {
+		t.Errorf("GetOwner() was %v when receiver was nil", got)
+	}
+}
+
+func TestApp_GetPermissions(t *testing.T) {
+	v := map[string]string{}
+	s := &App{Permissions: v}
+	if got := s.GetPermissions(); got == nil {
+		t.Errorf("GetPermissions() was nil when field was non-nil")
+	}
+	s = nil
+	if got := s.GetPermissions(); got != nil {
+		t.Errorf("GetPermissions() was %v when receiver was nil", got)
+	}
+}
+
+func TestApp_GetSlug(t *testing.T) {
+	v := "x"
+	s := &App{Slug: &v}
+	if got := s.GetSlug(); got != v {
+		t.Errorf("GetSlug() was %v (should have been %v)", got, v)
+	}
+	s = &App{}
+	if got := s.GetSlug(); got != "" {
+		t.Errorf("GetSlug() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetSlug(); got != "" {
+		t.Errorf("GetSlug() was %v when receiver was nil", got)
+	}
+}
+
+func TestApp_GetUpdatedAt(t *testing.T) {
+	v := time.Unix(1557933565, 0)
+	s := &App{UpdatedAt: &TimeWrapper{t: v}}
+	if got := s.GetUpdatedAt(); !got.Equal(v) {
+		t.Errorf("GetUpdatedAt() was %v (should have been %v)", got, v)
+	}
+	s = &App{}
+	if got := s.GetUpdatedAt(); !got.IsZero() {
+		t.Errorf("GetUpdatedAt() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetUpdatedAt(); !got.IsZero() {
+		t.Errorf("GetUpdatedAt() was %v when receiver was nil", got)
+	}
+}
+
+func TestAutoMerge_GetCommitMessage(t *testing.T) {
+	v := "x"
+	s := &AutoMerge{CommitMessage: &v}
+	if got := s.GetCommitMessage(); got != v {
+		t.Errorf("GetCommitMessage() was %v (should have been %v)", got, v)
+	}
+	s = &AutoMerge{}
+	if got := s.GetCommitMessage(); got != "" {
+		t.Errorf("GetCommitMessage() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetCommitMessage(); got != "" {
+		t.Errorf("GetCommitMessage() was %v when receiver was nil", got)
+	}
+}
+
+func TestAutoMerge_GetCommitTitle(t *testing.T) {
+	v := "x"
+	s := &AutoMerge{CommitTitle: &v}
+	if got := s.GetCommitTitle(); got != v {
+		t.Errorf("GetCommitTitle() was %v (should have been %v)", got, v)
+	}
+	s = &AutoMerge{}
+	if got := s.GetCommitTitle(); got != "" {
+		t.Errorf("GetCommitTitle() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetCommitTitle(); got != "" {
+		t.Errorf("GetCommitTitle() was %v when receiver was nil", got)
+	}
+}
+
+func TestAutoMerge_GetEnabledBy(t *testing.T) {
+	v := &Account{}
+	s := &AutoMerge{EnabledBy: v}
+	if got := s.GetEnabledBy(); got != v {
+		t.Errorf("GetEnabledBy() didn't return the field")
+	}
+	s = nil
+	if got := s.GetEnabledBy(); got != nil {
+		t.Errorf("GetEnabledBy() was %v when receiver was nil", got)
+	}
+}
+
+func TestAutoMerge_GetMergeMethod(t *testing.T) {
+	v := "x"
+	s := &AutoMerge{MergeMethod: &v}
+	if got := s.GetMergeMethod(); got != v {
+		t.Errorf("GetMergeMethod() was %v (should have been %v)", got, v)
+	}
+	s = &AutoMerge{}
+	if got := s.GetMergeMethod(); got != "" {
+		t.Errorf("GetMergeMethod() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetMergeMethod(); got != "" {
+		t.Errorf("GetMergeMethod() was %v when receiver was nil", got)
+	}
+}
+
+func TestBaseChanges_GetRef(t *testing.T) {
+	v := &ChangeFrom{}
+	s := &BaseChanges{Ref: v}
+	if got := s.GetRef(); got != v {
+		t.Errorf("GetRef() didn't return the field")
+	}
+	s = nil
+	if got := s.GetRef(); got != nil {
+		t.Errorf("GetRef() was %v when receiver was nil", got)
+	}
+}
+
+func TestBaseChanges_GetSHA(t *testing.T) {
+	v := &ChangeFrom{}
+	s := &BaseChanges{SHA: v}
+	if got := s.GetSHA(); got != v {
+		t.Errorf("GetSHA() didn't return the field")
+	}
+	s = nil
+	if got := s.GetSHA(); got != nil {
+		t.Errorf("GetSHA() was %v when receiver was nil", got)
+	}
+}
+
+func TestChangeFrom_GetFrom(t *testing.T) {
+	v := "x"
+	s := &ChangeFrom{From: &v}
+	if got := s.GetFrom(); got != v {
+		t.Errorf("GetFrom() was %v (should have been %v)", got, v)
+	}
+	s = &ChangeFrom{}
+	if got := s.GetFrom(); got != "" {
+		t.Errorf("GetFrom() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetFrom(); got != "" {
+		t.Errorf("GetFrom() was %v when receiver was nil", got)
+	}
+}
+
+func TestChanges_GetBase(t *testing.T) {
+	v := &BaseChanges{}
+	s := &Changes{Base: v}
+	if got := s.GetBase(); got != v {
+		t.Errorf("GetBase() didn't return the field")
+	}
+	s = nil
+	if got := s.GetBase(); got != nil {
+		t.Errorf("GetBase() was %v when receiver was nil", got)
+	}
+}
+
+func TestChanges_GetBody(t *testing.T) {
+	v := &ChangeFrom{}
+	s := &Changes{Body: v}
+	if got := s.GetBody(); got != v {
+		t.Errorf("GetBody() didn't return the field")
+	}
+	s = nil
+	if got := s.GetBody(); got != nil {
+		t.Errorf("GetBody() was %v when receiver was nil", got)
+	}
+}
+
+func TestChanges_GetColor(t *testing.T) {
+	v := &ChangeFrom{}
+	s := &Changes{Color: v}
+	if got := s.GetColor(); got != v {
+		t.Errorf("GetColor() didn't return the field")
+	}
+	s = nil
+	if got := s.GetColor(); got != nil {
+		t.Errorf("GetColor() was %v when receiver was nil", got)
+	}
+}
+
+func TestChanges_GetDescription(t *testing.T) {
+	v := &ChangeFrom{}
+	s := &Changes{Description: v}
+	if got := s.GetDescription(); got != v {
+		t.Errorf("GetDescription() didn't return the field")
+	}
+	s = nil
+	if got := s.GetDescription(); got != nil {
+		t.Errorf("GetDescription() was %v when receiver was nil", got)
+	}
+}
+
+func TestChanges_GetName(t *testing.T) {
+	v := &ChangeFrom{}
+	s := &Changes{Name: v}
+	if got := s.GetName(); got != v {
+		t.Errorf("GetName() didn't return the field")
+	}
+	s = nil
+	if got := s.GetName(); got != nil {
+		t.Errorf("GetName() was %v when receiver was nil", got)
+	}
+}
+
+func TestChanges_GetTitle(t *testing.T) {
+	v := &ChangeFrom{}
+	s := &Changes{Title: v}
+	if got := s.GetTitle(); got != v {
+		t.Errorf("GetTitle() didn't return the field")
+	}
+	s = nil
+	if got := s.GetTitle(); got != nil {
+		t.Errorf("GetTitle() was %v when receiver was nil", got)
+	}
+}
+
+func TestCommit_GetAdded(t *testing.T) {
+	v := []string{}
+	s := &Commit{Added: v}
+	if got := s.GetAdded(); got == nil {
+		t.Errorf("GetAdded() was nil when field was non-nil")
+	}
+	s = nil
+	if got := s.GetAdded(); got != nil {
+		t.Errorf("GetAdded() was %v when receiver was nil", got)
+	}
+}
+
+func TestCommit_GetAuthor(t *testing.T) {
+	v := &Account{}
+	s := &Commit{Author: v}
+	if got := s.GetAuthor(); got != v {
+		t.Errorf("GetAuthor() didn't return the field")
+	}
+	s = nil
+	if got := s.GetAuthor(); got != nil {
+		t.Errorf("GetAuthor() was %v when receiver was nil", got)
+	}
+}
+
+func TestCommit_GetCommentsURL(t *testing.T) {
+	v := "x"
+	s := &Commit{CommentsURL: &v}
+	if got := s.GetCommentsURL(); got != v {
+		t.Errorf("GetCommentsURL() was %v (should have been %v)", got, v)
+	}
+	s = &Commit{}
+	if got := s.GetCommentsURL(); got != "" {
+		t.Errorf("GetCommentsURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetCommentsURL(); got != "" {
+		t.Errorf("GetCommentsURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestCommit_GetCommit(t *testing.T) {
+	v := &CommitData{}
+	s := &Commit{Commit: v}
+	if got := s.GetCommit(); got != v {
+		t.Errorf("GetCommit() didn't return the field")
+	}
+	s = nil
+	if got := s.GetCommit(); got != nil {
+		t.Errorf("GetCommit() was %v when receiver was nil", got)
+	}
+}
+
+func TestCommit_GetCommitter(t *testing.T) {
+	v := &Account{}
+	s := &Commit{Committer: v}
+	if got := s.GetCommitter(); got != v {
+		t.Errorf("GetCommitter() didn't return the field")
+	}
+	s = nil
+	if got := s.GetCommitter(); got != nil {
+		t.Errorf("GetCommitter() was %v when receiver was nil", got)
+	}
+}
+
+func TestCommit_GetDistinct(t *testing.T) {
+	v := true
+	s := &Commit{Distinct: &v}
+	if got := s.GetDistinct(); got != v {
+		t.Errorf("GetDistinct() was %v (should have been %v)", got, v)
+	}
+	s = &Commit{}
+	if got := s.GetDistinct(); got != false {
+		t.Errorf("GetDistinct() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetDistinct(); got != false {
+		t.Errorf("GetDistinct() was %v when receiver was nil", got)
+	}
+}
+
+func TestCommit_GetHTMLURL(t *testing.T) {
+	v := "x"
+	s := &Commit{HTMLURL: &v}
+	if got := s.GetHTMLURL(); got != v {
+		t.Errorf("GetHTMLURL() was %v (should have been %v)", got, v)
+	}
+	s = &Commit{}
+	if got := s.GetHTMLURL(); got != "" {
+		t.Errorf("GetHTMLURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetHTMLURL(); got != "" {
+		t.Errorf("GetHTMLURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestCommit_GetID(t *testing.T) {
+	v := "x"
+	s := &Commit{ID: &v}
+	if got := s.GetID(); got != v {
+		t.Errorf("GetID() was %v (should have been %v)", got, v)
+	}
+	s = &Commit{}
+	if got := s.GetID(); got != "" {
+		t.Errorf("GetID() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetID(); got != "" {
+		t.Errorf("GetID() was %v when receiver was nil", got)
+	}
+}
+
+func TestCommit_GetMessage(t *testing.T) {
+	v := "x"
+	s := &Commit{Message: &v}
+	if got := s.GetMessage(); got != v {
+		t.Errorf("GetMessage() was %v (should have been %v)", got, v)
+	}
+	s = &Commit{}
+	if got := s.GetMessage(); got != "" {
+		t.Errorf("GetMessage() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetMessage(); got != "" {
+		t.Errorf("GetMessage() was %v when receiver was nil", got)
+	}
+}
+
+func TestCommit_GetModified(t *testing.T) {
+	v := []string{}
+	s := &Commit{Modified: v}
+	if got := s.GetModified(); got == nil {
+		t.Errorf("GetModified() was nil when field was non-nil")
+	}
+	s = nil
+	if got := s.GetModified(); got != nil {
+		t.Errorf("GetModified() was %v when receiver was nil", got)
+	}
+}
+
+func TestCommit_GetNodeID(t *testing.T) {
+	v := "x"
+	s := &Commit{NodeID: &v}
+	if got := s.GetNodeID(); got != v {
+		t.Errorf("GetNodeID() was %v (should have been %v)", got, v)
+	}
+	s = &Commit{}
+	if got := s.GetNodeID(); got != "" {
+		t.Errorf("GetNodeID() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetNodeID(); got != "" {
+		t.Errorf("GetNodeID() was %v when receiver was nil", got)
+	}
+}
+
+func TestCommit_GetParents(t *testing.T) {
+	v := []TreeObject{}
+	s := &Commit{Parents: v}
+	if got := s.GetParents(); got == nil {
+		t.Errorf("GetParents() was nil when field was non-nil")
+	}
+	s = nil
+	if got := s.GetParents(); got != nil {
+		t.Errorf("GetParents() was %v when receiver was nil", got)
+	}
+}
+
+func TestCommit_GetRemoved(t *testing.T) {
+	v := []string{}
+	s := &Commit{Removed: v}
+	if got := s.GetRemoved(); got == nil {
+		t.Errorf("GetRemoved() was nil when field was non-nil")
+	}
+	s = nil
+	if got := s.GetRemoved(); got != nil {
+		t.Errorf("GetRemoved() was %v when receiver was nil", got)
+	}
+}
+
+func TestCommit_GetSHA(t *testing.T) {
+	v := "x"
+	s := &Commit{SHA: &v}
+	if got := s.GetSHA(); got != v {
+		t.Errorf("GetSHA() was %v (should have been %v)", got, v)
+	}
+	s = &Commit{}
+	if got := s.GetSHA(); got != "" {
+		t.Errorf("GetSHA() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetSHA(); got != "" {
+		t.Errorf("GetSHA() was %v when receiver was nil", got)
+	}
+}
+
+func TestCommit_GetTimestamp(t *testing.T) {
+	v := time.Unix(1557933565, 0)
+	s := &Commit{Timestamp: &TimeWrapper{t: v}}
+	if got := s.GetTimestamp(); !got.Equal(v) {
+		t.Errorf("GetTimestamp() was %v (should have been %v)", got, v)
+	}
+	s = &Commit{}
+	if got := s.GetTimestamp(); !got.IsZero() {
+		t.Errorf("GetTimestamp() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetTimestamp(); !got.IsZero() {
+		t.Errorf("GetTimestamp() was %v when receiver was nil", got)
+	}
+}
+
+func TestCommit_GetTreeID(t *testing.T) {
+	v := "x"
+	s := &Commit{TreeID: &v}
+	if got := s.GetTreeID(); got != v {
+		t.Errorf("GetTreeID() was %v (should have been %v)", got, v)
+	}
+	s = &Commit{}
+	if got := s.GetTreeID(); got != "" {
+		t.Errorf("GetTreeID() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetTreeID(); got != "" {
+		t.Errorf("GetTreeID() was %v when receiver was nil", got)
+	}
+}
+
+func TestCommit_GetURL(t *testing.T) {
+	v := "x"
+	s := &Commit{URL: &v}
+	if got := s.GetURL(); got != v {
+		t.Errorf("GetURL() was %v (should have been %v)", got, v)
+	}
+	s = &Commit{}
+	if got := s.GetURL(); got != "" {
+		t.Errorf("GetURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetURL(); got != "" {
+		t.Errorf("GetURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestCommitData_GetAuthor(t *testing.T) {
+	v := &CommitUser{}
+	s := &CommitData{Author: v}
+	if got := s.GetAuthor(); got != v {
+		t.Errorf("GetAuthor() didn't return the field")
+	}
+	s = nil
+	if got := s.GetAuthor(); got != nil {
+		t.Errorf("GetAuthor() was %v when receiver was nil", got)
+	}
+}
+
+func TestCommitData_GetCommentCount(t *testing.T) {
+	v := 1
+	s := &CommitData{CommentCount: &v}
+	if got := s.GetCommentCount(); got != v {
+		t.Errorf("GetCommentCount() was %v (should have been %v)", got, v)
+	}
+	s = &CommitData{}
+	if got := s.GetCommentCount(); got != 0 {
+		t.Errorf("GetCommentCount() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetCommentCount(); got != 0 {
+		t.Errorf("GetCommentCount() was %v when receiver was nil", got)
+	}
+}
+
+func TestCommitData_GetCommitter(t *testing.T) {
+	v := &CommitUser{}
+	s := &CommitData{Committer: v}
+	if got := s.GetCommitter(); got != v {
+		t.Errorf("GetCommitter() didn't return the field")
+	}
+	s = nil
+	if got := s.GetCommitter(); got != nil {
+		t.Errorf("GetCommitter() was %v when receiver was nil", got)
+	}
+}
+
+func TestCommitData_GetMessage(t *testing.T) {
+	v := "x"
+	s := &CommitData{Message: &v}
+	if got := s.GetMessage(); got != v {
+		t.Errorf("GetMessage() was %v (should have been %v)", got, v)
+	}
+	s = &CommitData{}
+	if got := s.GetMessage(); got != "" {
+		t.Errorf("GetMessage() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetMessage(); got != "" {
+		t.Errorf("GetMessage() was %v when receiver was nil", got)
+	}
+}
+
+func TestCommitData_GetTree(t *testing.T) {
+	v := &TreeObject{}
+	s := &CommitData{Tree: v}
+	if got := s.GetTree(); got != v {
+		t.Errorf("GetTree() didn't return the field")
+	}
+	s = nil
+	if got := s.GetTree(); got != nil {
+		t.Errorf("GetTree() was %v when receiver was nil", got)
+	}
+}
+
+func TestCommitData_GetURL(t *testing.T) {
+	v := "x"
+	s := &CommitData{URL: &v}
+	if got := s.GetURL(); got != v {
+		t.Errorf("GetURL() was %v (should have been %v)", got, v)
+	}
+	s = &CommitData{}
+	if got := s.GetURL(); got != "" {
+		t.Errorf("GetURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetURL(); got != "" {
+		t.Errorf("GetURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestCommitData_GetVerification(t *testing.T) {
+	v := &VerificationObject{}
+	s := &CommitData{Verification: v}
+	if got := s.GetVerification(); got != v {
+		t.Errorf("GetVerification() didn't return the field")
+	}
+	s = nil
+	if got := s.GetVerification(); got != nil {
+		t.Errorf("GetVerification() was %v when receiver was nil", got)
+	}
+}
+
+func TestCommitUser_GetDate(t *testing.T) {
+	v := time.Unix(1557933565, 0)
+	s := &CommitUser{Date: &TimeWrapper{t: v}}
+	if got := s.GetDate(); !got.Equal(v) {
+		t.Errorf("GetDate() was %v (should have been %v)", got, v)
+	}
+	s = &CommitUser{}
+	if got := s.GetDate(); !got.IsZero() {
+		t.Errorf("GetDate() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetDate(); !got.IsZero() {
+		t.Errorf("GetDate() was %v when receiver was nil", got)
+	}
+}
+
+func TestCommitUser_GetEmail(t *testing.T) {
+	v := "x"
+	s := &CommitUser{Email: &v}
+	if got := s.GetEmail(); got != v {
+		t.Errorf("GetEmail() was %v (should have been %v)", got, v)
+	}
+	s = &CommitUser{}
+	if got := s.GetEmail(); got != "" {
+		t.Errorf("GetEmail() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetEmail(); got != "" {
+		t.Errorf("GetEmail() was %v when receiver was nil", got)
+	}
+}
+
+func TestCommitUser_GetName(t *testing.T) {
+	v := "x"
+	s := &CommitUser{Name: &v}
+	if got := s.GetName(); got != v {
+		t.Errorf("GetName() was %v (should have been %v)", got, v)
+	}
+	s = &CommitUser{}
+	if got := s.GetName(); got != "" {
+		t.Errorf("GetName() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetName(); got != "" {
+		t.Errorf("GetName() was %v when receiver was nil", got)
+	}
+}
+
+func TestEmailUser_GetEmail(t *testing.T) {
+	v := "x"
+	s := &EmailUser{Email: &v}
+	if got := s.GetEmail(); got != v {
+		t.Errorf("GetEmail() was %v (should have been %v)", got, v)
+	}
+	s = &EmailUser{}
+	if got := s.GetEmail(); got != "" {
+		t.Errorf("GetEmail() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetEmail(); got != "" {
+		t.Errorf("GetEmail() was %v when receiver was nil", got)
+	}
+}
+
+func TestEmailUser_GetName(t *testing.T) {
+	v := "x"
+	s := &EmailUser{Name: &v}
+	if got := s.GetName(); got != v {
+		t.Errorf("GetName() was %v (should have been %v)", got, v)
+	}
+	s = &EmailUser{}
+	if got := s.GetName(); got != "" {
+		t.Errorf("GetName() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetName(); got != "" {
+		t.Errorf("GetName() was %v when receiver was nil", got)
+	}
+}
+
+func TestForkEvent_GetForkee(t *testing.T) {
+	v := &Repository{}
+	s := &ForkEvent{Forkee: v}
+	if got := s.GetForkee(); got != v {
+		t.Errorf("GetForkee() didn't return the field")
+	}
+	s = nil
+	if got := s.GetForkee(); got != nil {
+		t.Errorf("GetForkee() was %v when receiver was nil", got)
+	}
+}
+
+func TestForkEvent_GetInstallation(t *testing.T) {
+	v := &Installation{}
+	s := &ForkEvent{Installation: v}
+	if got := s.GetInstallation(); got != v {
+		t.Errorf("GetInstallation() didn't return the field")
+	}
+	s = nil
+	if got := s.GetInstallation(); got != nil {
+		t.Errorf("GetInstallation() was %v when receiver was nil", got)
+	}
+}
+
+func TestForkEvent_GetOrganization(t *testing.T) {
+	v := &Account{}
+	s := &ForkEvent{Organization: v}
+	if got := s.GetOrganization(); got != v {
+		t.Errorf("GetOrganization() didn't return the field")
+	}
+	s = nil
+	if got := s.GetOrganization(); got != nil {
+		t.Errorf("GetOrganization() was %v when receiver was nil", got)
+	}
+}
+
+func TestForkEvent_GetRepository(t *testing.T) {
+	v := &Repository{}
+	s := &ForkEvent{Repository: v}
+	if got := s.GetRepository(); got != v {
+		t.Errorf("GetRepository() didn't return the field")
+	}
+	s = nil
+	if got := s.GetRepository(); got != nil {
+		t.Errorf("GetRepository() was %v when receiver was nil", got)
+	}
+}
+
+func TestForkEvent_GetSender(t *testing.T) {
+	v := &Account{}
+	s := &ForkEvent{Sender: v}
+	if got := s.GetSender(); got != v {
+		t.Errorf("GetSender() didn't return the field")
+	}
+	s = nil
+	if got := s.GetSender(); got != nil {
+		t.Errorf("GetSender() was %v when receiver was nil", got)
+	}
+}
+
+func TestHook_GetActive(t *testing.T) {
+	v := true
+	s := &Hook{Active: &v}
+	if got := s.GetActive(); got != v {
+		t.Errorf("GetActive() was %v (should have been %v)", got, v)
+	}
+	s = &Hook{}
+	if got := s.GetActive(); got != false {
+		t.Errorf("GetActive() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetActive(); got != false {
+		t.Errorf("GetActive() was %v when receiver was nil", got)
+	}
+}
+
+func TestHook_GetAppID(t *testing.T) {
+	v := 1
+	s := &Hook{AppID: &v}
+	if got := s.GetAppID(); got != v {
+		t.Errorf("GetAppID() was %v (should have been %v)", got, v)
+	}
+	s = &Hook{}
+	if got := s.GetAppID(); got != 0 {
+		t.Errorf("GetAppID() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetAppID(); got != 0 {
+		t.Errorf("GetAppID() was %v when receiver was nil", got)
+	}
+}
+
+func TestHook_GetConfig(t *testing.T) {
+	v := &HookConfig{}
+	s := &Hook{Config: v}
+	if got := s.GetConfig(); got != v {
+		t.Errorf("GetConfig() didn't return the field")
+	}
+	s = nil
+	if got := s.GetConfig(); got != nil {
+		t.Errorf("GetConfig() was %v when receiver was nil", got)
+	}
+}
+
+func TestHook_GetCreatedAt(t *testing.T) {
+	v := time.Unix(1557933565, 0)
+	s := &Hook{CreatedAt: &TimeWrapper{t: v}}
+	if got := s.GetCreatedAt(); !got.Equal(v) {
+		t.Errorf("GetCreatedAt() was %v (should have been %v)", got, v)
+	}
+	s = &Hook{}
+	if got := s.GetCreatedAt(); !got.IsZero() {
+		t.Errorf("GetCreatedAt() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetCreatedAt(); !got.IsZero() {
+		t.Errorf("GetCreatedAt() was %v when receiver was nil", got)
+	}
+}
+
+func TestHook_GetDeliveriesURL(t *testing.T) {
+	v := "x"
+	s := &Hook{DeliveriesURL: &v}
+	if got := s.GetDeliveriesURL(); got != v {
+		t.Errorf("GetDeliveriesURL() was %v (should have been %v)", got, v)
+	}
+	s = &Hook{}
+	if got := s.GetDeliveriesURL(); got != "" {
+		t.Errorf("GetDeliveriesURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetDeliveriesURL(); got != "" {
+		t.Errorf("GetDeliveriesURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestHook_GetEvents(t *testing.T) {
+	v := []string{}
+	s := &Hook{Events: v}
+	if got := s.GetEvents(); got == nil {
+		t.Errorf("GetEvents() was nil when field was non-nil")
+	}
+	s = nil
+	if got := s.GetEvents(); got != nil {
+		t.Errorf("GetEvents() was %v when receiver was nil", got)
+	}
+}
+
+func TestHook_GetID(t *testing.T) {
+	v := 1
+	s := &Hook{ID: &v}
+	if got := s.GetID(); got != v {
+		t.Errorf("GetID() was %v (should have been %v)", got, v)
+	}
+	s = &Hook{}
+	if got := s.GetID(); got != 0 {
+		t.Errorf("GetID() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetID(); got != 0 {
+		t.Errorf("GetID() was %v when receiver was nil", got)
+	}
+}
+
+func TestHook_GetLastResponse(t *testing.T) {
+	v := &HookResponse{}
+	s := &Hook{LastResponse: v}
+	if got := s.GetLastResponse(); got != v {
+		t.Errorf("GetLastResponse() didn't return the field")
+	}
+	s = nil
+	if got := s.GetLastResponse(); got != nil {
+		t.Errorf("GetLastResponse() was %v when receiver was nil", got)
+	}
+}
+
+func TestHook_GetName(t *testing.T) {
+	v := "x"
+	s := &Hook{Name: &v}
+	if got := s.GetName(); got != v {
+		t.Errorf("GetName() was %v (should have been %v)", got, v)
+	}
+	s = &Hook{}
+	if got := s.GetName(); got != "" {
+		t.Errorf("GetName() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetName(); got != "" {
+		t.Errorf("GetName() was %v when receiver was nil", got)
+	}
+}
+
+func TestHook_GetPingURL(t *testing.T) {
+	v := "x"
+	s := &Hook{PingURL: &v}
+	if got := s.GetPingURL(); got != v {
+		t.Errorf("GetPingURL() was %v (should have been %v)", got, v)
+	}
+	s = &Hook{}
+	if got := s.GetPingURL(); got != "" {
+		t.Errorf("GetPingURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetPingURL(); got != "" {
+		t.Errorf("GetPingURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestHook_GetTestURL(t *testing.T) {
+	v := "x"
+	s := &Hook{TestURL: &v}
+	if got := s.GetTestURL(); got != v {
+		t.Errorf("GetTestURL() was %v (should have been %v)", got, v)
+	}
+	s = &Hook{}
+	if got := s.GetTestURL(); got != "" {
+		t.Errorf("GetTestURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetTestURL(); got != "" {
+		t.Errorf("GetTestURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestHook_GetType(t *testing.T) {
+	v := "x"
+	s := &Hook{Type: &v}
+	if got := s.GetType(); got != v {
+		t.Errorf("GetType() was %v (should have been %v)", got, v)
+	}
+	s = &Hook{}
+	if got := s.GetType(); got != "" {
+		t.Errorf("GetType() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetType(); got != "" {
+		t.Errorf("GetType() was %v when receiver was nil", got)
+	}
+}
+
+func TestHook_GetURL(t *testing.T) {
+	v := "x"
+	s := &Hook{URL: &v}
+	if got := s.GetURL(); got != v {
+		t.Errorf("GetURL() was %v (should have been %v)", got, v)
+	}
+	s = &Hook{}
+	if got := s.GetURL(); got != "" {
+		t.Errorf("GetURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetURL(); got != "" {
+		t.Errorf("GetURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestHook_GetUpdatedAt(t *testing.T) {
+	v := time.Unix(1557933565, 0)
+	s := &Hook{UpdatedAt: &TimeWrapper{t: v}}
+	if got := s.GetUpdatedAt(); !got.Equal(v) {
+		t.Errorf("GetUpdatedAt() was %v (should have been %v)", got, v)
+	}
+	s = &Hook{}
+	if got := s.GetUpdatedAt(); !got.IsZero() {
+		t.Errorf("GetUpdatedAt() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetUpdatedAt(); !got.IsZero() {
+		t.Errorf("GetUpdatedAt() was %v when receiver was nil", got)
+	}
+}
+
+func TestHookConfig_GetContentType(t *testing.T) {
+	v := "x"
+	s := &HookConfig{ContentType: &v}
+	if got := s.GetContentType(); got != v {
+		t.Errorf("GetContentType() was %v (should have been %v)", got, v)
+	}
+	s = &HookConfig{}
+	if got := s.GetContentType(); got != "" {
+		t.Errorf("GetContentType() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetContentType(); got != "" {
+		t.Errorf("GetContentType() was %v when receiver was nil", got)
+	}
+}
+
+func TestHookConfig_GetInsecureSSL(t *testing.T) {
+	v := "x"
+	s := &HookConfig{InsecureSSL: &v}
+	if got := s.GetInsecureSSL(); got != v {
+		t.Errorf("GetInsecureSSL() was %v (should have been %v)", got, v)
+	}
+	s = &HookConfig{}
+	if got := s.GetInsecureSSL(); got != "" {
+		t.Errorf("GetInsecureSSL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetInsecureSSL(); got != "" {
+		t.Errorf("GetInsecureSSL() was %v when receiver was nil", got)
+	}
+}
+
+func TestHookConfig_GetSecret(t *testing.T) {
+	v := "x"
+	s := &HookConfig{Secret: &v}
+	if got := s.GetSecret(); got != v {
+		t.Errorf("GetSecret() was %v (should have been %v)", got, v)
+	}
+	s = &HookConfig{}
+	if got := s.GetSecret(); got != "" {
+		t.Errorf("GetSecret() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetSecret(); got != "" {
+		t.Errorf("GetSecret() was %v when receiver was nil", got)
+	}
+}
+
+func TestHookConfig_GetURL(t *testing.T) {
+	v := "x"
+	s := &HookConfig{URL: &v}
+	if got := s.GetURL(); got != v {
+		t.Errorf("GetURL() was %v (should have been %v)", got, v)
+	}
+	s = &HookConfig{}
+	if got := s.GetURL(); got != "" {
+		t.Errorf("GetURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetURL(); got != "" {
+		t.Errorf("GetURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestHookResponse_GetCode(t *testing.T) {
+	v := 1
+	s := &HookResponse{Code: &v}
+	if got := s.GetCode(); got != v {
+		t.Errorf("GetCode() was %v (should have been %v)", got, v)
+	}
+	s = &HookResponse{}
+	if got := s.GetCode(); got != 0 {
+		t.Errorf("GetCode() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetCode(); got != 0 {
+		t.Errorf("GetCode() was %v when receiver was nil", got)
+	}
+}
+
+func TestHookResponse_GetMessage(t *testing.T) {
+	v := "x"
+	s := &HookResponse{Message: &v}
+	if got := s.GetMessage(); got != v {
+		t.Errorf("GetMessage() was %v (should have been %v)", got, v)
+	}
+	s = &HookResponse{}
+	if got := s.GetMessage(); got != "" {
+		t.Errorf("GetMessage() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetMessage(); got != "" {
+		t.Errorf("GetMessage() was %v when receiver was nil", got)
+	}
+}
+
+func TestHookResponse_GetStatus(t *testing.T) {
+	v := "x"
+	s := &HookResponse{Status: &v}
+	if got := s.GetStatus(); got != v {
+		t.Errorf("GetStatus() was %v (should have been %v)", got, v)
+	}
+	s = &HookResponse{}
+	if got := s.GetStatus(); got != "" {
+		t.Errorf("GetStatus() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetStatus(); got != "" {
+		t.Errorf("GetStatus() was %v when receiver was nil", got)
+	}
+}
+
+func TestInstallation_GetAccessTokensURL(t *testing.T) {
+	v := "x"
+	s := &Installation{AccessTokensURL: &v}
+	if got := s.GetAccessTokensURL(); got != v {
+		t.Errorf("GetAccessTokensURL() was %v (should have been %v)", got, v)
+	}
+	s = &Installation{}
+	if got := s.GetAccessTokensURL(); got != "" {
+		t.Errorf("GetAccessTokensURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetAccessTokensURL(); got != "" {
+		t.Errorf("GetAccessTokensURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestInstallation_GetAccount(t *testing.T) {
+	v := &Account{}
+	s := &Installation{Account: v}
+	if got := s.GetAccount(); got != v {
+		t.Errorf("GetAccount() didn't return the field")
+	}
+	s = nil
+	if got := s.GetAccount(); got != nil {
+		t.Errorf("GetAccount() was %v when receiver was nil", got)
+	}
+}
+
+func TestInstallation_GetAppID(t *testing.T) {
+	v := 1
+	s := &Installation{AppID: &v}
+	if got := s.GetAppID(); got != v {
+		t.Errorf("GetAppID() was %v (should have been %v)", got, v)
+	}
+	s = &Installation{}
+	if got := s.GetAppID(); got != 0 {
+		t.Errorf("GetAppID() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetAppID(); got != 0 {
+		t.Errorf("GetAppID() was %v when receiver was nil", got)
+	}
+}
+
+func TestInstallation_GetAppSlug(t *testing.T) {
+	v := "x"
+	s := &Installation{AppSlug: &v}
+	if got := s.GetAppSlug(); got != v {
+		t.Errorf("GetAppSlug() was %v (should have been %v)", got, v)
+	}
+	s = &Installation{}
+	if got := s.GetAppSlug(); got != "" {
+		t.Errorf("GetAppSlug() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetAppSlug(); got != "" {
+		t.Errorf("GetAppSlug() was %v when receiver was nil", got)
+	}
+}
+
+func TestInstallation_GetCreatedAt(t *testing.T) {
+	v := time.Unix(1557933565, 0)
+	s := &Installation{CreatedAt: &TimeWrapper{t: v}}
+	if got := s.GetCreatedAt(); !got.Equal(v) {
+		t.Errorf("GetCreatedAt() was %v (should have been %v)", got, v)
+	}
+	s = &Installation{}
+	if got := s.GetCreatedAt(); !got.IsZero() {
+		t.Errorf("GetCreatedAt() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetCreatedAt(); !got.IsZero() {
+		t.Errorf("GetCreatedAt() was %v when receiver was nil", got)
+	}
+}
+
+func TestInstallation_GetEvents(t *testing.T) {
+	v := []string{}
+	s := &Installation{Events: v}
+	if got := s.GetEvents(); got == nil {
+		t.Errorf("GetEvents() was nil when field was non-nil")
+	}
+	s = nil
+	if got := s.GetEvents(); got != nil {
+		t.Errorf("GetEvents() was %v when receiver was nil", got)
+	}
+}
+
+func TestInstallation_GetHTMLURL(t *testing.T) {
+	v := "x"
+	s := &Installation{HTMLURL: &v}
+	if got := s.GetHTMLURL(); got != v {
+		t.Errorf("GetHTMLURL() was %v (should have been %v)", got, v)
+	}
+	s = &Installation{}
+	if got := s.GetHTMLURL(); got != "" {
+		t.Errorf("GetHTMLURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetHTMLURL(); got != "" {
+		t.Errorf("GetHTMLURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestInstallation_GetHasMultipleSingleFiles(t *testing.T) {
+	v := true
+	s := &Installation{HasMultipleSingleFiles: &v}
+	if got := s.GetHasMultipleSingleFiles(); got != v {
+		t.Errorf("GetHasMultipleSingleFiles() was %v (should have been %v)", got, v)
+	}
+	s = &Installation{}
+	if got := s.GetHasMultipleSingleFiles(); got != false {
+		t.Errorf("GetHasMultipleSingleFiles() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetHasMultipleSingleFiles(); got != false {
+		t.Errorf("GetHasMultipleSingleFiles() was %v when receiver was nil", got)
+	}
+}
+
+func TestInstallation_GetID(t *testing.T) {
+	v := 1
+	s := &Installation{ID: &v}
+	if got := s.GetID(); got != v {
+		t.Errorf("GetID() was %v (should have been %v)", got, v)
+	}
+	s = &Installation{}
+	if got := s.GetID(); got != 0 {
+		t.Errorf("GetID() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetID(); got != 0 {
+		t.Errorf("GetID() was %v when receiver was nil", got)
+	}
+}
+
+func TestInstallation_GetNodeID(t *testing.T) {
+	v := "x"
+	s := &Installation{NodeID: &v}
+	if got := s.GetNodeID(); got != v {
+		t.Errorf("GetNodeID() was %v (should have been %v)", got, v)
+	}
+	s = &Installation{}
+	if got := s.GetNodeID(); got != "" {
+		t.Errorf("GetNodeID() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetNodeID(); got != "" {
+		t.Errorf("GetNodeID() was %v when receiver was nil", got)
+	}
+}
+
+func TestInstallation_GetPermissions(t *testing.T) {
+	v := map[string]string{}
+	s := &Installation{Permissions: v}
+	if got := s.GetPermissions(); got == nil {
+		t.Errorf("GetPermissions() was nil when field was non-nil")
+	}
+	s = nil
+	if got := s.GetPermissions(); got != nil {
+		t.Errorf("GetPermissions() was %v when receiver was nil", got)
+	}
+}
+
+func TestInstallation_GetRepositoriesURL(t *testing.T) {
+	v := "x"
+	s := &Installation{RepositoriesURL: &v}
+	if got := s.GetRepositoriesURL(); got != v {
+		t.Errorf("GetRepositoriesURL() was %v (should have been %v)", got, v)
+	}
+	s = &Installation{}
+	if got := s.GetRepositoriesURL(); got != "" {
+		t.Errorf("GetRepositoriesURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetRepositoriesURL(); got != "" {
+		t.Errorf("GetRepositoriesURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestInstallation_GetRepositorySelection(t *testing.T) {
+	v := "x"
+	s := &Installation{RepositorySelection: &v}
+	if got := s.GetRepositorySelection(); got != v {
+		t.Errorf("GetRepositorySelection() was %v (should have been %v)", got, v)
+	}
+	s = &Installation{}
+	if got := s.GetRepositorySelection(); got != "" {
+		t.Errorf("GetRepositorySelection() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetRepositorySelection(); got != "" {
+		t.Errorf("GetRepositorySelection() was %v when receiver was nil", got)
+	}
+}
+
+func TestInstallation_GetSingleFileName(t *testing.T) {
+	v := "x"
+	s := &Installation{SingleFileName: &v}
+	if got := s.GetSingleFileName(); got != v {
+		t.Errorf("GetSingleFileName() was %v (should have been %v)", got, v)
+	}
+	s = &Installation{}
+	if got := s.GetSingleFileName(); got != "" {
+		t.Errorf("GetSingleFileName() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetSingleFileName(); got != "" {
+		t.Errorf("GetSingleFileName() was %v when receiver was nil", got)
+	}
+}
+
+func TestInstallation_GetSingleFilePaths(t *testing.T) {
+	v := []string{}
+	s := &Installation{SingleFilePaths: v}
+	if got := s.GetSingleFilePaths(); got == nil {
+		t.Errorf("GetSingleFilePaths() was nil when field was non-nil")
+	}
+	s = nil
+	if got := s.GetSingleFilePaths(); got != nil {
+		t.Errorf("GetSingleFilePaths() was %v when receiver was nil", got)
+	}
+}
+
+func TestInstallation_GetSuspendedAt(t *testing.T) {
+	v := time.Unix(1557933565, 0)
+	s := &Installation{SuspendedAt: &TimeWrapper{t: v}}
+	if got := s.GetSuspendedAt(); !got.Equal(v) {
+		t.Errorf("GetSuspendedAt() was %v (should have been %v)", got, v)
+	}
+	s = &Installation{}
+	if got := s.GetSuspendedAt(); !got.IsZero() {
+		t.Errorf("GetSuspendedAt() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetSuspendedAt(); !got.IsZero() {
+		t.Errorf("GetSuspendedAt() was %v when receiver was nil", got)
+	}
+}
+
+func TestInstallation_GetSuspendedBy(t *testing.T) {
+	v := "x"
+	s := &Installation{SuspendedBy: &v}
+	if got := s.GetSuspendedBy(); got != v {
+		t.Errorf("GetSuspendedBy() was %v (should have been %v)", got, v)
+	}
+	s = &Installation{}
+	if got := s.GetSuspendedBy(); got != "" {
+		t.Errorf("GetSuspendedBy() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetSuspendedBy(); got != "" {
+		t.Errorf("GetSuspendedBy() was %v when receiver was nil", got)
+	}
+}
+
+func TestInstallation_GetTargetID(t *testing.T) {
+	v := 1
+	s := &Installation{TargetID: &v}
+	if got := s.GetTargetID(); got != v {
+		t.Errorf("GetTargetID() was %v (should have been %v)", got, v)
+	}
+	s = &Installation{}
+	if got := s.GetTargetID(); got != 0 {
+		t.Errorf("GetTargetID() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetTargetID(); got != 0 {
+		t.Errorf("GetTargetID() was %v when receiver was nil", got)
+	}
+}
+
+func TestInstallation_GetTargetType(t *testing.T) {
+	v := "x"
+	s := &Installation{TargetType: &v}
+	if got := s.GetTargetType(); got != v {
+		t.Errorf("GetTargetType() was %v (should have been %v)", got, v)
+	}
+	s = &Installation{}
+	if got := s.GetTargetType(); got != "" {
+		t.Errorf("GetTargetType() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetTargetType(); got != "" {
+		t.Errorf("GetTargetType() was %v when receiver was nil", got)
+	}
+}
+
+func TestInstallation_GetUpdatedAt(t *testing.T) {
+	v := time.Unix(1557933565, 0)
+	s := &Installation{UpdatedAt: &TimeWrapper{t: v}}
+	if got := s.GetUpdatedAt(); !got.Equal(v) {
+		t.Errorf("GetUpdatedAt() was %v (should have been %v)", got, v)
+	}
+	s = &Installation{}
+	if got := s.GetUpdatedAt(); !got.IsZero() {
+		t.Errorf("GetUpdatedAt() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetUpdatedAt(); !got.IsZero() {
+		t.Errorf("GetUpdatedAt() was %v when receiver was nil", got)
+	}
+}
+
+func TestInstallationEvent_GetAction(t *testing.T) {
+	v := "x"
+	s := &InstallationEvent{Action: &v}
+	if got := s.GetAction(); got != v {
+		t.Errorf("GetAction() was %v (should have been %v)", got, v)
+	}
+	s = &InstallationEvent{}
+	if got := s.GetAction(); got != "" {
+		t.Errorf("GetAction() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetAction(); got != "" {
+		t.Errorf("GetAction() was %v when receiver was nil", got)
+	}
+}
+
+func TestInstallationEvent_GetInstallation(t *testing.T) {
+	v := &Installation{}
+	s := &InstallationEvent{Installation: v}
+	if got := s.GetInstallation(); got != v {
+		t.Errorf("GetInstallation() didn't return the field")
+	}
+	s = nil
+	if got := s.GetInstallation(); got != nil {
+		t.Errorf("GetInstallation() was %v when receiver was nil", got)
+	}
+}
+
+func TestInstallationEvent_GetRepositories(t *testing.T) {
+	v := []Repository{}
+	s := &InstallationEvent{Repositories: v}
+	if got := s.GetRepositories(); got == nil {
+		t.Errorf("GetRepositories() was nil when field was non-nil")
+	}
+	s = nil
+	if got := s.GetRepositories(); got != nil {
+		t.Errorf("GetRepositories() was %v when receiver was nil", got)
+	}
+}
+
+func TestInstallationEvent_GetRequester(t *testing.T) {
+	v := &Account{}
+	s := &InstallationEvent{Requester: v}
+	if got := s.GetRequester(); got != v {
+		t.Errorf("GetRequester() didn't return the field")
+	}
+	s = nil
+	if got := s.GetRequester(); got != nil {
+		t.Errorf("GetRequester() was %v when receiver was nil", got)
+	}
+}
+
+func TestInstallationEvent_GetSender(t *testing.T) {
+	v := &Account{}
+	s := &InstallationEvent{Sender: v}
+	if got := s.GetSender(); got != v {
+		t.Errorf("GetSender() didn't return the field")
+	}
+	s = nil
+	if got := s.GetSender(); got != nil {
+		t.Errorf("GetSender() was %v when receiver was nil", got)
+	}
+}
+
+func TestInstallationRepositoriesEvent_GetAction(t *testing.T) {
+	v := "x"
+	s := &InstallationRepositoriesEvent{Action: &v}
+	if got := s.GetAction(); got != v {
+		t.Errorf("GetAction() was %v (should have been %v)", got, v)
+	}
+	s = &InstallationRepositoriesEvent{}
+	if got := s.GetAction(); got != "" {
+		t.Errorf("GetAction() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetAction(); got != "" {
+		t.Errorf("GetAction() was %v when receiver was nil", got)
+	}
+}
+
+func TestInstallationRepositoriesEvent_GetInstallation(t *testing.T) {
+	v := &Installation{}
+	s := &InstallationRepositoriesEvent{Installation: v}
+	if got := s.GetInstallation(); got != v {
+		t.Errorf("GetInstallation() didn't return the field")
+	}
+	s = nil
+	if got := s.GetInstallation(); got != nil {
+		t.Errorf("GetInstallation() was %v when receiver was nil", got)
+	}
+}
+
+func TestInstallationRepositoriesEvent_GetRepositoriesAdded(t *testing.T) {
+	v := []Repository{}
+	s := &InstallationRepositoriesEvent{RepositoriesAdded: v}
+	if got := s.GetRepositoriesAdded(); got == nil {
+		t.Errorf("GetRepositoriesAdded() was nil when field was non-nil")
+	}
+	s = nil
+	if got := s.GetRepositoriesAdded(); got != nil {
+		t.Errorf("GetRepositoriesAdded() was %v when receiver was nil", got)
+	}
+}
+
+func TestInstallationRepositoriesEvent_GetRepositoriesRemoved(t *testing.T) {
+	v := []Repository{}
+	s := &InstallationRepositoriesEvent{RepositoriesRemoved: v}
+	if got := s.GetRepositoriesRemoved(); got == nil {
+		t.Errorf("GetRepositoriesRemoved() was nil when field was non-nil")
+	}
+	s = nil
+	if got := s.GetRepositoriesRemoved(); got != nil {
+		t.Errorf("GetRepositoriesRemoved() was %v when receiver was nil", got)
+	}
+}
+
+func TestInstallationRepositoriesEvent_GetRepositorySelection(t *testing.T) {
+	v := "x"
+	s := &InstallationRepositoriesEvent{RepositorySelection: &v}
+	if got := s.GetRepositorySelection(); got != v {
+		t.Errorf("GetRepositorySelection() was %v (should have been %v)", got, v)
+	}
+	s = &InstallationRepositoriesEvent{}
+	if got := s.GetRepositorySelection(); got != "" {
+		t.Errorf("GetRepositorySelection() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetRepositorySelection(); got != "" {
+		t.Errorf("GetRepositorySelection() was %v when receiver was nil", got)
+	}
+}
+
+func TestInstallationRepositoriesEvent_GetRequester(t *testing.T) {
+	v := &Account{}
+	s := &InstallationRepositoriesEvent{Requester: v}
+	if got := s.GetRequester(); got != v {
+		t.Errorf("GetRequester() didn't return the field")
+	}
+	s = nil
+	if got := s.GetRequester(); got != nil {
+		t.Errorf("GetRequester() was %v when receiver was nil", got)
+	}
+}
+
+func TestInstallationRepositoriesEvent_GetSender(t *testing.T) {
+	v := &Account{}
+	s := &InstallationRepositoriesEvent{Sender: v}
+	if got := s.GetSender(); got != v {
+		t.Errorf("GetSender() didn't return the field")
+	}
+	s = nil
+	if got := s.GetSender(); got != nil {
+		t.Errorf("GetSender() was %v when receiver was nil", got)
+	}
+}
+
+func TestInstallationRepositoriesResponse_GetRepositories(t *testing.T) {
+	v := []Repository{}
+	s := &InstallationRepositoriesResponse{Repositories: v}
+	if got := s.GetRepositories(); got == nil {
+		t.Errorf("GetRepositories() was nil when field was non-nil")
+	}
+	s = nil
+	if got := s.GetRepositories(); got != nil {
+		t.Errorf("GetRepositories() was %v when receiver was nil", got)
+	}
+}
+
+func TestInstallationRepositoriesResponse_GetRepositorySelection(t *testing.T) {
+	v := "x"
+	s := &InstallationRepositoriesResponse{RepositorySelection: &v}
+	if got := s.GetRepositorySelection(); got != v {
+		t.Errorf("GetRepositorySelection() was %v (should have been %v)", got, v)
+	}
+	s = &InstallationRepositoriesResponse{}
+	if got := s.GetRepositorySelection(); got != "" {
+		t.Errorf("GetRepositorySelection() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetRepositorySelection(); got != "" {
+		t.Errorf("GetRepositorySelection() was %v when receiver was nil", got)
+	}
+}
+
+func TestInstallationRepositoriesResponse_GetTotalCount(t *testing.T) {
+	s := &InstallationRepositoriesResponse{TotalCount: 1}
+	if got := s.GetTotalCount(); got != 1 {
+		t.Errorf("GetTotalCount() was %v (should have been 1)", got)
+	}
+	s = nil
+	if got := s.GetTotalCount(); got != 0 {
+		t.Errorf("GetTotalCount() was %v when receiver was nil", got)
+	}
+}
+
+func TestInstallationToken_GetExpiresAt(t *testing.T) {
+	v := time.Unix(1557933565, 0)
+	s := &InstallationToken{ExpiresAt: &TimeWrapper{t: v}}
+	if got := s.GetExpiresAt(); !got.Equal(v) {
+		t.Errorf("GetExpiresAt() was %v (should have been %v)", got, v)
+	}
+	s = &InstallationToken{}
+	if got := s.GetExpiresAt(); !got.IsZero() {
+		t.Errorf("GetExpiresAt() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetExpiresAt(); !got.IsZero() {
+		t.Errorf("GetExpiresAt() was %v when receiver was nil", got)
+	}
+}
+
+func TestInstallationToken_GetPermissions(t *testing.T) {
+	v := map[string]string{}
+	s := &InstallationToken{Permissions: v}
+	if got := s.GetPermissions(); got == nil {
+		t.Errorf("GetPermissions() was nil when field was non-nil")
+	}
+	s = nil
+	if got := s.GetPermissions(); got != nil {
+		t.Errorf("GetPermissions() was %v when receiver was nil", got)
+	}
+}
+
+func TestInstallationToken_GetRepositories(t *testing.T) {
+	v := []Repository{}
+	s := &InstallationToken{Repositories: v}
+	if got := s.GetRepositories(); got == nil {
+		t.Errorf("GetRepositories() was nil when field was non-nil")
+	}
+	s = nil
+	if got := s.GetRepositories(); got != nil {
+		t.Errorf("GetRepositories() was %v when receiver was nil", got)
+	}
+}
+
+func TestInstallationToken_GetRepositorySelection(t *testing.T) {
+	v := "x"
+	s := &InstallationToken{RepositorySelection: &v}
+	if got := s.GetRepositorySelection(); got != v {
+		t.Errorf("GetRepositorySelection() was %v (should have been %v)", got, v)
+	}
+	s = &InstallationToken{}
+	if got := s.GetRepositorySelection(); got != "" {
+		t.Errorf("GetRepositorySelection() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetRepositorySelection(); got != "" {
+		t.Errorf("GetRepositorySelection() was %v when receiver was nil", got)
+	}
+}
+
+func TestInstallationToken_GetToken(t *testing.T) {
+	v := "x"
+	s := &InstallationToken{Token: &v}
+	if got := s.GetToken(); got != v {
+		t.Errorf("GetToken() was %v (should have been %v)", got, v)
+	}
+	s = &InstallationToken{}
+	if got := s.GetToken(); got != "" {
+		t.Errorf("GetToken() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetToken(); got != "" {
+		t.Errorf("GetToken() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssue_GetActiveLockReason(t *testing.T) {
+	v := "x"
+	s := &Issue{ActiveLockReason: &v}
+	if got := s.GetActiveLockReason(); got != v {
+		t.Errorf("GetActiveLockReason() was %v (should have been %v)", got, v)
+	}
+	s = &Issue{}
+	if got := s.GetActiveLockReason(); got != "" {
+		t.Errorf("GetActiveLockReason() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetActiveLockReason(); got != "" {
+		t.Errorf("GetActiveLockReason() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssue_GetAssignee(t *testing.T) {
+	v := &Account{}
+	s := &Issue{Assignee: v}
+	if got := s.GetAssignee(); got != v {
+		t.Errorf("GetAssignee() didn't return the field")
+	}
+	s = nil
+	if got := s.GetAssignee(); got != nil {
+		t.Errorf("GetAssignee() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssue_GetAssignees(t *testing.T) {
+	v := []Account{}
+	s := &Issue{Assignees: v}
+	if got := s.GetAssignees(); got == nil {
+		t.Errorf("GetAssignees() was nil when field was non-nil")
+	}
+	s = nil
+	if got := s.GetAssignees(); got != nil {
+		t.Errorf("GetAssignees() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssue_GetAuthorAssociation(t *testing.T) {
+	v := "x"
+	s := &Issue{AuthorAssociation: &v}
+	if got := s.GetAuthorAssociation(); got != v {
+		t.Errorf("GetAuthorAssociation() was %v (should have been %v)", got, v)
+	}
+	s = &Issue{}
+	if got := s.GetAuthorAssociation(); got != "" {
+		t.Errorf("GetAuthorAssociation() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetAuthorAssociation(); got != "" {
+		t.Errorf("GetAuthorAssociation() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssue_GetBody(t *testing.T) {
+	v := "x"
+	s := &Issue{Body: &v}
+	if got := s.GetBody(); got != v {
+		t.Errorf("GetBody() was %v (should have been %v)", got, v)
+	}
+	s = &Issue{}
+	if got := s.GetBody(); got != "" {
+		t.Errorf("GetBody() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetBody(); got != "" {
+		t.Errorf("GetBody() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssue_GetClosedAt(t *testing.T) {
+	v := time.Unix(1557933565, 0)
+	s := &Issue{ClosedAt: &TimeWrapper{t: v}}
+	if got := s.GetClosedAt(); !got.Equal(v) {
+		t.Errorf("GetClosedAt() was %v (should have been %v)", got, v)
+	}
+	s = &Issue{}
+	if got := s.GetClosedAt(); !got.IsZero() {
+		t.Errorf("GetClosedAt() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetClosedAt(); !got.IsZero() {
+		t.Errorf("GetClosedAt() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssue_GetClosedBy(t *testing.T) {
+	v := &Account{}
+	s := &Issue{ClosedBy: v}
+	if got := s.GetClosedBy(); got != v {
+		t.Errorf("GetClosedBy() didn't return the field")
+	}
+	s = nil
+	if got := s.GetClosedBy(); got != nil {
+		t.Errorf("GetClosedBy() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssue_GetComments(t *testing.T) {
+	v := 1
+	s := &Issue{Comments: &v}
+	if got := s.GetComments(); got != v {
+		t.Errorf("GetComments() was %v (should have been %v)", got, v)
+	}
+	s = &Issue{}
+	if got := s.GetComments(); got != 0 {
+		t.Errorf("GetComments() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetComments(); got != 0 {
+		t.Errorf("GetComments() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssue_GetCommentsURL(t *testing.T) {
+	v := "x"
+	s := &Issue{CommentsURL: &v}
+	if got := s.GetCommentsURL(); got != v {
+		t.Errorf("GetCommentsURL() was %v (should have been %v)", got, v)
+	}
+	s = &Issue{}
+	if got := s.GetCommentsURL(); got != "" {
+		t.Errorf("GetCommentsURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetCommentsURL(); got != "" {
+		t.Errorf("GetCommentsURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssue_GetCreatedAt(t *testing.T) {
+	v := time.Unix(1557933565, 0)
+	s := &Issue{CreatedAt: &TimeWrapper{t: v}}
+	if got := s.GetCreatedAt(); !got.Equal(v) {
+		t.Errorf("GetCreatedAt() was %v (should have been %v)", got, v)
+	}
+	s = &Issue{}
+	if got := s.GetCreatedAt(); !got.IsZero() {
+		t.Errorf("GetCreatedAt() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetCreatedAt(); !got.IsZero() {
+		t.Errorf("GetCreatedAt() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssue_GetEventsURL(t *testing.T) {
+	v := "x"
+	s := &Issue{EventsURL: &v}
+	if got := s.GetEventsURL(); got != v {
+		t.Errorf("GetEventsURL() was %v (should have been %v)", got, v)
+	}
+	s = &Issue{}
+	if got := s.GetEventsURL(); got != "" {
+		t.Errorf("GetEventsURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetEventsURL(); got != "" {
+		t.Errorf("GetEventsURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssue_GetHTMLURL(t *testing.T) {
+	v := "x"
+	s := &Issue{HTMLURL: &v}
+	if got := s.GetHTMLURL(); got != v {
+		t.Errorf("GetHTMLURL() was %v (should have been %v)", got, v)
+	}
+	s = &Issue{}
+	if got := s.GetHTMLURL(); got != "" {
+		t.Errorf("GetHTMLURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetHTMLURL(); got != "" {
+		t.Errorf("GetHTMLURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssue_GetID(t *testing.T) {
+	v := 1
+	s := &Issue{ID: &v}
+	if got := s.GetID(); got != v {
+		t.Errorf("GetID() was %v (should have been %v)", got, v)
+	}
+	s = &Issue{}
+	if got := s.GetID(); got != 0 {
+		t.Errorf("GetID() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetID(); got != 0 {
+		t.Errorf("GetID() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssue_GetLabels(t *testing.T) {
+	v := []Label{}
+	s := &Issue{Labels: v}
+	if got := s.GetLabels(); got == nil {
+		t.Errorf("GetLabels() was nil when field was non-nil")
+	}
+	s = nil
+	if got := s.GetLabels(); got != nil {
+		t.Errorf("GetLabels() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssue_GetLabelsURL(t *testing.T) {
+	v := "x"
+	s := &Issue{LabelsURL: &v}
+	if got := s.GetLabelsURL(); got != v {
+		t.Errorf("GetLabelsURL() was %v (should have been %v)", got, v)
+	}
+	s = &Issue{}
+	if got := s.GetLabelsURL(); got != "" {
+		t.Errorf("GetLabelsURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetLabelsURL(); got != "" {
+		t.Errorf("GetLabelsURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssue_GetLocked(t *testing.T) {
+	v := true
+	s := &Issue{Locked: &v}
+	if got := s.GetLocked(); got != v {
+		t.Errorf("GetLocked() was %v (should have been %v)", got, v)
+	}
+	s = &Issue{}
+	if got := s.GetLocked(); got != false {
+		t.Errorf("GetLocked() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetLocked(); got != false {
+		t.Errorf("GetLocked() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssue_GetMilestone(t *testing.T) {
+	v := &Milestone{}
+	s := &Issue{Milestone: v}
+	if got := s.GetMilestone(); got != v {
+		t.Errorf("GetMilestone() didn't return the field")
+	}
+	s = nil
+	if got := s.GetMilestone(); got != nil {
+		t.Errorf("GetMilestone() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssue_GetNodeID(t *testing.T) {
+	v := "x"
+	s := &Issue{NodeID: &v}
+	if got := s.GetNodeID(); got != v {
+		t.Errorf("GetNodeID() was %v (should have been %v)", got, v)
+	}
+	s = &Issue{}
+	if got := s.GetNodeID(); got != "" {
+		t.Errorf("GetNodeID() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetNodeID(); got != "" {
+		t.Errorf("GetNodeID() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssue_GetNumber(t *testing.T) {
+	v := 1
+	s := &Issue{Number: &v}
+	if got := s.GetNumber(); got != v {
+		t.Errorf("GetNumber() was %v (should have been %v)", got, v)
+	}
+	s = &Issue{}
+	if got := s.GetNumber(); got != 0 {
+		t.Errorf("GetNumber() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetNumber(); got != 0 {
+		t.Errorf("GetNumber() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssue_GetPerformedViaGithubApp(t *testing.T) {
+	v := &App{}
+	s := &Issue{PerformedViaGithubApp: v}
+	if got := s.GetPerformedViaGithubApp(); got != v {
+		t.Errorf("GetPerformedViaGithubApp() didn't return the field")
+	}
+	s = nil
+	if got := s.GetPerformedViaGithubApp(); got != nil {
+		t.Errorf("GetPerformedViaGithubApp() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssue_GetPullRequest(t *testing.T) {
+	v := &IssuePullRequest{}
+	s := &Issue{PullRequest: v}
+	if got := s.GetPullRequest(); got != v {
+		t.Errorf("GetPullRequest() didn't return the field")
+	}
+	s = nil
+	if got := s.GetPullRequest(); got != nil {
+		t.Errorf("GetPullRequest() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssue_GetRepositoryURL(t *testing.T) {
+	v := "x"
+	s := &Issue{RepositoryURL: &v}
+	if got := s.GetRepositoryURL(); got != v {
+		t.Errorf("GetRepositoryURL() was %v (should have been %v)", got, v)
+	}
+	s = &Issue{}
+	if got := s.GetRepositoryURL(); got != "" {
+		t.Errorf("GetRepositoryURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetRepositoryURL(); got != "" {
+		t.Errorf("GetRepositoryURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssue_GetState(t *testing.T) {
+	v := "x"
+	s := &Issue{State: &v}
+	if got := s.GetState(); got != v {
+		t.Errorf("GetState() was %v (should have been %v)", got, v)
+	}
+	s = &Issue{}
+	if got := s.GetState(); got != "" {
+		t.Errorf("GetState() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetState(); got != "" {
+		t.Errorf("GetState() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssue_GetTitle(t *testing.T) {
+	v := "x"
+	s := &Issue{Title: &v}
+	if got := s.GetTitle(); got != v {
+		t.Errorf("GetTitle() was %v (should have been %v)", got, v)
+	}
+	s = &Issue{}
+	if got := s.GetTitle(); got != "" {
+		t.Errorf("GetTitle() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetTitle(); got != "" {
+		t.Errorf("GetTitle() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssue_GetURL(t *testing.T) {
+	v := "x"
+	s := &Issue{URL: &v}
+	if got := s.GetURL(); got != v {
+		t.Errorf("GetURL() was %v (should have been %v)", got, v)
+	}
+	s = &Issue{}
+	if got := s.GetURL(); got != "" {
+		t.Errorf("GetURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetURL(); got != "" {
+		t.Errorf("GetURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssue_GetUpdatedAt(t *testing.T) {
+	v := time.Unix(1557933565, 0)
+	s := &Issue{UpdatedAt: &TimeWrapper{t: v}}
+	if got := s.GetUpdatedAt(); !got.Equal(v) {
+		t.Errorf("GetUpdatedAt() was %v (should have been %v)", got, v)
+	}
+	s = &Issue{}
+	if got := s.GetUpdatedAt(); !got.IsZero() {
+		t.Errorf("GetUpdatedAt() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetUpdatedAt(); !got.IsZero() {
+		t.Errorf("GetUpdatedAt() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssue_GetUser(t *testing.T) {
+	v := &Account{}
+	s := &Issue{User: v}
+	if got := s.GetUser(); got != v {
+		t.Errorf("GetUser() didn't return the field")
+	}
+	s = nil
+	if got := s.GetUser(); got != nil {
+		t.Errorf("GetUser() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssueComment_GetAuthorAssociation(t *testing.T) {
+	v := "x"
+	s := &IssueComment{AuthorAssociation: &v}
+	if got := s.GetAuthorAssociation(); got != v {
+		t.Errorf("GetAuthorAssociation() was %v (should have been %v)", got, v)
+	}
+	s = &IssueComment{}
+	if got := s.GetAuthorAssociation(); got != "" {
+		t.Errorf("GetAuthorAssociation() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetAuthorAssociation(); got != "" {
+		t.Errorf("GetAuthorAssociation() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssueComment_GetBody(t *testing.T) {
+	v := "x"
+	s := &IssueComment{Body: &v}
+	if got := s.GetBody(); got != v {
+		t.Errorf("GetBody() was %v (should have been %v)", got, v)
+	}
+	s = &IssueComment{}
+	if got := s.GetBody(); got != "" {
+		t.Errorf("GetBody() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetBody(); got != "" {
+		t.Errorf("GetBody() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssueComment_GetCreatedAt(t *testing.T) {
+	v := time.Unix(1557933565, 0)
+	s := &IssueComment{CreatedAt: &TimeWrapper{t: v}}
+	if got := s.GetCreatedAt(); !got.Equal(v) {
+		t.Errorf("GetCreatedAt() was %v (should have been %v)", got, v)
+	}
+	s = &IssueComment{}
+	if got := s.GetCreatedAt(); !got.IsZero() {
+		t.Errorf("GetCreatedAt() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetCreatedAt(); !got.IsZero() {
+		t.Errorf("GetCreatedAt() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssueComment_GetHTMLURL(t *testing.T) {
+	v := "x"
+	s := &IssueComment{HTMLURL: &v}
+	if got := s.GetHTMLURL(); got != v {
+		t.Errorf("GetHTMLURL() was %v (should have been %v)", got, v)
+	}
+	s = &IssueComment{}
+	if got := s.GetHTMLURL(); got != "" {
+		t.Errorf("GetHTMLURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetHTMLURL(); got != "" {
+		t.Errorf("GetHTMLURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssueComment_GetID(t *testing.T) {
+	v := 1
+	s := &IssueComment{ID: &v}
+	if got := s.GetID(); got != v {
+		t.Errorf("GetID() was %v (should have been %v)", got, v)
+	}
+	s = &IssueComment{}
+	if got := s.GetID(); got != 0 {
+		t.Errorf("GetID() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetID(); got != 0 {
+		t.Errorf("GetID() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssueComment_GetIssueURL(t *testing.T) {
+	v := "x"
+	s := &IssueComment{IssueURL: &v}
+	if got := s.GetIssueURL(); got != v {
+		t.Errorf("GetIssueURL() was %v (should have been %v)", got, v)
+	}
+	s = &IssueComment{}
+	if got := s.GetIssueURL(); got != "" {
+		t.Errorf("GetIssueURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetIssueURL(); got != "" {
+		t.Errorf("GetIssueURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssueComment_GetNodeID(t *testing.T) {
+	v := "x"
+	s := &IssueComment{NodeID: &v}
+	if got := s.GetNodeID(); got != v {
+		t.Errorf("GetNodeID() was %v (should have been %v)", got, v)
+	}
+	s = &IssueComment{}
+	if got := s.GetNodeID(); got != "" {
+		t.Errorf("GetNodeID() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetNodeID(); got != "" {
+		t.Errorf("GetNodeID() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssueComment_GetPerformedViaGithubApp(t *testing.T) {
+	v := &App{}
+	s := &IssueComment{PerformedViaGithubApp: v}
+	if got := s.GetPerformedViaGithubApp(); got != v {
+		t.Errorf("GetPerformedViaGithubApp() didn't return the field")
+	}
+	s = nil
+	if got := s.GetPerformedViaGithubApp(); got != nil {
+		t.Errorf("GetPerformedViaGithubApp() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssueComment_GetURL(t *testing.T) {
+	v := "x"
+	s := &IssueComment{URL: &v}
+	if got := s.GetURL(); got != v {
+		t.Errorf("GetURL() was %v (should have been %v)", got, v)
+	}
+	s = &IssueComment{}
+	if got := s.GetURL(); got != "" {
+		t.Errorf("GetURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetURL(); got != "" {
+		t.Errorf("GetURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssueComment_GetUpdatedAt(t *testing.T) {
+	v := time.Unix(1557933565, 0)
+	s := &IssueComment{UpdatedAt: &TimeWrapper{t: v}}
+	if got := s.GetUpdatedAt(); !got.Equal(v) {
+		t.Errorf("GetUpdatedAt() was %v (should have been %v)", got, v)
+	}
+	s = &IssueComment{}
+	if got := s.GetUpdatedAt(); !got.IsZero() {
+		t.Errorf("GetUpdatedAt() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetUpdatedAt(); !got.IsZero() {
+		t.Errorf("GetUpdatedAt() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssueComment_GetUser(t *testing.T) {
+	v := &Account{}
+	s := &IssueComment{User: v}
+	if got := s.GetUser(); got != v {
+		t.Errorf("GetUser() didn't return the field")
+	}
+	s = nil
+	if got := s.GetUser(); got != nil {
+		t.Errorf("GetUser() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssueCommentEvent_GetAction(t *testing.T) {
+	v := "x"
+	s := &IssueCommentEvent{Action: &v}
+	if got := s.GetAction(); got != v {
+		t.Errorf("GetAction() was %v (should have been %v)", got, v)
+	}
+	s = &IssueCommentEvent{}
+	if got := s.GetAction(); got != "" {
+		t.Errorf("GetAction() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetAction(); got != "" {
+		t.Errorf("GetAction() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssueCommentEvent_GetChanges(t *testing.T) {
+	v := &Changes{}
+	s := &IssueCommentEvent{Changes: v}
+	if got := s.GetChanges(); got != v {
+		t.Errorf("GetChanges() didn't return the field")
+	}
+	s = nil
+	if got := s.GetChanges(); got != nil {
+		t.Errorf("GetChanges() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssueCommentEvent_GetComment(t *testing.T) {
+	v := &IssueComment{}
+	s := &IssueCommentEvent{Comment: v}
+	if got := s.GetComment(); got != v {
+		t.Errorf("GetComment() didn't return the field")
+	}
+	s = nil
+	if got := s.GetComment(); got != nil {
+		t.Errorf("GetComment() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssueCommentEvent_GetInstallation(t *testing.T) {
+	v := &Installation{}
+	s := &IssueCommentEvent{Installation: v}
+	if got := s.GetInstallation(); got != v {
+		t.Errorf("GetInstallation() didn't return the field")
+	}
+	s = nil
+	if got := s.GetInstallation(); got != nil {
+		t.Errorf("GetInstallation() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssueCommentEvent_GetIssue(t *testing.T) {
+	v := &Issue{}
+	s := &IssueCommentEvent{Issue: v}
+	if got := s.GetIssue(); got != v {
+		t.Errorf("GetIssue() didn't return the field")
+	}
+	s = nil
+	if got := s.GetIssue(); got != nil {
+		t.Errorf("GetIssue() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssueCommentEvent_GetOrganization(t *testing.T) {
+	v := &Account{}
+	s := &IssueCommentEvent{Organization: v}
+	if got := s.GetOrganization(); got != v {
+		t.Errorf("GetOrganization() didn't return the field")
+	}
+	s = nil
+	if got := s.GetOrganization(); got != nil {
+		t.Errorf("GetOrganization() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssueCommentEvent_GetRepository(t *testing.T) {
+	v := &Repository{}
+	s := &IssueCommentEvent{Repository: v}
+	if got := s.GetRepository(); got != v {
+		t.Errorf("GetRepository() didn't return the field")
+	}
+	s = nil
+	if got := s.GetRepository(); got != nil {
+		t.Errorf("GetRepository() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssueCommentEvent_GetSender(t *testing.T) {
+	v := &Account{}
+	s := &IssueCommentEvent{Sender: v}
+	if got := s.GetSender(); got != v {
+		t.Errorf("GetSender() didn't return the field")
+	}
+	s = nil
+	if got := s.GetSender(); got != nil {
+		t.Errorf("GetSender() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssuePullRequest_GetDiffURL(t *testing.T) {
+	v := "x"
+	s := &IssuePullRequest{DiffURL: &v}
+	if got := s.GetDiffURL(); got != v {
+		t.Errorf("GetDiffURL() was %v (should have been %v)", got, v)
+	}
+	s = &IssuePullRequest{}
+	if got := s.GetDiffURL(); got != "" {
+		t.Errorf("GetDiffURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetDiffURL(); got != "" {
+		t.Errorf("GetDiffURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssuePullRequest_GetHTMLURL(t *testing.T) {
+	v := "x"
+	s := &IssuePullRequest{HTMLURL: &v}
+	if got := s.GetHTMLURL(); got != v {
+		t.Errorf("GetHTMLURL() was %v (should have been %v)", got, v)
+	}
+	s = &IssuePullRequest{}
+	if got := s.GetHTMLURL(); got != "" {
+		t.Errorf("GetHTMLURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetHTMLURL(); got != "" {
+		t.Errorf("GetHTMLURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssuePullRequest_GetPatchURL(t *testing.T) {
+	v := "x"
+	s := &IssuePullRequest{PatchURL: &v}
+	if got := s.GetPatchURL(); got != v {
+		t.Errorf("GetPatchURL() was %v (should have been %v)", got, v)
+	}
+	s = &IssuePullRequest{}
+	if got := s.GetPatchURL(); got != "" {
+		t.Errorf("GetPatchURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetPatchURL(); got != "" {
+		t.Errorf("GetPatchURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssuePullRequest_GetURL(t *testing.T) {
+	v := "x"
+	s := &IssuePullRequest{URL: &v}
+	if got := s.GetURL(); got != v {
+		t.Errorf("GetURL() was %v (should have been %v)", got, v)
+	}
+	s = &IssuePullRequest{}
+	if got := s.GetURL(); got != "" {
+		t.Errorf("GetURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetURL(); got != "" {
+		t.Errorf("GetURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssuesEvent_GetAction(t *testing.T) {
+	v := "x"
+	s := &IssuesEvent{Action: &v}
+	if got := s.GetAction(); got != v {
+		t.Errorf("GetAction() was %v (should have been %v)", got, v)
+	}
+	s = &IssuesEvent{}
+	if got := s.GetAction(); got != "" {
+		t.Errorf("GetAction() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetAction(); got != "" {
+		t.Errorf("GetAction() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssuesEvent_GetAssignee(t *testing.T) {
+	v := &Account{}
+	s := &IssuesEvent{Assignee: v}
+	if got := s.GetAssignee(); got != v {
+		t.Errorf("GetAssignee() didn't return the field")
+	}
+	s = nil
+	if got := s.GetAssignee(); got != nil {
+		t.Errorf("GetAssignee() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssuesEvent_GetChanges(t *testing.T) {
+	v := &Changes{}
+	s := &IssuesEvent{Changes: v}
+	if got := s.GetChanges(); got != v {
+		t.Errorf("GetChanges() didn't return the field")
+	}
+	s = nil
+	if got := s.GetChanges(); got != nil {
+		t.Errorf("GetChanges() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssuesEvent_GetInstallation(t *testing.T) {
+	v := &Installation{}
+	s := &IssuesEvent{Installation: v}
+	if got := s.GetInstallation(); got != v {
+		t.Errorf("GetInstallation() didn't return the field")
+	}
+	s = nil
+	if got := s.GetInstallation(); got != nil {
+		t.Errorf("GetInstallation() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssuesEvent_GetIssue(t *testing.T) {
+	v := &Issue{}
+	s := &IssuesEvent{Issue: v}
+	if got := s.GetIssue(); got != v {
+		t.Errorf("GetIssue() didn't return the field")
+	}
+	s = nil
+	if got := s.GetIssue(); got != nil {
+		t.Errorf("GetIssue() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssuesEvent_GetLabel(t *testing.T) {
+	v := &Label{}
+	s := &IssuesEvent{Label: v}
+	if got := s.GetLabel(); got != v {
+		t.Errorf("GetLabel() didn't return the field")
+	}
+	s = nil
+	if got := s.GetLabel(); got != nil {
+		t.Errorf("GetLabel() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssuesEvent_GetMilestone(t *testing.T) {
+	v := &Milestone{}
+	s := &IssuesEvent{Milestone: v}
+	if got := s.GetMilestone(); got != v {
+		t.Errorf("GetMilestone() didn't return the field")
+	}
+	s = nil
+	if got := s.GetMilestone(); got != nil {
+		t.Errorf("GetMilestone() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssuesEvent_GetOrganization(t *testing.T) {
+	v := &Account{}
+	s := &IssuesEvent{Organization: v}
+	if got := s.GetOrganization(); got != v {
+		t.Errorf("GetOrganization() didn't return the field")
+	}
+	s = nil
+	if got := s.GetOrganization(); got != nil {
+		t.Errorf("GetOrganization() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssuesEvent_GetRepository(t *testing.T) {
+	v := &Repository{}
+	s := &IssuesEvent{Repository: v}
+	if got := s.GetRepository(); got != v {
+		t.Errorf("GetRepository() didn't return the field")
+	}
+	s = nil
+	if got := s.GetRepository(); got != nil {
+		t.Errorf("GetRepository() was %v when receiver was nil", got)
+	}
+}
+
+func TestIssuesEvent_GetSender(t *testing.T) {
+	v := &Account{}
+	s := &IssuesEvent{Sender: v}
+	if got := s.GetSender(); got != v {
+		t.Errorf("GetSender() didn't return the field")
+	}
+	s = nil
+	if got := s.GetSender(); got != nil {
+		t.Errorf("GetSender() was %v when receiver was nil", got)
+	}
+}
+
+func TestLabel_GetColor(t *testing.T) {
+	v := "x"
+	s := &Label{Color: &v}
+	if got := s.GetColor(); got != v {
+		t.Errorf("GetColor() was %v (should have been %v)", got, v)
+	}
+	s = &Label{}
+	if got := s.GetColor(); got != "" {
+		t.Errorf("GetColor() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetColor(); got != "" {
+		t.Errorf("GetColor() was %v when receiver was nil", got)
+	}
+}
+
+func TestLabel_GetDefault(t *testing.T) {
+	v := true
+	s := &Label{Default: &v}
+	if got := s.GetDefault(); got != v {
+		t.Errorf("GetDefault() was %v (should have been %v)", got, v)
+	}
+	s = &Label{}
+	if got := s.GetDefault(); got != false {
+		t.Errorf("GetDefault() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetDefault(); got != false {
+		t.Errorf("GetDefault() was %v when receiver was nil", got)
+	}
+}
+
+func TestLabel_GetDescription(t *testing.T) {
+	v := "x"
+	s := &Label{Description: &v}
+	if got := s.GetDescription(); got != v {
+		t.Errorf("GetDescription() was %v (should have been %v)", got, v)
+	}
+	s = &Label{}
+	if got := s.GetDescription(); got != "" {
+		t.Errorf("GetDescription() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetDescription(); got != "" {
+		t.Errorf("GetDescription() was %v when receiver was nil", got)
+	}
+}
+
+func TestLabel_GetID(t *testing.T) {
+	v := 1
+	s := &Label{ID: &v}
+	if got := s.GetID(); got != v {
+		t.Errorf("GetID() was %v (should have been %v)", got, v)
+	}
+	s = &Label{}
+	if got := s.GetID(); got != 0 {
+		t.Errorf("GetID() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetID(); got != 0 {
+		t.Errorf("GetID() was %v when receiver was nil", got)
+	}
+}
+
+func TestLabel_GetName(t *testing.T) {
+	v := "x"
+	s := &Label{Name: &v}
+	if got := s.GetName(); got != v {
+		t.Errorf("GetName() was %v (should have been %v)", got, v)
+	}
+	s = &Label{}
+	if got := s.GetName(); got != "" {
+		t.Errorf("GetName() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetName(); got != "" {
+		t.Errorf("GetName() was %v when receiver was nil", got)
+	}
+}
+
+func TestLabel_GetNodeID(t *testing.T) {
+	v := "x"
+	s := &Label{NodeID: &v}
+	if got := s.GetNodeID(); got != v {
+		t.Errorf("GetNodeID() was %v (should have been %v)", got, v)
+	}
+	s = &Label{}
+	if got := s.GetNodeID(); got != "" {
+		t.Errorf("GetNodeID() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetNodeID(); got != "" {
+		t.Errorf("GetNodeID() was %v when receiver was nil", got)
+	}
+}
+
+func TestLabel_GetURL(t *testing.T) {
+	v := "x"
+	s := &Label{URL: &v}
+	if got := s.GetURL(); got != v {
+		t.Errorf("GetURL() was %v (should have been %v)", got, v)
+	}
+	s = &Label{}
+	if got := s.GetURL(); got != "" {
+		t.Errorf("GetURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetURL(); got != "" {
+		t.Errorf("GetURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestLabelEvent_GetAction(t *testing.T) {
+	v := "x"
+	s := &LabelEvent{Action: &v}
+	if got := s.GetAction(); got != v {
+		t.Errorf("GetAction() was %v (should have been %v)", got, v)
+	}
+	s = &LabelEvent{}
+	if got := s.GetAction(); got != "" {
+		t.Errorf("GetAction() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetAction(); got != "" {
+		t.Errorf("GetAction() was %v when receiver was nil", got)
+	}
+}
+
+func TestLabelEvent_GetChanges(t *testing.T) {
+	v := &Changes{}
+	s := &LabelEvent{Changes: v}
+	if got := s.GetChanges(); got != v {
+		t.Errorf("GetChanges() didn't return the field")
+	}
+	s = nil
+	if got := s.GetChanges(); got != nil {
+		t.Errorf("GetChanges() was %v when receiver was nil", got)
+	}
+}
+
+func TestLabelEvent_GetInstallation(t *testing.T) {
+	v := &Installation{}
+	s := &LabelEvent{Installation: v}
+	if got := s.GetInstallation(); got != v {
+		t.Errorf("GetInstallation() didn't return the field")
+	}
+	s = nil
+	if got := s.GetInstallation(); got != nil {
+		t.Errorf("GetInstallation() was %v when receiver was nil", got)
+	}
+}
+
+func TestLabelEvent_GetLabel(t *testing.T) {
+	v := &Label{}
+	s := &LabelEvent{Label: v}
+	if got := s.GetLabel(); got != v {
+		t.Errorf("GetLabel() didn't return the field")
+	}
+	s = nil
+	if got := s.GetLabel(); got != nil {
+		t.Errorf("GetLabel() was %v when receiver was nil", got)
+	}
+}
+
+func TestLabelEvent_GetOrganization(t *testing.T) {
+	v := &Account{}
+	s := &LabelEvent{Organization: v}
+	if got := s.GetOrganization(); got != v {
+		t.Errorf("GetOrganization() didn't return the field")
+	}
+	s = nil
+	if got := s.GetOrganization(); got != nil {
+		t.Errorf("GetOrganization() was %v when receiver was nil", got)
+	}
+}
+
+func TestLabelEvent_GetRepository(t *testing.T) {
+	v := &Repository{}
+	s := &LabelEvent{Repository: v}
+	if got := s.GetRepository(); got != v {
+		t.Errorf("GetRepository() didn't return the field")
+	}
+	s = nil
+	if got := s.GetRepository(); got != nil {
+		t.Errorf("GetRepository() was %v when receiver was nil", got)
+	}
+}
+
+func TestLabelEvent_GetSender(t *testing.T) {
+	v := &Account{}
+	s := &LabelEvent{Sender: v}
+	if got := s.GetSender(); got != v {
+		t.Errorf("GetSender() didn't return the field")
+	}
+	s = nil
+	if got := s.GetSender(); got != nil {
+		t.Errorf("GetSender() was %v when receiver was nil", got)
+	}
+}
+
+func TestLicense_GetKey(t *testing.T) {
+	v := "x"
+	s := &License{Key: &v}
+	if got := s.GetKey(); got != v {
+		t.Errorf("GetKey() was %v (should have been %v)", got, v)
+	}
+	s = &License{}
+	if got := s.GetKey(); got != "" {
+		t.Errorf("GetKey() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetKey(); got != "" {
+		t.Errorf("GetKey() was %v when receiver was nil", got)
+	}
+}
+
+func TestLicense_GetName(t *testing.T) {
+	v := "x"
+	s := &License{Name: &v}
+	if got := s.GetName(); got != v {
+		t.Errorf("GetName() was %v (should have been %v)", got, v)
+	}
+	s = &License{}
+	if got := s.GetName(); got != "" {
+		t.Errorf("GetName() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetName(); got != "" {
+		t.Errorf("GetName() was %v when receiver was nil", got)
+	}
+}
+
+func TestLicense_GetNodeID(t *testing.T) {
+	v := "x"
+	s := &License{NodeID: &v}
+	if got := s.GetNodeID(); got != v {
+		t.Errorf("GetNodeID() was %v (should have been %v)", got, v)
+	}
+	s = &License{}
+	if got := s.GetNodeID(); got != "" {
+		t.Errorf("GetNodeID() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetNodeID(); got != "" {
+		t.Errorf("GetNodeID() was %v when receiver was nil", got)
+	}
+}
+
+func TestLicense_GetSpdxID(t *testing.T) {
+	v := "x"
+	s := &License{SpdxID: &v}
+	if got := s.GetSpdxID(); got != v {
+		t.Errorf("GetSpdxID() was %v (should have been %v)", got, v)
+	}
+	s = &License{}
+	if got := s.GetSpdxID(); got != "" {
+		t.Errorf("GetSpdxID() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetSpdxID(); got != "" {
+		t.Errorf("GetSpdxID() was %v when receiver was nil", got)
+	}
+}
+
+func TestLicense_GetURL(t *testing.T) {
+	v := "x"
+	s := &License{URL: &v}
+	if got := s.GetURL(); got != v {
+		t.Errorf("GetURL() was %v (should have been %v)", got, v)
+	}
+	s = &License{}
+	if got := s.GetURL(); got != "" {
+		t.Errorf("GetURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetURL(); got != "" {
+		t.Errorf("GetURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestLink_GetHref(t *testing.T) {
+	v := "x"
+	s := &Link{Href: &v}
+	if got := s.GetHref(); got != v {
+		t.Errorf("GetHref() was %v (should have been %v)", got, v)
+	}
+	s = &Link{}
+	if got := s.GetHref(); got != "" {
+		t.Errorf("GetHref() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetHref(); got != "" {
+		t.Errorf("GetHref() was %v when receiver was nil", got)
+	}
+}
+
+func TestMarketplacePendingChange_GetEffectiveDate(t *testing.T) {
+	v := time.Unix(1557933565, 0)
+	s := &MarketplacePendingChange{EffectiveDate: &TimeWrapper{t: v}}
+	if got := s.GetEffectiveDate(); !got.Equal(v) {
+		t.Errorf("GetEffectiveDate() was %v (should have been %v)", got, v)
+	}
+	s = &MarketplacePendingChange{}
+	if got := s.GetEffectiveDate(); !got.IsZero() {
+		t.Errorf("GetEffectiveDate() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetEffectiveDate(); !got.IsZero() {
+		t.Errorf("GetEffectiveDate() was %v when receiver was nil", got)
+	}
+}
+
+func TestMarketplacePendingChange_GetID(t *testing.T) {
+	v := 1
+	s := &MarketplacePendingChange{ID: &v}
+	if got := s.GetID(); got != v {
+		t.Errorf("GetID() was %v (should have been %v)", got, v)
+	}
+	s = &MarketplacePendingChange{}
+	if got := s.GetID(); got != 0 {
+		t.Errorf("GetID() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetID(); got != 0 {
+		t.Errorf("GetID() was %v when receiver was nil", got)
+	}
+}
+
+func TestMarketplacePendingChange_GetPlan(t *testing.T) {
+	v := &Plan{}
+	s := &MarketplacePendingChange{Plan: v}
+	if got := s.GetPlan(); got != v {
+		t.Errorf("GetPlan() didn't return the field")
+	}
+	s = nil
+	if got := s.GetPlan(); got != nil {
+		t.Errorf("GetPlan() was %v when receiver was nil", got)
+	}
+}
+
+func TestMarketplacePendingChange_GetUnitCount(t *testing.T) {
+	v := 1
+	s := &MarketplacePendingChange{UnitCount: &v}
+	if got := s.GetUnitCount(); got != v {
+		t.Errorf("GetUnitCount() was %v (should have been %v)", got, v)
+	}
+	s = &MarketplacePendingChange{}
+	if got := s.GetUnitCount(); got != 0 {
+		t.Errorf("GetUnitCount() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetUnitCount(); got != 0 {
+		t.Errorf("GetUnitCount() was %v when receiver was nil", got)
+	}
+}
+
+func TestMarketplacePurchase_GetBillingCycle(t *testing.T) {
+	v := "x"
+	s := &MarketplacePurchase{BillingCycle: &v}
+	if got := s.GetBillingCycle(); got != v {
+		t.Errorf("GetBillingCycle() was %v (should have been %v)", got, v)
+	}
+	s = &MarketplacePurchase{}
+	if got := s.GetBillingCycle(); got != "" {
+		t.Errorf("GetBillingCycle() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetBillingCycle(); got != "" {
+		t.Errorf("GetBillingCycle() was %v when receiver was nil", got)
+	}
+}
+
+func TestMarketplacePurchase_GetFreeTrialEndsOn(t *testing.T) {
+	v := time.Unix(1557933565, 0)
+	s := &MarketplacePurchase{FreeTrialEndsOn: &TimeWrapper{t: v}}
+	if got := s.GetFreeTrialEndsOn(); !got.Equal(v) {
+		t.Errorf("GetFreeTrialEndsOn() was %v (should have been %v)", got, v)
+	}
+	s = &MarketplacePurchase{}
+	if got := s.GetFreeTrialEndsOn(); !got.IsZero() {
+		t.Errorf("GetFreeTrialEndsOn() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetFreeTrialEndsOn(); !got.IsZero() {
+		t.Errorf("GetFreeTrialEndsOn() was %v when receiver was nil", got)
+	}
+}
+
+func TestMarketplacePurchase_GetNextBillingDate(t *testing.T) {
+	v := time.Unix(1557933565, 0)
+	s := &MarketplacePurchase{NextBillingDate: &TimeWrapper{t: v}}
+	if got := s.GetNextBillingDate(); !got.Equal(v) {
+		t.Errorf("GetNextBillingDate() was %v (should have been %v)", got, v)
+	}
+	s = &MarketplacePurchase{}
+	if got := s.GetNextBillingDate(); !got.IsZero() {
+		t.Errorf("GetNextBillingDate() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetNextBillingDate(); !got.IsZero() {
+		t.Errorf("GetNextBillingDate() was %v when receiver was nil", got)
+	}
+}
+
+func TestMarketplacePurchase_GetOnFreeTrial(t *testing.T) {
+	v := true
+	s := &MarketplacePurchase{OnFreeTrial: &v}
+	if got := s.GetOnFreeTrial(); got != v {
+		t.Errorf("GetOnFreeTrial() was %v (should have been %v)", got, v)
+	}
+	s = &MarketplacePurchase{}
+	if got := s.GetOnFreeTrial(); got != false {
+		t.Errorf("GetOnFreeTrial() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetOnFreeTrial(); got != false {
+		t.Errorf("GetOnFreeTrial() was %v when receiver was nil", got)
+	}
+}
+
+func TestMarketplacePurchase_GetPlan(t *testing.T) {
+	v := &Plan{}
+	s := &MarketplacePurchase{Plan: v}
+	if got := s.GetPlan(); got != v {
+		t.Errorf("GetPlan() didn't return the field")
+	}
+	s = nil
+	if got := s.GetPlan(); got != nil {
+		t.Errorf("GetPlan() was %v when receiver was nil", got)
+	}
+}
+
+func TestMarketplacePurchase_GetUnitCount(t *testing.T) {
+	v := 1
+	s := &MarketplacePurchase{UnitCount: &v}
+	if got := s.GetUnitCount(); got != v {
+		t.Errorf("GetUnitCount() was %v (should have been %v)", got, v)
+	}
+	s = &MarketplacePurchase{}
+	if got := s.GetUnitCount(); got != 0 {
+		t.Errorf("GetUnitCount() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetUnitCount(); got != 0 {
+		t.Errorf("GetUnitCount() was %v when receiver was nil", got)
+	}
+}
+
+func TestMarketplacePurchase_GetUpdatedAt(t *testing.T) {
+	v := time.Unix(1557933565, 0)
+	s := &MarketplacePurchase{UpdatedAt: &TimeWrapper{t: v}}
+	if got := s.GetUpdatedAt(); !got.Equal(v) {
+		t.Errorf("GetUpdatedAt() was %v (should have been %v)", got, v)
+	}
+	s = &MarketplacePurchase{}
+	if got := s.GetUpdatedAt(); !got.IsZero() {
+		t.Errorf("GetUpdatedAt() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetUpdatedAt(); !got.IsZero() {
+		t.Errorf("GetUpdatedAt() was %v when receiver was nil", got)
+	}
+}
+
+func TestMilestone_GetClosedAt(t *testing.T) {
+	v := time.Unix(1557933565, 0)
+	s := &Milestone{ClosedAt: &TimeWrapper{t: v}}
+	if got := s.GetClosedAt(); !got.Equal(v) {
+		t.Errorf("GetClosedAt() was %v (should have been %v)", got, v)
+	}
+	s = &Milestone{}
+	if got := s.GetClosedAt(); !got.IsZero() {
+		t.Errorf("GetClosedAt() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetClosedAt(); !got.IsZero() {
+		t.Errorf("GetClosedAt() was %v when receiver was nil", got)
+	}
+}
+
+func TestMilestone_GetClosedIssues(t *testing.T) {
+	v := 1
+	s := &Milestone{ClosedIssues: &v}
+	if got := s.GetClosedIssues(); got != v {
+		t.Errorf("GetClosedIssues() was %v (should have been %v)", got, v)
+	}
+	s = &Milestone{}
+	if got := s.GetClosedIssues(); got != 0 {
+		t.Errorf("GetClosedIssues() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetClosedIssues(); got != 0 {
+		t.Errorf("GetClosedIssues() was %v when receiver was nil", got)
+	}
+}
+
+func TestMilestone_GetCreatedAt(t *testing.T) {
+	v := time.Unix(1557933565, 0)
+	s := &Milestone{CreatedAt: &TimeWrapper{t: v}}
+	if got := s.GetCreatedAt(); !got.Equal(v) {
+		t.Errorf("GetCreatedAt() was %v (should have been %v)", got, v)
+	}
+	s = &Milestone{}
+	if got := s.GetCreatedAt(); !got.IsZero() {
+		t.Errorf("GetCreatedAt() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetCreatedAt(); !got.IsZero() {
+		t.Errorf("GetCreatedAt() was %v when receiver was nil", got)
+	}
+}
+
+func TestMilestone_GetCreator(t *testing.T) {
+	v := &Account{}
+	s := &Milestone{Creator: v}
+	if got := s.GetCreator(); got != v {
+		t.Errorf("GetCreator() didn't return the field")
+	}
+	s = nil
+	if got := s.GetCreator(); got != nil {
+		t.Errorf("GetCreator() was %v when receiver was nil", got)
+	}
+}
+
+func TestMilestone_GetDescription(t *testing.T) {
+	v := "x"
+	s := &Milestone{Description: &v}
+	if got := s.GetDescription(); got != v {
+		t.Errorf("GetDescription() was %v (should have been %v)", got, v)
+	}
+	s = &Milestone{}
+	if got := s.GetDescription(); got != "" {
+		t.Errorf("GetDescription() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetDescription(); got != "" {
+		t.Errorf("GetDescription() was %v when receiver was nil", got)
+	}
+}
+
+func TestMilestone_GetDueOn(t *testing.T) {
+	v := time.Unix(1557933565, 0)
+	s := &Milestone{DueOn: &TimeWrapper{t: v}}
+	if got := s.GetDueOn(); !got.Equal(v) {
+		t.Errorf("GetDueOn() was %v (should have been %v)", got, v)
+	}
+	s = &Milestone{}
+	if got := s.GetDueOn(); !got.IsZero() {
+		t.Errorf("GetDueOn() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetDueOn(); !got.IsZero() {
+		t.Errorf("GetDueOn() was %v when receiver was nil", got)
+	}
+}
+
+func TestMilestone_GetHTMLURL(t *testing.T) {
+	v := "x"
+	s := &Milestone{HTMLURL: &v}
+	if got := s.GetHTMLURL(); got != v {
+		t.Errorf("GetHTMLURL() was %v (should have been %v)", got, v)
+	}
+	s = &Milestone{}
+	if got := s.GetHTMLURL(); got != "" {
+		t.Errorf("GetHTMLURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetHTMLURL(); got != "" {
+		t.Errorf("GetHTMLURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestMilestone_GetID(t *testing.T) {
+	v := 1
+	s := &Milestone{ID: &v}
+	if got := s.GetID(); got != v {
+		t.Errorf("GetID() was %v (should have been %v)", got, v)
+	}
+	s = &Milestone{}
+	if got := s.GetID(); got != 0 {
+		t.Errorf("GetID() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetID(); got != 0 {
+		t.Errorf("GetID() was %v when receiver was nil", got)
+	}
+}
+
+func TestMilestone_GetLabelsURL(t *testing.T) {
+	v := "x"
+	s := &Milestone{LabelsURL: &v}
+	if got := s.GetLabelsURL(); got != v {
+		t.Errorf("GetLabelsURL() was %v (should have been %v)", got, v)
+	}
+	s = &Milestone{}
+	if got := s.GetLabelsURL(); got != "" {
+		t.Errorf("GetLabelsURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetLabelsURL(); got != "" {
+		t.Errorf("GetLabelsURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestMilestone_GetNodeID(t *testing.T) {
+	v := "x"
+	s := &Milestone{NodeID: &v}
+	if got := s.GetNodeID(); got != v {
+		t.Errorf("GetNodeID() was %v (should have been %v)", got, v)
+	}
+	s = &Milestone{}
+	if got := s.GetNodeID(); got != "" {
+		t.Errorf("GetNodeID() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetNodeID(); got != "" {
+		t.Errorf("GetNodeID() was %v when receiver was nil", got)
+	}
+}
+
+func TestMilestone_GetNumber(t *testing.T) {
+	v := 1
+	s := &Milestone{Number: &v}
+	if got := s.GetNumber(); got != v {
+		t.Errorf("GetNumber() was %v (should have been %v)", got, v)
+	}
+	s = &Milestone{}
+	if got := s.GetNumber(); got != 0 {
+		t.Errorf("GetNumber() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetNumber(); got != 0 {
+		t.Errorf("GetNumber() was %v when receiver was nil", got)
+	}
+}
+
+func TestMilestone_GetOpenIssues(t *testing.T) {
+	v := 1
+	s := &Milestone{OpenIssues: &v}
+	if got := s.GetOpenIssues(); got != v {
+		t.Errorf("GetOpenIssues() was %v (should have been %v)", got, v)
+	}
+	s = &Milestone{}
+	if got := s.GetOpenIssues(); got != 0 {
+		t.Errorf("GetOpenIssues() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetOpenIssues(); got != 0 {
+		t.Errorf("GetOpenIssues() was %v when receiver was nil", got)
+	}
+}
+
+func TestMilestone_GetState(t *testing.T) {
+	v := "x"
+	s := &Milestone{State: &v}
+	if got := s.GetState(); got != v {
+		t.Errorf("GetState() was %v (should have been %v)", got, v)
+	}
+	s = &Milestone{}
+	if got := s.GetState(); got != "" {
+		t.Errorf("GetState() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetState(); got != "" {
+		t.Errorf("GetState() was %v when receiver was nil", got)
+	}
+}
+
+func TestMilestone_GetTitle(t *testing.T) {
+	v := "x"
+	s := &Milestone{Title: &v}
+	if got := s.GetTitle(); got != v {
+		t.Errorf("GetTitle() was %v (should have been %v)", got, v)
+	}
+	s = &Milestone{}
+	if got := s.GetTitle(); got != "" {
+		t.Errorf("GetTitle() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetTitle(); got != "" {
+		t.Errorf("GetTitle() was %v when receiver was nil", got)
+	}
+}
+
+func TestMilestone_GetURL(t *testing.T) {
+	v := "x"
+	s := &Milestone{URL: &v}
+	if got := s.GetURL(); got != v {
+		t.Errorf("GetURL() was %v (should have been %v)", got, v)
+	}
+	s = &Milestone{}
+	if got := s.GetURL(); got != "" {
+		t.Errorf("GetURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetURL(); got != "" {
+		t.Errorf("GetURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestMilestone_GetUpdatedAt(t *testing.T) {
+	v := time.Unix(1557933565, 0)
+	s := &Milestone{UpdatedAt: &TimeWrapper{t: v}}
+	if got := s.GetUpdatedAt(); !got.Equal(v) {
+		t.Errorf("GetUpdatedAt() was %v (should have been %v)", got, v)
+	}
+	s = &Milestone{}
+	if got := s.GetUpdatedAt(); !got.IsZero() {
+		t.Errorf("GetUpdatedAt() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetUpdatedAt(); !got.IsZero() {
+		t.Errorf("GetUpdatedAt() was %v when receiver was nil", got)
+	}
+}
+
+func TestMilestoneEvent_GetAction(t *testing.T) {
+	v := "x"
+	s := &MilestoneEvent{Action: &v}
+	if got := s.GetAction(); got != v {
+		t.Errorf("GetAction() was %v (should have been %v)", got, v)
+	}
+	s = &MilestoneEvent{}
+	if got := s.GetAction(); got != "" {
+		t.Errorf("GetAction() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetAction(); got != "" {
+		t.Errorf("GetAction() was %v when receiver was nil", got)
+	}
+}
+
+func TestMilestoneEvent_GetChanges(t *testing.T) {
+	v := &Changes{}
+	s := &MilestoneEvent{Changes: v}
+	if got := s.GetChanges(); got != v {
+		t.Errorf("GetChanges() didn't return the field")
+	}
+	s = nil
+	if got := s.GetChanges(); got != nil {
+		t.Errorf("GetChanges() was %v when receiver was nil", got)
+	}
+}
+
+func TestMilestoneEvent_GetInstallation(t *testing.T) {
+	v := &Installation{}
+	s := &MilestoneEvent{Installation: v}
+	if got := s.GetInstallation(); got != v {
+		t.Errorf("GetInstallation() didn't return the field")
+	}
+	s = nil
+	if got := s.GetInstallation(); got != nil {
+		t.Errorf("GetInstallation() was %v when receiver was nil", got)
+	}
+}
+
+func TestMilestoneEvent_GetMilestone(t *testing.T) {
+	v := &Milestone{}
+	s := &MilestoneEvent{Milestone: v}
+	if got := s.GetMilestone(); got != v {
+		t.Errorf("GetMilestone() didn't return the field")
+	}
+	s = nil
+	if got := s.GetMilestone(); got != nil {
+		t.Errorf("GetMilestone() was %v when receiver was nil", got)
+	}
+}
+
+func TestMilestoneEvent_GetOrganization(t *testing.T) {
+	v := &Account{}
+	s := &MilestoneEvent{Organization: v}
+	if got := s.GetOrganization(); got != v {
+		t.Errorf("GetOrganization() didn't return the field")
+	}
+	s = nil
+	if got := s.GetOrganization(); got != nil {
+		t.Errorf("GetOrganization() was %v when receiver was nil", got)
+	}
+}
+
+func TestMilestoneEvent_GetRepository(t *testing.T) {
+	v := &Repository{}
+	s := &MilestoneEvent{Repository: v}
+	if got := s.GetRepository(); got != v {
+		t.Errorf("GetRepository() didn't return the field")
+	}
+	s = nil
+	if got := s.GetRepository(); got != nil {
+		t.Errorf("GetRepository() was %v when receiver was nil", got)
+	}
+}
+
+func TestMilestoneEvent_GetSender(t *testing.T) {
+	v := &Account{}
+	s := &MilestoneEvent{Sender: v}
+	if got := s.GetSender(); got != v {
+		t.Errorf("GetSender() didn't return the field")
+	}
+	s = nil
+	if got := s.GetSender(); got != nil {
+		t.Errorf("GetSender() was %v when receiver was nil", got)
+	}
+}
+
+func TestPingEvent_GetHook(t *testing.T) {
+	v := &Hook{}
+	s := &PingEvent{Hook: v}
+	if got := s.GetHook(); got != v {
+		t.Errorf("GetHook() didn't return the field")
+	}
+	s = nil
+	if got := s.GetHook(); got != nil {
+		t.Errorf("GetHook() was %v when receiver was nil", got)
+	}
+}
+
+func TestPingEvent_GetHookID(t *testing.T) {
+	v := 1
+	s := &PingEvent{HookID: &v}
+	if got := s.GetHookID(); got != v {
+		t.Errorf("GetHookID() was %v (should have been %v)", got, v)
+	}
+	s = &PingEvent{}
+	if got := s.GetHookID(); got != 0 {
+		t.Errorf("GetHookID() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetHookID(); got != 0 {
+		t.Errorf("GetHookID() was %v when receiver was nil", got)
+	}
+}
+
+func TestPingEvent_GetOrganization(t *testing.T) {
+	v := &Account{}
+	s := &PingEvent{Organization: v}
+	if got := s.GetOrganization(); got != v {
+		t.Errorf("GetOrganization() didn't return the field")
+	}
+	s = nil
+	if got := s.GetOrganization(); got != nil {
+		t.Errorf("GetOrganization() was %v when receiver was nil", got)
+	}
+}
+
+func TestPingEvent_GetRepository(t *testing.T) {
+	v := &Repository{}
+	s := &PingEvent{Repository: v}
+	if got := s.GetRepository(); got != v {
+		t.Errorf("GetRepository() didn't return the field")
+	}
+	s = nil
+	if got := s.GetRepository(); got != nil {
+		t.Errorf("GetRepository() was %v when receiver was nil", got)
+	}
+}
+
+func TestPingEvent_GetSender(t *testing.T) {
+	v := &Account{}
+	s := &PingEvent{Sender: v}
+	if got := s.GetSender(); got != v {
+		t.Errorf("GetSender() didn't return the field")
+	}
+	s = nil
+	if got := s.GetSender(); got != nil {
+		t.Errorf("GetSender() was %v when receiver was nil", got)
+	}
+}
+
+func TestPingEvent_GetZen(t *testing.T) {
+	v := "x"
+	s := &PingEvent{Zen: &v}
+	if got := s.GetZen(); got != v {
+		t.Errorf("GetZen() was %v (should have been %v)", got, v)
+	}
+	s = &PingEvent{}
+	if got := s.GetZen(); got != "" {
+		t.Errorf("GetZen() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetZen(); got != "" {
+		t.Errorf("GetZen() was %v when receiver was nil", got)
+	}
+}
+
+func TestPlan_GetAccountsURL(t *testing.T) {
+	v := "x"
+	s := &Plan{AccountsURL: &v}
+	if got := s.GetAccountsURL(); got != v {
+		t.Errorf("GetAccountsURL() was %v (should have been %v)", got, v)
+	}
+	s = &Plan{}
+	if got := s.GetAccountsURL(); got != "" {
+		t.Errorf("GetAccountsURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetAccountsURL(); got != "" {
+		t.Errorf("GetAccountsURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestPlan_GetBullets(t *testing.T) {
+	v := []string{}
+	s := &Plan{Bullets: v}
+	if got := s.GetBullets(); got == nil {
+		t.Errorf("GetBullets() was nil when field was non-nil")
+	}
+	s = nil
+	if got := s.GetBullets(); got != nil {
+		t.Errorf("GetBullets() was %v when receiver was nil", got)
+	}
+}
+
+func TestPlan_GetDescription(t *testing.T) {
+	v := "x"
+	s := &Plan{Description: &v}
+	if got := s.GetDescription(); got != v {
+		t.Errorf("GetDescription() was %v (should have been %v)", got, v)
+	}
+	s = &Plan{}
+	if got := s.GetDescription(); got != "" {
+		t.Errorf("GetDescription() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetDescription(); got != "" {
+		t.Errorf("GetDescription() was %v when receiver was nil", got)
+	}
+}
+
+func TestPlan_GetHasFreeTrial(t *testing.T) {
+	v := true
+	s := &Plan{HasFreeTrial: &v}
+	if got := s.GetHasFreeTrial(); got != v {
+		t.Errorf("GetHasFreeTrial() was %v (should have been %v)", got, v)
+	}
+	s = &Plan{}
+	if got := s.GetHasFreeTrial(); got != false {
+		t.Errorf("GetHasFreeTrial() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetHasFreeTrial(); got != false {
+		t.Errorf("GetHasFreeTrial() was %v when receiver was nil", got)
+	}
+}
+
+func TestPlan_GetID(t *testing.T) {
+	v := 1
+	s := &Plan{ID: &v}
+	if got := s.GetID(); got != v {
+		t.Errorf("GetID() was %v (should have been %v)", got, v)
+	}
+	s = &Plan{}
+	if got := s.GetID(); got != 0 {
+		t.Errorf("GetID() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetID(); got != 0 {
+		t.Errorf("GetID() was %v when receiver was nil", got)
+	}
+}
+
+func TestPlan_GetMonthlyPriceInCents(t *testing.T) {
+	v := 1
+	s := &Plan{MonthlyPriceInCents: &v}
+	if got := s.GetMonthlyPriceInCents(); got != v {
+		t.Errorf("GetMonthlyPriceInCents() was %v (should have been %v)", got, v)
+	}
+	s = &Plan{}
+	if got := s.GetMonthlyPriceInCents(); got != 0 {
+		t.Errorf("GetMonthlyPriceInCents() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetMonthlyPriceInCents(); got != 0 {
+		t.Errorf("GetMonthlyPriceInCents() was %v when receiver was nil", got)
+	}
+}
+
+func TestPlan_GetName(t *testing.T) {
+	v := "x"
+	s := &Plan{Name: &v}
+	if got := s.GetName(); got != v {
+		t.Errorf("GetName() was %v (should have been %v)", got, v)
+	}
+	s = &Plan{}
+	if got := s.GetName(); got != "" {
+		t.Errorf("GetName() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetName(); got != "" {
+		t.Errorf("GetName() was %v when receiver was nil", got)
+	}
+}
+
+func TestPlan_GetNumber(t *testing.T) {
+	v := 1
+	s := &Plan{Number: &v}
+	if got := s.GetNumber(); got != v {
+		t.Errorf("GetNumber() was %v (should have been %v)", got, v)
+	}
+	s = &Plan{}
+	if got := s.GetNumber(); got != 0 {
+		t.Errorf("GetNumber() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetNumber(); got != 0 {
+		t.Errorf("GetNumber() was %v when receiver was nil", got)
+	}
+}
+
+func TestPlan_GetPriceModel(t *testing.T) {
+	v := "x"
+	s := &Plan{PriceModel: &v}
+	if got := s.GetPriceModel(); got != v {
+		t.Errorf("GetPriceModel() was %v (should have been %v)", got, v)
+	}
+	s = &Plan{}
+	if got := s.GetPriceModel(); got != "" {
+		t.Errorf("GetPriceModel() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetPriceModel(); got != "" {
+		t.Errorf("GetPriceModel() was %v when receiver was nil", got)
+	}
+}
+
+func TestPlan_GetState(t *testing.T) {
+	v := "x"
+	s := &Plan{State: &v}
+	if got := s.GetState(); got != v {
+		t.Errorf("GetState() was %v (should have been %v)", got, v)
+	}
+	s = &Plan{}
+	if got := s.GetState(); got != "" {
+		t.Errorf("GetState() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetState(); got != "" {
+		t.Errorf("GetState() was %v when receiver was nil", got)
+	}
+}
+
+func TestPlan_GetURL(t *testing.T) {
+	v := "x"
+	s := &Plan{URL: &v}
+	if got := s.GetURL(); got != v {
+		t.Errorf("GetURL() was %v (should have been %v)", got, v)
+	}
+	s = &Plan{}
+	if got := s.GetURL(); got != "" {
+		t.Errorf("GetURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetURL(); got != "" {
+		t.Errorf("GetURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestPlan_GetUnitName(t *testing.T) {
+	v := "x"
+	s := &Plan{UnitName: &v}
+	if got := s.GetUnitName(); got != v {
+		t.Errorf("GetUnitName() was %v (should have been %v)", got, v)
+	}
+	s = &Plan{}
+	if got := s.GetUnitName(); got != "" {
+		t.Errorf("GetUnitName() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetUnitName(); got != "" {
+		t.Errorf("GetUnitName() was %v when receiver was nil", got)
+	}
+}
+
+func TestPlan_GetYearlyPriceInCents(t *testing.T) {
+	v := 1
+	s := &Plan{YearlyPriceInCents: &v}
+	if got := s.GetYearlyPriceInCents(); got != v {
+		t.Errorf("GetYearlyPriceInCents() was %v (should have been %v)", got, v)
+	}
+	s = &Plan{}
+	if got := s.GetYearlyPriceInCents(); got != 0 {
+		t.Errorf("GetYearlyPriceInCents() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetYearlyPriceInCents(); got != 0 {
+		t.Errorf("GetYearlyPriceInCents() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequest_GetActiveLockReason(t *testing.T) {
+	v := "x"
+	s := &PullRequest{ActiveLockReason: &v}
+	if got := s.GetActiveLockReason(); got != v {
+		t.Errorf("GetActiveLockReason() was %v (should have been %v)", got, v)
+	}
+	s = &PullRequest{}
+	if got := s.GetActiveLockReason(); got != "" {
+		t.Errorf("GetActiveLockReason() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetActiveLockReason(); got != "" {
+		t.Errorf("GetActiveLockReason() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequest_GetAdditions(t *testing.T) {
+	v := 1
+	s := &PullRequest{Additions: &v}
+	if got := s.GetAdditions(); got != v {
+		t.Errorf("GetAdditions() was %v (should have been %v)", got, v)
+	}
+	s = &PullRequest{}
+	if got := s.GetAdditions(); got != 0 {
+		t.Errorf("GetAdditions() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetAdditions(); got != 0 {
+		t.Errorf("GetAdditions() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequest_GetAssignee(t *testing.T) {
+	v := &Account{}
+	s := &PullRequest{Assignee: v}
+	if got := s.GetAssignee(); got != v {
+		t.Errorf("GetAssignee() didn't return the field")
+	}
+	s = nil
+	if got := s.GetAssignee(); got != nil {
+		t.Errorf("GetAssignee() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequest_GetAssignees(t *testing.T) {
+	v := []Account{}
+	s := &PullRequest{Assignees: v}
+	if got := s.GetAssignees(); got == nil {
+		t.Errorf("GetAssignees() was nil when field was non-nil")
+	}
+	s = nil
+	if got := s.GetAssignees(); got != nil {
+		t.Errorf("GetAssignees() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequest_GetAuthorAssociation(t *testing.T) {
+	v := "x"
+	s := &PullRequest{AuthorAssociation: &v}
+	if got := s.GetAuthorAssociation(); got != v {
+		t.Errorf("GetAuthorAssociation() was %v (should have been %v)", got, v)
+	}
+	s = &PullRequest{}
+	if got := s.GetAuthorAssociation(); got != "" {
+		t.Errorf("GetAuthorAssociation() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetAuthorAssociation(); got != "" {
+		t.Errorf("GetAuthorAssociation() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequest_GetAutoMerge(t *testing.T) {
+	v := &AutoMerge{}
+	s := &PullRequest{AutoMerge: v}
+	if got := s.GetAutoMerge(); got != v {
+		t.Errorf("GetAutoMerge() didn't return the field")
+	}
+	s = nil
+	if got := s.GetAutoMerge(); got != nil {
+		t.Errorf("GetAutoMerge() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequest_GetBase(t *testing.T) {
+	v := &PullRequestBranch{}
+	s := &PullRequest{Base: v}
+	if got := s.GetBase(); got != v {
+		t.Errorf("GetBase() didn't return the field")
+	}
+	s = nil
+	if got := s.GetBase(); got != nil {
+		t.Errorf("GetBase() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequest_GetBody(t *testing.T) {
+	v := "x"
+	s := &PullRequest{Body: &v}
+	if got := s.GetBody(); got != v {
+		t.Errorf("GetBody() was %v (should have been %v)", got, v)
+	}
+	s = &PullRequest{}
+	if got := s.GetBody(); got != "" {
+		t.Errorf("GetBody() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetBody(); got != "" {
+		t.Errorf("GetBody() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequest_GetChangedFiles(t *testing.T) {
+	v := 1
+	s := &PullRequest{ChangedFiles: &v}
+	if got := s.GetChangedFiles(); got != v {
+		t.Errorf("GetChangedFiles() was %v (should have been %v)", got, v)
+	}
+	s = &PullRequest{}
+	if got := s.GetChangedFiles(); got != 0 {
+		t.Errorf("GetChangedFiles() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetChangedFiles(); got != 0 {
+		t.Errorf("GetChangedFiles() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequest_GetClosedAt(t *testing.T) {
+	v := time.Unix(1557933565, 0)
+	s := &PullRequest{ClosedAt: &TimeWrapper{t: v}}
+	if got := s.GetClosedAt(); !got.Equal(v) {
+		t.Errorf("GetClosedAt() was %v (should have been %v)", got, v)
+	}
+	s = &PullRequest{}
+	if got := s.GetClosedAt(); !got.IsZero() {
+		t.Errorf("GetClosedAt() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetClosedAt(); !got.IsZero() {
+		t.Errorf("GetClosedAt() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequest_GetComments(t *testing.T) {
+	v := 1
+	s := &PullRequest{Comments: &v}
+	if got := s.GetComments(); got != v {
+		t.Errorf("GetComments() was %v (should have been %v)", got, v)
+	}
+	s = &PullRequest{}
+	if got := s.GetComments(); got != 0 {
+		t.Errorf("GetComments() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetComments(); got != 0 {
+		t.Errorf("GetComments() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequest_GetCommentsURL(t *testing.T) {
+	v := "x"
+	s := &PullRequest{CommentsURL: &v}
+	if got := s.GetCommentsURL(); got != v {
+		t.Errorf("GetCommentsURL() was %v (should have been %v)", got, v)
+	}
+	s = &PullRequest{}
+	if got := s.GetCommentsURL(); got != "" {
+		t.Errorf("GetCommentsURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetCommentsURL(); got != "" {
+		t.Errorf("GetCommentsURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequest_GetCommits(t *testing.T) {
+	v := 1
+	s := &PullRequest{Commits: &v}
+	if got := s.GetCommits(); got != v {
+		t.Errorf("GetCommits() was %v (should have been %v)", got, v)
+	}
+	s = &PullRequest{}
+	if got := s.GetCommits(); got != 0 {
+		t.Errorf("GetCommits() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetCommits(); got != 0 {
+		t.Errorf("GetCommits() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequest_GetCommitsURL(t *testing.T) {
+	v := "x"
+	s := &PullRequest{CommitsURL: &v}
+	if got := s.GetCommitsURL(); got != v {
+		t.Errorf("GetCommitsURL() was %v (should have been %v)", got, v)
+	}
+	s = &PullRequest{}
+	if got := s.GetCommitsURL(); got != "" {
+		t.Errorf("GetCommitsURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetCommitsURL(); got != "" {
+		t.Errorf("GetCommitsURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequest_GetCreatedAt(t *testing.T) {
+	v := time.Unix(1557933565, 0)
+	s := &PullRequest{CreatedAt: &TimeWrapper{t: v}}
+	if got := s.GetCreatedAt(); !got.Equal(v) {
+		t.Errorf("GetCreatedAt() was %v (should have been %v)", got, v)
+	}
+	s = &PullRequest{}
+	if got := s.GetCreatedAt(); !got.IsZero() {
+		t.Errorf("GetCreatedAt() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetCreatedAt(); !got.IsZero() {
+		t.Errorf("GetCreatedAt() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequest_GetDeletions(t *testing.T) {
+	v := 1
+	s := &PullRequest{Deletions: &v}
+	if got := s.GetDeletions(); got != v {
+		t.Errorf("GetDeletions() was %v (should have been %v)", got, v)
+	}
+	s = &PullRequest{}
+	if got := s.GetDeletions(); got != 0 {
+		t.Errorf("GetDeletions() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetDeletions(); got != 0 {
+		t.Errorf("GetDeletions() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequest_GetDiffURL(t *testing.T) {
+	v := "x"
+	s := &PullRequest{DiffURL: &v}
+	if got := s.GetDiffURL(); got != v {
+		t.Errorf("GetDiffURL() was %v (should have been %v)", got, v)
+	}
+	s = &PullRequest{}
+	if got := s.GetDiffURL(); got != "" {
+		t.Errorf("GetDiffURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetDiffURL(); got != "" {
+		t.Errorf("GetDiffURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequest_GetDraft(t *testing.T) {
+	v := true
+	s := &PullRequest{Draft: &v}
+	if got := s.GetDraft(); got != v {
+		t.Errorf("GetDraft() was %v (should have been %v)", got, v)
+	}
+	s = &PullRequest{}
+	if got := s.GetDraft(); got != false {
+		t.Errorf("GetDraft() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetDraft(); got != false {
+		t.Errorf("GetDraft() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequest_GetHTMLURL(t *testing.T) {
+	v := "x"
+	s := &PullRequest{HTMLURL: &v}
+	if got := s.GetHTMLURL(); got != v {
+		t.Errorf("GetHTMLURL() was %v (should have been %v)", got, v)
+	}
+	s = &PullRequest{}
+	if got := s.GetHTMLURL(); got != "" {
+		t.Errorf("GetHTMLURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetHTMLURL(); got != "" {
+		t.Errorf("GetHTMLURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequest_GetHead(t *testing.T) {
+	v := &PullRequestBranch{}
+	s := &PullRequest{Head: v}
+	if got := s.GetHead(); got != v {
+		t.Errorf("GetHead() didn't return the field")
+	}
+	s = nil
+	if got := s.GetHead(); got != nil {
+		t.Errorf("GetHead() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequest_GetID(t *testing.T) {
+	v := 1
+	s := &PullRequest{ID: &v}
+	if got := s.GetID(); got != v {
+		t.Errorf("GetID() was %v (should have been %v)", got, v)
+	}
+	s = &PullRequest{}
+	if got := s.GetID(); got != 0 {
+		t.Errorf("GetID() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetID(); got != 0 {
+		t.Errorf("GetID() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequest_GetIssueURL(t *testing.T) {
+	v := "x"
+	s := &PullRequest{IssueURL: &v}
+	if got := s.GetIssueURL(); got != v {
+		t.Errorf("GetIssueURL() was %v (should have been %v)", got, v)
+	}
+	s = &PullRequest{}
+	if got := s.GetIssueURL(); got != "" {
+		t.Errorf("GetIssueURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetIssueURL(); got != "" {
+		t.Errorf("GetIssueURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequest_GetLabels(t *testing.T) {
+	v := []Label{}
+	s := &PullRequest{Labels: v}
+	if got := s.GetLabels(); got == nil {
+		t.Errorf("GetLabels() was nil when field was non-nil")
+	}
+	s = nil
+	if got := s.GetLabels(); got != nil {
+		t.Errorf("GetLabels() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequest_GetLinks(t *testing.T) {
+	v := &PullRequestLinks{}
+	s := &PullRequest{Links: v}
+	if got := s.GetLinks(); got != v {
+		t.Errorf("GetLinks() didn't return the field")
+	}
+	s = nil
+	if got := s.GetLinks(); got != nil {
+		t.Errorf("GetLinks() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequest_GetLocked(t *testing.T) {
+	v := true
+	s := &PullRequest{Locked: &v}
+	if got := s.GetLocked(); got != v {
+		t.Errorf("GetLocked() was %v (should have been %v)", got, v)
+	}
+	s = &PullRequest{}
+	if got := s.GetLocked(); got != false {
+		t.Errorf("GetLocked() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetLocked(); got != false {
+		t.Errorf("GetLocked() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequest_GetMaintainerCanModify(t *testing.T) {
+	v := true
+	s := &PullRequest{MaintainerCanModify: &v}
+	if got := s.GetMaintainerCanModify(); got != v {
+		t.Errorf("GetMaintainerCanModify() was %v (should have been %v)", got, v)
+	}
+	s = &PullRequest{}
+	if got := s.GetMaintainerCanModify(); got != false {
+		t.Errorf("GetMaintainerCanModify() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetMaintainerCanModify(); got != false {
+		t.Errorf("GetMaintainerCanModify() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequest_GetMergeCommitSHA(t *testing.T) {
+	v := "x"
+	s := &PullRequest{MergeCommitSHA: &v}
+	if got := s.GetMergeCommitSHA(); got != v {
+		t.Errorf("GetMergeCommitSHA() was %v (should have been %v)", got, v)
+	}
+	s = &PullRequest{}
+	if got := s.GetMergeCommitSHA(); got != "" {
+		t.Errorf("GetMergeCommitSHA() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetMergeCommitSHA(); got != "" {
+		t.Errorf("GetMergeCommitSHA() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequest_GetMergeable(t *testing.T) {
+	v := true
+	s := &PullRequest{Mergeable: &v}
+	if got := s.GetMergeable(); got != v {
+		t.Errorf("GetMergeable() was %v (should have been %v)", got, v)
+	}
+	s = &PullRequest{}
+	if got := s.GetMergeable(); got != false {
+		t.Errorf("GetMergeable() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetMergeable(); got != false {
+		t.Errorf("GetMergeable() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequest_GetMergeableState(t *testing.T) {
+	v := "x"
+	s := &PullRequest{MergeableState: &v}
+	if got := s.GetMergeableState(); got != v {
+		t.Errorf("GetMergeableState() was %v (should have been %v)", got, v)
+	}
+	s = &PullRequest{}
+	if got := s.GetMergeableState(); got != "" {
+		t.Errorf("GetMergeableState() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetMergeableState(); got != "" {
+		t.Errorf("GetMergeableState() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequest_GetMerged(t *testing.T) {
+	v := true
+	s := &PullRequest{Merged: &v}
+	if got := s.GetMerged(); got != v {
+		t.Errorf("GetMerged() was %v (should have been %v)", got, v)
+	}
+	s = &PullRequest{}
+	if got := s.GetMerged(); got != false {
+		t.Errorf("GetMerged() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetMerged(); got != false {
+		t.Errorf("GetMerged() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequest_GetMergedAt(t *testing.T) {
+	v := time.Unix(1557933565, 0)
+	s := &PullRequest{MergedAt: &TimeWrapper{t: v}}
+	if got := s.GetMergedAt(); !got.Equal(v) {
+		t.Errorf("GetMergedAt() was %v (should have been %v)", got, v)
+	}
+	s = &PullRequest{}
+	if got := s.GetMergedAt(); !got.IsZero() {
+		t.Errorf("GetMergedAt() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetMergedAt(); !got.IsZero() {
+		t.Errorf("GetMergedAt() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequest_GetMergedBy(t *testing.T) {
+	v := &Account{}
+	s := &PullRequest{MergedBy: v}
+	if got := s.GetMergedBy(); got != v {
+		t.Errorf("GetMergedBy() didn't return the field")
+	}
+	s = nil
+	if got := s.GetMergedBy(); got != nil {
+		t.Errorf("GetMergedBy() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequest_GetMilestone(t *testing.T) {
+	v := &Milestone{}
+	s := &PullRequest{Milestone: v}
+	if got := s.GetMilestone(); got != v {
+		t.Errorf("GetMilestone() didn't return the field")
+	}
+	s = nil
+	if got := s.GetMilestone(); got != nil {
+		t.Errorf("GetMilestone() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequest_GetNodeID(t *testing.T) {
+	v := "x"
+	s := &PullRequest{NodeID: &v}
+	if got := s.GetNodeID(); got != v {
+		t.Errorf("GetNodeID() was %v (should have been %v)", got, v)
+	}
+	s = &PullRequest{}
+	if got := s.GetNodeID(); got != "" {
+		t.Errorf("GetNodeID() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetNodeID(); got != "" {
+		t.Errorf("GetNodeID() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequest_GetNumber(t *testing.T) {
+	v := 1
+	s := &PullRequest{Number: &v}
+	if got := s.GetNumber(); got != v {
+		t.Errorf("GetNumber() was %v (should have been %v)", got, v)
+	}
+	s = &PullRequest{}
+	if got := s.GetNumber(); got != 0 {
+		t.Errorf("GetNumber() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetNumber(); got != 0 {
+		t.Errorf("GetNumber() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequest_GetPatchURL(t *testing.T) {
+	v := "x"
+	s := &PullRequest{PatchURL: &v}
+	if got := s.GetPatchURL(); got != v {
+		t.Errorf("GetPatchURL() was %v (should have been %v)", got, v)
+	}
+	s = &PullRequest{}
+	if got := s.GetPatchURL(); got != "" {
+		t.Errorf("GetPatchURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetPatchURL(); got != "" {
+		t.Errorf("GetPatchURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequest_GetRebaseable(t *testing.T) {
+	v := true
+	s := &PullRequest{Rebaseable: &v}
+	if got := s.GetRebaseable(); got != v {
+		t.Errorf("GetRebaseable() was %v (should have been %v)", got, v)
+	}
+	s = &PullRequest{}
+	if got := s.GetRebaseable(); got != false {
+		t.Errorf("GetRebaseable() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetRebaseable(); got != false {
+		t.Errorf("GetRebaseable() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequest_GetRequestedReviewers(t *testing.T) {
+	v := []Account{}
+	s := &PullRequest{RequestedReviewers: v}
+	if got := s.GetRequestedReviewers(); got == nil {
+		t.Errorf("GetRequestedReviewers() was nil when field was non-nil")
+	}
+	s = nil
+	if got := s.GetRequestedReviewers(); got != nil {
+		t.Errorf("GetRequestedReviewers() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequest_GetRequestedTeams(t *testing.T) {
+	v := []Team{}
+	s := &PullRequest{RequestedTeams: v}
+	if got := s.GetRequestedTeams(); got == nil {
+		t.Errorf("GetRequestedTeams() was nil when field was non-nil")
+	}
+	s = nil
+	if got := s.GetRequestedTeams(); got != nil {
+		t.Errorf("GetRequestedTeams() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequest_GetReviewCommentURL(t *testing.T) {
+	v := "x"
+	s := &PullRequest{ReviewCommentURL: &v}
+	if got := s.GetReviewCommentURL(); got != v {
+		t.Errorf("GetReviewCommentURL() was %v (should have been %v)", got, v)
+	}
+	s = &PullRequest{}
+	if got := s.GetReviewCommentURL(); got != "" {
+		t.Errorf("GetReviewCommentURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetReviewCommentURL(); got != "" {
+		t.Errorf("GetReviewCommentURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequest_GetReviewComments(t *testing.T) {
+	v := 1
+	s := &PullRequest{ReviewComments: &v}
+	if got := s.GetReviewComments(); got != v {
+		t.Errorf("GetReviewComments() was %v (should have been %v)", got, v)
+	}
+	s = &PullRequest{}
+	if got := s.GetReviewComments(); got != 0 {
+		t.Errorf("GetReviewComments() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetReviewComments(); got != 0 {
+		t.Errorf("GetReviewComments() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequest_GetReviewCommentsURL(t *testing.T) {
+	v := "x"
+	s := &PullRequest{ReviewCommentsURL: &v}
+	if got := s.GetReviewCommentsURL(); got != v {
+		t.Errorf("GetReviewCommentsURL() was %v (should have been %v)", got, v)
+	}
+	s = &PullRequest{}
+	if got := s.GetReviewCommentsURL(); got != "" {
+		t.Errorf("GetReviewCommentsURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetReviewCommentsURL(); got != "" {
+		t.Errorf("GetReviewCommentsURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequest_GetState(t *testing.T) {
+	v := "x"
+	s := &PullRequest{State: &v}
+	if got := s.GetState(); got != v {
+		t.Errorf("GetState() was %v (should have been %v)", got, v)
+	}
+	s = &PullRequest{}
+	if got := s.GetState(); got != "" {
+		t.Errorf("GetState() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetState(); got != "" {
+		t.Errorf("GetState() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequest_GetStatusesURL(t *testing.T) {
+	v := "x"
+	s := &PullRequest{StatusesURL: &v}
+	if got := s.GetStatusesURL(); got != v {
+		t.Errorf("GetStatusesURL() was %v (should have been %v)", got, v)
+	}
+	s = &PullRequest{}
+	if got := s.GetStatusesURL(); got != "" {
+		t.Errorf("GetStatusesURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetStatusesURL(); got != "" {
+		t.Errorf("GetStatusesURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequest_GetTitle(t *testing.T) {
+	v := "x"
+	s := &PullRequest{Title: &v}
+	if got := s.GetTitle(); got != v {
+		t.Errorf("GetTitle() was %v (should have been %v)", got, v)
+	}
+	s = &PullRequest{}
+	if got := s.GetTitle(); got != "" {
+		t.Errorf("GetTitle() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetTitle(); got != "" {
+		t.Errorf("GetTitle() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequest_GetURL(t *testing.T) {
+	v := "x"
+	s := &PullRequest{URL: &v}
+	if got := s.GetURL(); got != v {
+		t.Errorf("GetURL() was %v (should have been %v)", got, v)
+	}
+	s = &PullRequest{}
+	if got := s.GetURL(); got != "" {
+		t.Errorf("GetURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetURL(); got != "" {
+		t.Errorf("GetURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequest_GetUpdatedAt(t *testing.T) {
+	v := time.Unix(1557933565, 0)
+	s := &PullRequest{UpdatedAt: &TimeWrapper{t: v}}
+	if got := s.GetUpdatedAt(); !got.Equal(v) {
+		t.Errorf("GetUpdatedAt() was %v (should have been %v)", got, v)
+	}
+	s = &PullRequest{}
+	if got := s.GetUpdatedAt(); !got.IsZero() {
+		t.Errorf("GetUpdatedAt() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetUpdatedAt(); !got.IsZero() {
+		t.Errorf("GetUpdatedAt() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequest_GetUser(t *testing.T) {
+	v := &Account{}
+	s := &PullRequest{User: v}
+	if got := s.GetUser(); got != v {
+		t.Errorf("GetUser() didn't return the field")
+	}
+	s = nil
+	if got := s.GetUser(); got != nil {
+		t.Errorf("GetUser() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequestBranch_GetLabel(t *testing.T) {
+	v := "x"
+	s := &PullRequestBranch{Label: &v}
+	if got := s.GetLabel(); got != v {
+		t.Errorf("GetLabel() was %v (should have been %v)", got, v)
+	}
+	s = &PullRequestBranch{}
+	if got := s.GetLabel(); got != "" {
+		t.Errorf("GetLabel() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetLabel(); got != "" {
+		t.Errorf("GetLabel() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequestBranch_GetRef(t *testing.T) {
+	v := "x"
+	s := &PullRequestBranch{Ref: &v}
+	if got := s.GetRef(); got != v {
+		t.Errorf("GetRef() was %v (should have been %v)", got, v)
+	}
+	s = &PullRequestBranch{}
+	if got := s.GetRef(); got != "" {
+		t.Errorf("GetRef() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetRef(); got != "" {
+		t.Errorf("GetRef() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequestBranch_GetRepo(t *testing.T) {
+	v := &Repository{}
+	s := &PullRequestBranch{Repo: v}
+	if got := s.GetRepo(); got != v {
+		t.Errorf("GetRepo() didn't return the field")
+	}
+	s = nil
+	if got := s.GetRepo(); got != nil {
+		t.Errorf("GetRepo() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequestBranch_GetSHA(t *testing.T) {
+	v := "x"
+	s := &PullRequestBranch{SHA: &v}
+	if got := s.GetSHA(); got != v {
+		t.Errorf("GetSHA() was %v (should have been %v)", got, v)
+	}
+	s = &PullRequestBranch{}
+	if got := s.GetSHA(); got != "" {
+		t.Errorf("GetSHA() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetSHA(); got != "" {
+		t.Errorf("GetSHA() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequestBranch_GetUser(t *testing.T) {
+	v := &Account{}
+	s := &PullRequestBranch{User: v}
+	if got := s.GetUser(); got != v {
+		t.Errorf("GetUser() didn't return the field")
+	}
+	s = nil
+	if got := s.GetUser(); got != nil {
+		t.Errorf("GetUser() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequestEvent_GetAction(t *testing.T) {
+	v := "x"
+	s := &PullRequestEvent{Action: &v}
+	if got := s.GetAction(); got != v {
+		t.Errorf("GetAction() was %v (should have been %v)", got, v)
+	}
+	s = &PullRequestEvent{}
+	if got := s.GetAction(); got != "" {
+		t.Errorf("GetAction() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetAction(); got != "" {
+		t.Errorf("GetAction() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequestEvent_GetAfter(t *testing.T) {
+	v := "x"
+	s := &PullRequestEvent{After: &v}
+	if got := s.GetAfter(); got != v {
+		t.Errorf("GetAfter() was %v (should have been %v)", got, v)
+	}
+	s = &PullRequestEvent{}
+	if got := s.GetAfter(); got != "" {
+		t.Errorf("GetAfter() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetAfter(); got != "" {
+		t.Errorf("GetAfter() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequestEvent_GetAssignee(t *testing.T) {
+	v := &Account{}
+	s := &PullRequestEvent{Assignee: v}
+	if got := s.GetAssignee(); got != v {
+		t.Errorf("GetAssignee() didn't return the field")
+	}
+	s = nil
+	if got := s.GetAssignee(); got != nil {
+		t.Errorf("GetAssignee() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequestEvent_GetBefore(t *testing.T) {
+	v := "x"
+	s := &PullRequestEvent{Before: &v}
+	if got := s.GetBefore(); got != v {
+		t.Errorf("GetBefore() was %v (should have been %v)", got, v)
+	}
+	s = &PullRequestEvent{}
+	if got := s.GetBefore(); got != "" {
+		t.Errorf("GetBefore() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetBefore(); got != "" {
+		t.Errorf("GetBefore() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequestEvent_GetChanges(t *testing.T) {
+	v := &Changes{}
+	s := &PullRequestEvent{Changes: v}
+	if got := s.GetChanges(); got != v {
+		t.Errorf("GetChanges() didn't return the field")
+	}
+	s = nil
+	if got := s.GetChanges(); got != nil {
+		t.Errorf("GetChanges() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequestEvent_GetInstallation(t *testing.T) {
+	v := &Installation{}
+	s := &PullRequestEvent{Installation: v}
+	if got := s.GetInstallation(); got != v {
+		t.Errorf("GetInstallation() didn't return the field")
+	}
+	s = nil
+	if got := s.GetInstallation(); got != nil {
+		t.Errorf("GetInstallation() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequestEvent_GetLabel(t *testing.T) {
+	v := &Label{}
+	s := &PullRequestEvent{Label: v}
+	if got := s.GetLabel(); got != v {
+		t.Errorf("GetLabel() didn't return the field")
+	}
+	s = nil
+	if got := s.GetLabel(); got != nil {
+		t.Errorf("GetLabel() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequestEvent_GetNumber(t *testing.T) {
+	v := 1
+	s := &PullRequestEvent{Number: &v}
+	if got := s.GetNumber(); got != v {
+		t.Errorf("GetNumber() was %v (should have been %v)", got, v)
+	}
+	s = &PullRequestEvent{}
+	if got := s.GetNumber(); got != 0 {
+		t.Errorf("GetNumber() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetNumber(); got != 0 {
+		t.Errorf("GetNumber() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequestEvent_GetOrganization(t *testing.T) {
+	v := &Account{}
+	s := &PullRequestEvent{Organization: v}
+	if got := s.GetOrganization(); got != v {
+		t.Errorf("GetOrganization() didn't return the field")
+	}
+	s = nil
+	if got := s.GetOrganization(); got != nil {
+		t.Errorf("GetOrganization() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequestEvent_GetPullRequest(t *testing.T) {
+	v := &PullRequest{}
+	s := &PullRequestEvent{PullRequest: v}
+	if got := s.GetPullRequest(); got != v {
+		t.Errorf("GetPullRequest() didn't return the field")
+	}
+	s = nil
+	if got := s.GetPullRequest(); got != nil {
+		t.Errorf("GetPullRequest() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequestEvent_GetRepository(t *testing.T) {
+	v := &Repository{}
+	s := &PullRequestEvent{Repository: v}
+	if got := s.GetRepository(); got != v {
+		t.Errorf("GetRepository() didn't return the field")
+	}
+	s = nil
+	if got := s.GetRepository(); got != nil {
+		t.Errorf("GetRepository() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequestEvent_GetRequestedReviewer(t *testing.T) {
+	v := &Account{}
+	s := &PullRequestEvent{RequestedReviewer: v}
+	if got := s.GetRequestedReviewer(); got != v {
+		t.Errorf("GetRequestedReviewer() didn't return the field")
+	}
+	s = nil
+	if got := s.GetRequestedReviewer(); got != nil {
+		t.Errorf("GetRequestedReviewer() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequestEvent_GetSender(t *testing.T) {
+	v := &Account{}
+	s := &PullRequestEvent{Sender: v}
+	if got := s.GetSender(); got != v {
+		t.Errorf("GetSender() didn't return the field")
+	}
+	s = nil
+	if got := s.GetSender(); got != nil {
+		t.Errorf("GetSender() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequestLinks_GetComments(t *testing.T) {
+	v := &Link{}
+	s := &PullRequestLinks{Comments: v}
+	if got := s.GetComments(); got != v {
+		t.Errorf("GetComments() didn't return the field")
+	}
+	s = nil
+	if got := s.GetComments(); got != nil {
+		t.Errorf("GetComments() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequestLinks_GetCommits(t *testing.T) {
+	v := &Link{}
+	s := &PullRequestLinks{Commits: v}
+	if got := s.GetCommits(); got != v {
+		t.Errorf("GetCommits() didn't return the field")
+	}
+	s = nil
+	if got := s.GetCommits(); got != nil {
+		t.Errorf("GetCommits() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequestLinks_GetHTML(t *testing.T) {
+	v := &Link{}
+	s := &PullRequestLinks{HTML: v}
+	if got := s.GetHTML(); got != v {
+		t.Errorf("GetHTML() didn't return the field")
+	}
+	s = nil
+	if got := s.GetHTML(); got != nil {
+		t.Errorf("GetHTML() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequestLinks_GetIssue(t *testing.T) {
+	v := &Link{}
+	s := &PullRequestLinks{Issue: v}
+	if got := s.GetIssue(); got != v {
+		t.Errorf("GetIssue() didn't return the field")
+	}
+	s = nil
+	if got := s.GetIssue(); got != nil {
+		t.Errorf("GetIssue() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequestLinks_GetReviewComment(t *testing.T) {
+	v := &Link{}
+	s := &PullRequestLinks{ReviewComment: v}
+	if got := s.GetReviewComment(); got != v {
+		t.Errorf("GetReviewComment() didn't return the field")
+	}
+	s = nil
+	if got := s.GetReviewComment(); got != nil {
+		t.Errorf("GetReviewComment() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequestLinks_GetReviewComments(t *testing.T) {
+	v := &Link{}
+	s := &PullRequestLinks{ReviewComments: v}
+	if got := s.GetReviewComments(); got != v {
+		t.Errorf("GetReviewComments() didn't return the field")
+	}
+	s = nil
+	if got := s.GetReviewComments(); got != nil {
+		t.Errorf("GetReviewComments() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequestLinks_GetSelf(t *testing.T) {
+	v := &Link{}
+	s := &PullRequestLinks{Self: v}
+	if got := s.GetSelf(); got != v {
+		t.Errorf("GetSelf() didn't return the field")
+	}
+	s = nil
+	if got := s.GetSelf(); got != nil {
+		t.Errorf("GetSelf() was %v when receiver was nil", got)
+	}
+}
+
+func TestPullRequestLinks_GetStatuses(t *testing.T) {
+	v := &Link{}
+	s := &PullRequestLinks{Statuses: v}
+	if got := s.GetStatuses(); got != v {
+		t.Errorf("GetStatuses() didn't return the field")
+	}
+	s = nil
+	if got := s.GetStatuses(); got != nil {
+		t.Errorf("GetStatuses() was %v when receiver was nil", got)
+	}
+}
+
+func TestPushEvent_GetAfter(t *testing.T) {
+	v := "x"
+	s := &PushEvent{After: &v}
+	if got := s.GetAfter(); got != v {
+		t.Errorf("GetAfter() was %v (should have been %v)", got, v)
+	}
+	s = &PushEvent{}
+	if got := s.GetAfter(); got != "" {
+		t.Errorf("GetAfter() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetAfter(); got != "" {
+		t.Errorf("GetAfter() was %v when receiver was nil", got)
+	}
+}
+
+func TestPushEvent_GetBaseRef(t *testing.T) {
+	v := "x"
+	s := &PushEvent{BaseRef: &v}
+	if got := s.GetBaseRef(); got != v {
+		t.Errorf("GetBaseRef() was %v (should have been %v)", got, v)
+	}
+	s = &PushEvent{}
+	if got := s.GetBaseRef(); got != "" {
+		t.Errorf("GetBaseRef() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetBaseRef(); got != "" {
+		t.Errorf("GetBaseRef() was %v when receiver was nil", got)
+	}
+}
+
+func TestPushEvent_GetBefore(t *testing.T) {
+	v := "x"
+	s := &PushEvent{Before: &v}
+	if got := s.GetBefore(); got != v {
+		t.Errorf("GetBefore() was %v (should have been %v)", got, v)
+	}
+	s = &PushEvent{}
+	if got := s.GetBefore(); got != "" {
+		t.Errorf("GetBefore() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetBefore(); got != "" {
+		t.Errorf("GetBefore() was %v when receiver was nil", got)
+	}
+}
+
+func TestPushEvent_GetCommits(t *testing.T) {
+	v := []Commit{}
+	s := &PushEvent{Commits: v}
+	if got := s.GetCommits(); got == nil {
+		t.Errorf("GetCommits() was nil when field was non-nil")
+	}
+	s = nil
+	if got := s.GetCommits(); got != nil {
+		t.Errorf("GetCommits() was %v when receiver was nil", got)
+	}
+}
+
+func TestPushEvent_GetCompare(t *testing.T) {
+	v := "x"
+	s := &PushEvent{Compare: &v}
+	if got := s.GetCompare(); got != v {
+		t.Errorf("GetCompare() was %v (should have been %v)", got, v)
+	}
+	s = &PushEvent{}
+	if got := s.GetCompare(); got != "" {
+		t.Errorf("GetCompare() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetCompare(); got != "" {
+		t.Errorf("GetCompare() was %v when receiver was nil", got)
+	}
+}
+
+func TestPushEvent_GetCreated(t *testing.T) {
+	v := true
+	s := &PushEvent{Created: &v}
+	if got := s.GetCreated(); got != v {
+		t.Errorf("GetCreated() was %v (should have been %v)", got, v)
+	}
+	s = &PushEvent{}
+	if got := s.GetCreated(); got != false {
+		t.Errorf("GetCreated() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetCreated(); got != false {
+		t.Errorf("GetCreated() was %v when receiver was nil", got)
+	}
+}
+
+func TestPushEvent_GetDeleted(t *testing.T) {
+	v := true
+	s := &PushEvent{Deleted: &v}
+	if got := s.GetDeleted(); got != v {
+		t.Errorf("GetDeleted() was %v (should have been %v)", got, v)
+	}
+	s = &PushEvent{}
+	if got := s.GetDeleted(); got != false {
+		t.Errorf("GetDeleted() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetDeleted(); got != false {
+		t.Errorf("GetDeleted() was %v when receiver was nil", got)
+	}
+}
+
+func TestPushEvent_GetForced(t *testing.T) {
+	v := true
+	s := &PushEvent{Forced: &v}
+	if got := s.GetForced(); got != v {
+		t.Errorf("GetForced() was %v (should have been %v)", got, v)
+	}
+	s = &PushEvent{}
+	if got := s.GetForced(); got != false {
+		t.Errorf("GetForced() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetForced(); got != false {
+		t.Errorf("GetForced() was %v when receiver was nil", got)
+	}
+}
+
+func TestPushEvent_GetHeadCommit(t *testing.T) {
+	v := &Commit{}
+	s := &PushEvent{HeadCommit: v}
+	if got := s.GetHeadCommit(); got != v {
+		t.Errorf("GetHeadCommit() didn't return the field")
+	}
+	s = nil
+	if got := s.GetHeadCommit(); got != nil {
+		t.Errorf("GetHeadCommit() was %v when receiver was nil", got)
+	}
+}
+
+func TestPushEvent_GetInstallation(t *testing.T) {
+	v := &Installation{}
+	s := &PushEvent{Installation: v}
+	if got := s.GetInstallation(); got != v {
+		t.Errorf("GetInstallation() didn't return the field")
+	}
+	s = nil
+	if got := s.GetInstallation(); got != nil {
+		t.Errorf("GetInstallation() was %v when receiver was nil", got)
+	}
+}
+
+func TestPushEvent_GetOrganization(t *testing.T) {
+	v := &Account{}
+	s := &PushEvent{Organization: v}
+	if got := s.GetOrganization(); got != v {
+		t.Errorf("GetOrganization() didn't return the field")
+	}
+	s = nil
+	if got := s.GetOrganization(); got != nil {
+		t.Errorf("GetOrganization() was %v when receiver was nil", got)
+	}
+}
+
+func TestPushEvent_GetPusher(t *testing.T) {
+	v := &EmailUser{}
+	s := &PushEvent{Pusher: v}
+	if got := s.GetPusher(); got != v {
+		t.Errorf("GetPusher() didn't return the field")
+	}
+	s = nil
+	if got := s.GetPusher(); got != nil {
+		t.Errorf("GetPusher() was %v when receiver was nil", got)
+	}
+}
+
+func TestPushEvent_GetRef(t *testing.T) {
+	v := "x"
+	s := &PushEvent{Ref: &v}
+	if got := s.GetRef(); got != v {
+		t.Errorf("GetRef() was %v (should have been %v)", got, v)
+	}
+	s = &PushEvent{}
+	if got := s.GetRef(); got != "" {
+		t.Errorf("GetRef() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetRef(); got != "" {
+		t.Errorf("GetRef() was %v when receiver was nil", got)
+	}
+}
+
+func TestPushEvent_GetRepository(t *testing.T) {
+	v := &Repository{}
+	s := &PushEvent{Repository: v}
+	if got := s.GetRepository(); got != v {
+		t.Errorf("GetRepository() didn't return the field")
+	}
+	s = nil
+	if got := s.GetRepository(); got != nil {
+		t.Errorf("GetRepository() was %v when receiver was nil", got)
+	}
+}
+
+func TestPushEvent_GetSender(t *testing.T) {
+	v := &Account{}
+	s := &PushEvent{Sender: v}
+	if got := s.GetSender(); got != v {
+		t.Errorf("GetSender() didn't return the field")
+	}
+	s = nil
+	if got := s.GetSender(); got != nil {
+		t.Errorf("GetSender() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetArchiveURL(t *testing.T) {
+	v := "x"
+	s := &Repository{ArchiveURL: &v}
+	if got := s.GetArchiveURL(); got != v {
+		t.Errorf("GetArchiveURL() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetArchiveURL(); got != "" {
+		t.Errorf("GetArchiveURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetArchiveURL(); got != "" {
+		t.Errorf("GetArchiveURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetArchived(t *testing.T) {
+	v := true
+	s := &Repository{Archived: &v}
+	if got := s.GetArchived(); got != v {
+		t.Errorf("GetArchived() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetArchived(); got != false {
+		t.Errorf("GetArchived() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetArchived(); got != false {
+		t.Errorf("GetArchived() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetAssigneesURL(t *testing.T) {
+	v := "x"
+	s := &Repository{AssigneesURL: &v}
+	if got := s.GetAssigneesURL(); got != v {
+		t.Errorf("GetAssigneesURL() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetAssigneesURL(); got != "" {
+		t.Errorf("GetAssigneesURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetAssigneesURL(); got != "" {
+		t.Errorf("GetAssigneesURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetBlobsURL(t *testing.T) {
+	v := "x"
+	s := &Repository{BlobsURL: &v}
+	if got := s.GetBlobsURL(); got != v {
+		t.Errorf("GetBlobsURL() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetBlobsURL(); got != "" {
+		t.Errorf("GetBlobsURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetBlobsURL(); got != "" {
+		t.Errorf("GetBlobsURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetBranchesURL(t *testing.T) {
+	v := "x"
+	s := &Repository{BranchesURL: &v}
+	if got := s.GetBranchesURL(); got != v {
+		t.Errorf("GetBranchesURL() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetBranchesURL(); got != "" {
+		t.Errorf("GetBranchesURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetBranchesURL(); got != "" {
+		t.Errorf("GetBranchesURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetCloneURL(t *testing.T) {
+	v := "x"
+	s := &Repository{CloneURL: &v}
+	if got := s.GetCloneURL(); got != v {
+		t.Errorf("GetCloneURL() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetCloneURL(); got != "" {
+		t.Errorf("GetCloneURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetCloneURL(); got != "" {
+		t.Errorf("GetCloneURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetCollaboratorsURL(t *testing.T) {
+	v := "x"
+	s := &Repository{CollaboratorsURL: &v}
+	if got := s.GetCollaboratorsURL(); got != v {
+		t.Errorf("GetCollaboratorsURL() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetCollaboratorsURL(); got != "" {
+		t.Errorf("GetCollaboratorsURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetCollaboratorsURL(); got != "" {
+		t.Errorf("GetCollaboratorsURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetCommentsURL(t *testing.T) {
+	v := "x"
+	s := &Repository{CommentsURL: &v}
+	if got := s.GetCommentsURL(); got != v {
+		t.Errorf("GetCommentsURL() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetCommentsURL(); got != "" {
+		t.Errorf("GetCommentsURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetCommentsURL(); got != "" {
+		t.Errorf("GetCommentsURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetCommitsURL(t *testing.T) {
+	v := "x"
+	s := &Repository{CommitsURL: &v}
+	if got := s.GetCommitsURL(); got != v {
+		t.Errorf("GetCommitsURL() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetCommitsURL(); got != "" {
+		t.Errorf("GetCommitsURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetCommitsURL(); got != "" {
+		t.Errorf("GetCommitsURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetCompareURL(t *testing.T) {
+	v := "x"
+	s := &Repository{CompareURL: &v}
+	if got := s.GetCompareURL(); got != v {
+		t.Errorf("GetCompareURL() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetCompareURL(); got != "" {
+		t.Errorf("GetCompareURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetCompareURL(); got != "" {
+		t.Errorf("GetCompareURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetContentsURL(t *testing.T) {
+	v := "x"
+	s := &Repository{ContentsURL: &v}
+	if got := s.GetContentsURL(); got != v {
+		t.Errorf("GetContentsURL() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetContentsURL(); got != "" {
+		t.Errorf("GetContentsURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetContentsURL(); got != "" {
+		t.Errorf("GetContentsURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetContributorsURL(t *testing.T) {
+	v := "x"
+	s := &Repository{ContributorsURL: &v}
+	if got := s.GetContributorsURL(); got != v {
+		t.Errorf("GetContributorsURL() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetContributorsURL(); got != "" {
+		t.Errorf("GetContributorsURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetContributorsURL(); got != "" {
+		t.Errorf("GetContributorsURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetCreatedAt(t *testing.T) {
+	v := time.Unix(1557933565, 0)
+	s := &Repository{CreatedAt: &TimeWrapper{t: v}}
+	if got := s.GetCreatedAt(); !got.Equal(v) {
+		t.Errorf("GetCreatedAt() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetCreatedAt(); !got.IsZero() {
+		t.Errorf("GetCreatedAt() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetCreatedAt(); !got.IsZero() {
+		t.Errorf("GetCreatedAt() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetDefaultBranch(t *testing.T) {
+	v := "x"
+	s := &Repository{DefaultBranch: &v}
+	if got := s.GetDefaultBranch(); got != v {
+		t.Errorf("GetDefaultBranch() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetDefaultBranch(); got != "" {
+		t.Errorf("GetDefaultBranch() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetDefaultBranch(); got != "" {
+		t.Errorf("GetDefaultBranch() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetDeploymentsURL(t *testing.T) {
+	v := "x"
+	s := &Repository{DeploymentsURL: &v}
+	if got := s.GetDeploymentsURL(); got != v {
+		t.Errorf("GetDeploymentsURL() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetDeploymentsURL(); got != "" {
+		t.Errorf("GetDeploymentsURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetDeploymentsURL(); got != "" {
+		t.Errorf("GetDeploymentsURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetDescription(t *testing.T) {
+	v := "x"
+	s := &Repository{Description: &v}
+	if got := s.GetDescription(); got != v {
+		t.Errorf("GetDescription() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetDescription(); got != "" {
+		t.Errorf("GetDescription() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetDescription(); got != "" {
+		t.Errorf("GetDescription() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetDisabled(t *testing.T) {
+	v := true
+	s := &Repository{Disabled: &v}
+	if got := s.GetDisabled(); got != v {
+		t.Errorf("GetDisabled() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetDisabled(); got != false {
+		t.Errorf("GetDisabled() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetDisabled(); got != false {
+		t.Errorf("GetDisabled() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetDownloadsURL(t *testing.T) {
+	v := "x"
+	s := &Repository{DownloadsURL: &v}
+	if got := s.GetDownloadsURL(); got != v {
+		t.Errorf("GetDownloadsURL() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetDownloadsURL(); got != "" {
+		t.Errorf("GetDownloadsURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetDownloadsURL(); got != "" {
+		t.Errorf("GetDownloadsURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetEventsURL(t *testing.T) {
+	v := "x"
+	s := &Repository{EventsURL: &v}
+	if got := s.GetEventsURL(); got != v {
+		t.Errorf("GetEventsURL() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetEventsURL(); got != "" {
+		t.Errorf("GetEventsURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetEventsURL(); got != "" {
+		t.Errorf("GetEventsURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetFork(t *testing.T) {
+	v := true
+	s := &Repository{Fork: &v}
+	if got := s.GetFork(); got != v {
+		t.Errorf("GetFork() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetFork(); got != false {
+		t.Errorf("GetFork() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetFork(); got != false {
+		t.Errorf("GetFork() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetForks(t *testing.T) {
+	v := 1
+	s := &Repository{Forks: &v}
+	if got := s.GetForks(); got != v {
+		t.Errorf("GetForks() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetForks(); got != 0 {
+		t.Errorf("GetForks() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetForks(); got != 0 {
+		t.Errorf("GetForks() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetForksCount(t *testing.T) {
+	v := 1
+	s := &Repository{ForksCount: &v}
+	if got := s.GetForksCount(); got != v {
+		t.Errorf("GetForksCount() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetForksCount(); got != 0 {
+		t.Errorf("GetForksCount() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetForksCount(); got != 0 {
+		t.Errorf("GetForksCount() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetForksURL(t *testing.T) {
+	v := "x"
+	s := &Repository{ForksURL: &v}
+	if got := s.GetForksURL(); got != v {
+		t.Errorf("GetForksURL() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetForksURL(); got != "" {
+		t.Errorf("GetForksURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetForksURL(); got != "" {
+		t.Errorf("GetForksURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetFullName(t *testing.T) {
+	v := "x"
+	s := &Repository{FullName: &v}
+	if got := s.GetFullName(); got != v {
+		t.Errorf("GetFullName() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetFullName(); got != "" {
+		t.Errorf("GetFullName() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetFullName(); got != "" {
+		t.Errorf("GetFullName() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetGitCommitsURL(t *testing.T) {
+	v := "x"
+	s := &Repository{GitCommitsURL: &v}
+	if got := s.GetGitCommitsURL(); got != v {
+		t.Errorf("GetGitCommitsURL() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetGitCommitsURL(); got != "" {
+		t.Errorf("GetGitCommitsURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetGitCommitsURL(); got != "" {
+		t.Errorf("GetGitCommitsURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetGitRefsURL(t *testing.T) {
+	v := "x"
+	s := &Repository{GitRefsURL: &v}
+	if got := s.GetGitRefsURL(); got != v {
+		t.Errorf("GetGitRefsURL() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetGitRefsURL(); got != "" {
+		t.Errorf("GetGitRefsURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetGitRefsURL(); got != "" {
+		t.Errorf("GetGitRefsURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetGitTagsURL(t *testing.T) {
+	v := "x"
+	s := &Repository{GitTagsURL: &v}
+	if got := s.GetGitTagsURL(); got != v {
+		t.Errorf("GetGitTagsURL() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetGitTagsURL(); got != "" {
+		t.Errorf("GetGitTagsURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetGitTagsURL(); got != "" {
+		t.Errorf("GetGitTagsURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetGitURL(t *testing.T) {
+	v := "x"
+	s := &Repository{GitURL: &v}
+	if got := s.GetGitURL(); got != v {
+		t.Errorf("GetGitURL() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetGitURL(); got != "" {
+		t.Errorf("GetGitURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetGitURL(); got != "" {
+		t.Errorf("GetGitURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetHTMLURL(t *testing.T) {
+	v := "x"
+	s := &Repository{HTMLURL: &v}
+	if got := s.GetHTMLURL(); got != v {
+		t.Errorf("GetHTMLURL() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetHTMLURL(); got != "" {
+		t.Errorf("GetHTMLURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetHTMLURL(); got != "" {
+		t.Errorf("GetHTMLURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetHasDownloads(t *testing.T) {
+	v := true
+	s := &Repository{HasDownloads: &v}
+	if got := s.GetHasDownloads(); got != v {
+		t.Errorf("GetHasDownloads() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetHasDownloads(); got != false {
+		t.Errorf("GetHasDownloads() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetHasDownloads(); got != false {
+		t.Errorf("GetHasDownloads() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetHasIssues(t *testing.T) {
+	v := true
+	s := &Repository{HasIssues: &v}
+	if got := s.GetHasIssues(); got != v {
+		t.Errorf("GetHasIssues() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetHasIssues(); got != false {
+		t.Errorf("GetHasIssues() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetHasIssues(); got != false {
+		t.Errorf("GetHasIssues() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetHasPages(t *testing.T) {
+	v := true
+	s := &Repository{HasPages: &v}
+	if got := s.GetHasPages(); got != v {
+		t.Errorf("GetHasPages() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetHasPages(); got != false {
+		t.Errorf("GetHasPages() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetHasPages(); got != false {
+		t.Errorf("GetHasPages() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetHasProjects(t *testing.T) {
+	v := true
+	s := &Repository{HasProjects: &v}
+	if got := s.GetHasProjects(); got != v {
+		t.Errorf("GetHasProjects() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetHasProjects(); got != false {
+		t.Errorf("GetHasProjects() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetHasProjects(); got != false {
+		t.Errorf("GetHasProjects() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetHasWiki(t *testing.T) {
+	v := true
+	s := &Repository{HasWiki: &v}
+	if got := s.GetHasWiki(); got != v {
+		t.Errorf("GetHasWiki() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetHasWiki(); got != false {
+		t.Errorf("GetHasWiki() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetHasWiki(); got != false {
+		t.Errorf("GetHasWiki() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetHomepage(t *testing.T) {
+	v := "x"
+	s := &Repository{Homepage: &v}
+	if got := s.GetHomepage(); got != v {
+		t.Errorf("GetHomepage() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetHomepage(); got != "" {
+		t.Errorf("GetHomepage() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetHomepage(); got != "" {
+		t.Errorf("GetHomepage() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetHooksURL(t *testing.T) {
+	v := "x"
+	s := &Repository{HooksURL: &v}
+	if got := s.GetHooksURL(); got != v {
+		t.Errorf("GetHooksURL() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetHooksURL(); got != "" {
+		t.Errorf("GetHooksURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetHooksURL(); got != "" {
+		t.Errorf("GetHooksURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetID(t *testing.T) {
+	v := 1
+	s := &Repository{ID: &v}
+	if got := s.GetID(); got != v {
+		t.Errorf("GetID() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetID(); got != 0 {
+		t.Errorf("GetID() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetID(); got != 0 {
+		t.Errorf("GetID() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetIssueCommentsURL(t *testing.T) {
+	v := "x"
+	s := &Repository{IssueCommentsURL: &v}
+	if got := s.GetIssueCommentsURL(); got != v {
+		t.Errorf("GetIssueCommentsURL() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetIssueCommentsURL(); got != "" {
+		t.Errorf("GetIssueCommentsURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetIssueCommentsURL(); got != "" {
+		t.Errorf("GetIssueCommentsURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetIssueEventsURL(t *testing.T) {
+	v := "x"
+	s := &Repository{IssueEventsURL: &v}
+	if got := s.GetIssueEventsURL(); got != v {
+		t.Errorf("GetIssueEventsURL() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetIssueEventsURL(); got != "" {
+		t.Errorf("GetIssueEventsURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetIssueEventsURL(); got != "" {
+		t.Errorf("GetIssueEventsURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetIssuesURL(t *testing.T) {
+	v := "x"
+	s := &Repository{IssuesURL: &v}
+	if got := s.GetIssuesURL(); got != v {
+		t.Errorf("GetIssuesURL() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetIssuesURL(); got != "" {
+		t.Errorf("GetIssuesURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetIssuesURL(); got != "" {
+		t.Errorf("GetIssuesURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetKeysURL(t *testing.T) {
+	v := "x"
+	s := &Repository{KeysURL: &v}
+	if got := s.GetKeysURL(); got != v {
+		t.Errorf("GetKeysURL() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetKeysURL(); got != "" {
+		t.Errorf("GetKeysURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetKeysURL(); got != "" {
+		t.Errorf("GetKeysURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetLabelsURL(t *testing.T) {
+	v := "x"
+	s := &Repository{LabelsURL: &v}
+	if got := s.GetLabelsURL(); got != v {
+		t.Errorf("GetLabelsURL() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetLabelsURL(); got != "" {
+		t.Errorf("GetLabelsURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetLabelsURL(); got != "" {
+		t.Errorf("GetLabelsURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetLanguages(t *testing.T) {
+	v := "x"
+	s := &Repository{Languages: &v}
+	if got := s.GetLanguages(); got != v {
+		t.Errorf("GetLanguages() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetLanguages(); got != "" {
+		t.Errorf("GetLanguages() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetLanguages(); got != "" {
+		t.Errorf("GetLanguages() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetLanguagesURL(t *testing.T) {
+	v := "x"
+	s := &Repository{LanguagesURL: &v}
+	if got := s.GetLanguagesURL(); got != v {
+		t.Errorf("GetLanguagesURL() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetLanguagesURL(); got != "" {
+		t.Errorf("GetLanguagesURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetLanguagesURL(); got != "" {
+		t.Errorf("GetLanguagesURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetLicense(t *testing.T) {
+	v := &License{}
+	s := &Repository{License: v}
+	if got := s.GetLicense(); got != v {
+		t.Errorf("GetLicense() didn't return the field")
+	}
+	s = nil
+	if got := s.GetLicense(); got != nil {
+		t.Errorf("GetLicense() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetMasterBranch(t *testing.T) {
+	v := "x"
+	s := &Repository{MasterBranch: &v}
+	if got := s.GetMasterBranch(); got != v {
+		t.Errorf("GetMasterBranch() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetMasterBranch(); got != "" {
+		t.Errorf("GetMasterBranch() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetMasterBranch(); got != "" {
+		t.Errorf("GetMasterBranch() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetMergesURL(t *testing.T) {
+	v := "x"
+	s := &Repository{MergesURL: &v}
+	if got := s.GetMergesURL(); got != v {
+		t.Errorf("GetMergesURL() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetMergesURL(); got != "" {
+		t.Errorf("GetMergesURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetMergesURL(); got != "" {
+		t.Errorf("GetMergesURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetMilestonesURL(t *testing.T) {
+	v := "x"
+	s := &Repository{MilestonesURL: &v}
+	if got := s.GetMilestonesURL(); got != v {
+		t.Errorf("GetMilestonesURL() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetMilestonesURL(); got != "" {
+		t.Errorf("GetMilestonesURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetMilestonesURL(); got != "" {
+		t.Errorf("GetMilestonesURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetMirrorURL(t *testing.T) {
+	v := "x"
+	s := &Repository{MirrorURL: &v}
+	if got := s.GetMirrorURL(); got != v {
+		t.Errorf("GetMirrorURL() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetMirrorURL(); got != "" {
+		t.Errorf("GetMirrorURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetMirrorURL(); got != "" {
+		t.Errorf("GetMirrorURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetName(t *testing.T) {
+	v := "x"
+	s := &Repository{Name: &v}
+	if got := s.GetName(); got != v {
+		t.Errorf("GetName() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetName(); got != "" {
+		t.Errorf("GetName() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetName(); got != "" {
+		t.Errorf("GetName() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetNodeID(t *testing.T) {
+	v := "x"
+	s := &Repository{NodeID: &v}
+	if got := s.GetNodeID(); got != v {
+		t.Errorf("GetNodeID() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetNodeID(); got != "" {
+		t.Errorf("GetNodeID() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetNodeID(); got != "" {
+		t.Errorf("GetNodeID() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetNotificationURL(t *testing.T) {
+	v := "x"
+	s := &Repository{NotificationURL: &v}
+	if got := s.GetNotificationURL(); got != v {
+		t.Errorf("GetNotificationURL() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetNotificationURL(); got != "" {
+		t.Errorf("GetNotificationURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetNotificationURL(); got != "" {
+		t.Errorf("GetNotificationURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetOpenIssues(t *testing.T) {
+	v := 1
+	s := &Repository{OpenIssues: &v}
+	if got := s.GetOpenIssues(); got != v {
+		t.Errorf("GetOpenIssues() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetOpenIssues(); got != 0 {
+		t.Errorf("GetOpenIssues() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetOpenIssues(); got != 0 {
+		t.Errorf("GetOpenIssues() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetOpenIssuesCount(t *testing.T) {
+	v := 1
+	s := &Repository{OpenIssuesCount: &v}
+	if got := s.GetOpenIssuesCount(); got != v {
+		t.Errorf("GetOpenIssuesCount() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetOpenIssuesCount(); got != 0 {
+		t.Errorf("GetOpenIssuesCount() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetOpenIssuesCount(); got != 0 {
+		t.Errorf("GetOpenIssuesCount() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetOwner(t *testing.T) {
+	v := &Account{}
+	s := &Repository{Owner: v}
+	if got := s.GetOwner(); got != v {
+		t.Errorf("GetOwner() didn't return the field")
+	}
+	s = nil
+	if got := s.GetOwner(); got != nil {
+		t.Errorf("GetOwner() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetPrivate(t *testing.T) {
+	v := true
+	s := &Repository{Private: &v}
+	if got := s.GetPrivate(); got != v {
+		t.Errorf("GetPrivate() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetPrivate(); got != false {
+		t.Errorf("GetPrivate() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetPrivate(); got != false {
+		t.Errorf("GetPrivate() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetPullsURL(t *testing.T) {
+	v := "x"
+	s := &Repository{PullsURL: &v}
+	if got := s.GetPullsURL(); got != v {
+		t.Errorf("GetPullsURL() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetPullsURL(); got != "" {
+		t.Errorf("GetPullsURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetPullsURL(); got != "" {
+		t.Errorf("GetPullsURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetPushedAt(t *testing.T) {
+	v := time.Unix(1557933565, 0)
+	s := &Repository{PushedAt: &TimeWrapper{t: v}}
+	if got := s.GetPushedAt(); !got.Equal(v) {
+		t.Errorf("GetPushedAt() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetPushedAt(); !got.IsZero() {
+		t.Errorf("GetPushedAt() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetPushedAt(); !got.IsZero() {
+		t.Errorf("GetPushedAt() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetReleasesURL(t *testing.T) {
+	v := "x"
+	s := &Repository{ReleasesURL: &v}
+	if got := s.GetReleasesURL(); got != v {
+		t.Errorf("GetReleasesURL() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetReleasesURL(); got != "" {
+		t.Errorf("GetReleasesURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetReleasesURL(); got != "" {
+		t.Errorf("GetReleasesURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetSSHURL(t *testing.T) {
+	v := "x"
+	s := &Repository{SSHURL: &v}
+	if got := s.GetSSHURL(); got != v {
+		t.Errorf("GetSSHURL() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetSSHURL(); got != "" {
+		t.Errorf("GetSSHURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetSSHURL(); got != "" {
+		t.Errorf("GetSSHURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetSize(t *testing.T) {
+	v := 1
+	s := &Repository{Size: &v}
+	if got := s.GetSize(); got != v {
+		t.Errorf("GetSize() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetSize(); got != 0 {
+		t.Errorf("GetSize() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetSize(); got != 0 {
+		t.Errorf("GetSize() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetStargazers(t *testing.T) {
+	v := 1
+	s := &Repository{Stargazers: &v}
+	if got := s.GetStargazers(); got != v {
+		t.Errorf("GetStargazers() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetStargazers(); got != 0 {
+		t.Errorf("GetStargazers() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetStargazers(); got != 0 {
+		t.Errorf("GetStargazers() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetStargazersCount(t *testing.T) {
+	v := 1
+	s := &Repository{StargazersCount: &v}
+	if got := s.GetStargazersCount(); got != v {
+		t.Errorf("GetStargazersCount() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetStargazersCount(); got != 0 {
+		t.Errorf("GetStargazersCount() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetStargazersCount(); got != 0 {
+		t.Errorf("GetStargazersCount() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetStargazersURL(t *testing.T) {
+	v := "x"
+	s := &Repository{StargazersURL: &v}
+	if got := s.GetStargazersURL(); got != v {
+		t.Errorf("GetStargazersURL() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetStargazersURL(); got != "" {
+		t.Errorf("GetStargazersURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetStargazersURL(); got != "" {
+		t.Errorf("GetStargazersURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetStatusesURL(t *testing.T) {
+	v := "x"
+	s := &Repository{StatusesURL: &v}
+	if got := s.GetStatusesURL(); got != v {
+		t.Errorf("GetStatusesURL() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetStatusesURL(); got != "" {
+		t.Errorf("GetStatusesURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetStatusesURL(); got != "" {
+		t.Errorf("GetStatusesURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetSubscribersURL(t *testing.T) {
+	v := "x"
+	s := &Repository{SubscribersURL: &v}
+	if got := s.GetSubscribersURL(); got != v {
+		t.Errorf("GetSubscribersURL() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetSubscribersURL(); got != "" {
+		t.Errorf("GetSubscribersURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetSubscribersURL(); got != "" {
+		t.Errorf("GetSubscribersURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetSubscriptionURL(t *testing.T) {
+	v := "x"
+	s := &Repository{SubscriptionURL: &v}
+	if got := s.GetSubscriptionURL(); got != v {
+		t.Errorf("GetSubscriptionURL() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetSubscriptionURL(); got != "" {
+		t.Errorf("GetSubscriptionURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetSubscriptionURL(); got != "" {
+		t.Errorf("GetSubscriptionURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetSvnURL(t *testing.T) {
+	v := "x"
+	s := &Repository{SvnURL: &v}
+	if got := s.GetSvnURL(); got != v {
+		t.Errorf("GetSvnURL() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetSvnURL(); got != "" {
+		t.Errorf("GetSvnURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetSvnURL(); got != "" {
+		t.Errorf("GetSvnURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetTagsURL(t *testing.T) {
+	v := "x"
+	s := &Repository{TagsURL: &v}
+	if got := s.GetTagsURL(); got != v {
+		t.Errorf("GetTagsURL() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetTagsURL(); got != "" {
+		t.Errorf("GetTagsURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetTagsURL(); got != "" {
+		t.Errorf("GetTagsURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetTeamsURL(t *testing.T) {
+	v := "x"
+	s := &Repository{TeamsURL: &v}
+	if got := s.GetTeamsURL(); got != v {
+		t.Errorf("GetTeamsURL() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetTeamsURL(); got != "" {
+		t.Errorf("GetTeamsURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetTeamsURL(); got != "" {
+		t.Errorf("GetTeamsURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetTreesURL(t *testing.T) {
+	v := "x"
+	s := &Repository{TreesURL: &v}
+	if got := s.GetTreesURL(); got != v {
+		t.Errorf("GetTreesURL() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetTreesURL(); got != "" {
+		t.Errorf("GetTreesURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetTreesURL(); got != "" {
+		t.Errorf("GetTreesURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetURL(t *testing.T) {
+	v := "x"
+	s := &Repository{URL: &v}
+	if got := s.GetURL(); got != v {
+		t.Errorf("GetURL() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetURL(); got != "" {
+		t.Errorf("GetURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetURL(); got != "" {
+		t.Errorf("GetURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetUpdatedAt(t *testing.T) {
+	v := time.Unix(1557933565, 0)
+	s := &Repository{UpdatedAt: &TimeWrapper{t: v}}
+	if got := s.GetUpdatedAt(); !got.Equal(v) {
+		t.Errorf("GetUpdatedAt() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetUpdatedAt(); !got.IsZero() {
+		t.Errorf("GetUpdatedAt() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetUpdatedAt(); !got.IsZero() {
+		t.Errorf("GetUpdatedAt() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetWatchers(t *testing.T) {
+	v := 1
+	s := &Repository{Watchers: &v}
+	if got := s.GetWatchers(); got != v {
+		t.Errorf("GetWatchers() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetWatchers(); got != 0 {
+		t.Errorf("GetWatchers() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetWatchers(); got != 0 {
+		t.Errorf("GetWatchers() was %v when receiver was nil", got)
+	}
+}
+
+func TestRepository_GetWatchersCount(t *testing.T) {
+	v := 1
+	s := &Repository{WatchersCount: &v}
+	if got := s.GetWatchersCount(); got != v {
+		t.Errorf("GetWatchersCount() was %v (should have been %v)", got, v)
+	}
+	s = &Repository{}
+	if got := s.GetWatchersCount(); got != 0 {
+		t.Errorf("GetWatchersCount() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetWatchersCount(); got != 0 {
+		t.Errorf("GetWatchersCount() was %v when receiver was nil", got)
+	}
+}
+
+func TestTeam_GetDescription(t *testing.T) {
+	v := "x"
+	s := &Team{Description: &v}
+	if got := s.GetDescription(); got != v {
+		t.Errorf("GetDescription() was %v (should have been %v)", got, v)
+	}
+	s = &Team{}
+	if got := s.GetDescription(); got != "" {
+		t.Errorf("GetDescription() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetDescription(); got != "" {
+		t.Errorf("GetDescription() was %v when receiver was nil", got)
+	}
+}
+
+func TestTeam_GetHTMLURL(t *testing.T) {
+	v := "x"
+	s := &Team{HTMLURL: &v}
+	if got := s.GetHTMLURL(); got != v {
+		t.Errorf("GetHTMLURL() was %v (should have been %v)", got, v)
+	}
+	s = &Team{}
+	if got := s.GetHTMLURL(); got != "" {
+		t.Errorf("GetHTMLURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetHTMLURL(); got != "" {
+		t.Errorf("GetHTMLURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestTeam_GetID(t *testing.T) {
+	v := 1
+	s := &Team{ID: &v}
+	if got := s.GetID(); got != v {
+		t.Errorf("GetID() was %v (should have been %v)", got, v)
+	}
+	s = &Team{}
+	if got := s.GetID(); got != 0 {
+		t.Errorf("GetID() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetID(); got != 0 {
+		t.Errorf("GetID() was %v when receiver was nil", got)
+	}
+}
+
+func TestTeam_GetMembersURL(t *testing.T) {
+	v := "x"
+	s := &Team{MembersURL: &v}
+	if got := s.GetMembersURL(); got != v {
+		t.Errorf("GetMembersURL() was %v (should have been %v)", got, v)
+	}
+	s = &Team{}
+	if got := s.GetMembersURL(); got != "" {
+		t.Errorf("GetMembersURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetMembersURL(); got != "" {
+		t.Errorf("GetMembersURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestTeam_GetName(t *testing.T) {
+	v := "x"
+	s := &Team{Name: &v}
+	if got := s.GetName(); got != v {
+		t.Errorf("GetName() was %v (should have been %v)", got, v)
+	}
+	s = &Team{}
+	if got := s.GetName(); got != "" {
+		t.Errorf("GetName() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetName(); got != "" {
+		t.Errorf("GetName() was %v when receiver was nil", got)
+	}
+}
+
+func TestTeam_GetNodeID(t *testing.T) {
+	v := "x"
+	s := &Team{NodeID: &v}
+	if got := s.GetNodeID(); got != v {
+		t.Errorf("GetNodeID() was %v (should have been %v)", got, v)
+	}
+	s = &Team{}
+	if got := s.GetNodeID(); got != "" {
+		t.Errorf("GetNodeID() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetNodeID(); got != "" {
+		t.Errorf("GetNodeID() was %v when receiver was nil", got)
+	}
+}
+
+func TestTeam_GetPermission(t *testing.T) {
+	v := "x"
+	s := &Team{Permission: &v}
+	if got := s.GetPermission(); got != v {
+		t.Errorf("GetPermission() was %v (should have been %v)", got, v)
+	}
+	s = &Team{}
+	if got := s.GetPermission(); got != "" {
+		t.Errorf("GetPermission() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetPermission(); got != "" {
+		t.Errorf("GetPermission() was %v when receiver was nil", got)
+	}
+}
+
+func TestTeam_GetPrivacy(t *testing.T) {
+	v := "x"
+	s := &Team{Privacy: &v}
+	if got := s.GetPrivacy(); got != v {
+		t.Errorf("GetPrivacy() was %v (should have been %v)", got, v)
+	}
+	s = &Team{}
+	if got := s.GetPrivacy(); got != "" {
+		t.Errorf("GetPrivacy() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetPrivacy(); got != "" {
+		t.Errorf("GetPrivacy() was %v when receiver was nil", got)
+	}
+}
+
+func TestTeam_GetRepositoriesURL(t *testing.T) {
+	v := "x"
+	s := &Team{RepositoriesURL: &v}
+	if got := s.GetRepositoriesURL(); got != v {
+		t.Errorf("GetRepositoriesURL() was %v (should have been %v)", got, v)
+	}
+	s = &Team{}
+	if got := s.GetRepositoriesURL(); got != "" {
+		t.Errorf("GetRepositoriesURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetRepositoriesURL(); got != "" {
+		t.Errorf("GetRepositoriesURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestTeam_GetSlug(t *testing.T) {
+	v := "x"
+	s := &Team{Slug: &v}
+	if got := s.GetSlug(); got != v {
+		t.Errorf("GetSlug() was %v (should have been %v)", got, v)
+	}
+	s = &Team{}
+	if got := s.GetSlug(); got != "" {
+		t.Errorf("GetSlug() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetSlug(); got != "" {
+		t.Errorf("GetSlug() was %v when receiver was nil", got)
+	}
+}
+
+func TestTeam_GetURL(t *testing.T) {
+	v := "x"
+	s := &Team{URL: &v}
+	if got := s.GetURL(); got != v {
+		t.Errorf("GetURL() was %v (should have been %v)", got, v)
+	}
+	s = &Team{}
+	if got := s.GetURL(); got != "" {
+		t.Errorf("GetURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetURL(); got != "" {
+		t.Errorf("GetURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestTreeObject_GetSHA(t *testing.T) {
+	v := "x"
+	s := &TreeObject{SHA: &v}
+	if got := s.GetSHA(); got != v {
+		t.Errorf("GetSHA() was %v (should have been %v)", got, v)
+	}
+	s = &TreeObject{}
+	if got := s.GetSHA(); got != "" {
+		t.Errorf("GetSHA() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetSHA(); got != "" {
+		t.Errorf("GetSHA() was %v when receiver was nil", got)
+	}
+}
+
+func TestTreeObject_GetURL(t *testing.T) {
+	v := "x"
+	s := &TreeObject{URL: &v}
+	if got := s.GetURL(); got != v {
+		t.Errorf("GetURL() was %v (should have been %v)", got, v)
+	}
+	s = &TreeObject{}
+	if got := s.GetURL(); got != "" {
+		t.Errorf("GetURL() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetURL(); got != "" {
+		t.Errorf("GetURL() was %v when receiver was nil", got)
+	}
+}
+
+func TestUserInstallationsResponse_GetInstallations(t *testing.T) {
+	v := []Installation{}
+	s := &UserInstallationsResponse{Installations: v}
+	if got := s.GetInstallations(); got == nil {
+		t.Errorf("GetInstallations() was nil when field was non-nil")
+	}
+	s = nil
+	if got := s.GetInstallations(); got != nil {
+		t.Errorf("GetInstallations() was %v when receiver was nil", got)
+	}
+}
+
+func TestUserInstallationsResponse_GetTotalCount(t *testing.T) {
+	s := &UserInstallationsResponse{TotalCount: 1}
+	if got := s.GetTotalCount(); got != 1 {
+		t.Errorf("GetTotalCount() was %v (should have been 1)", got)
+	}
+	s = nil
+	if got := s.GetTotalCount(); got != 0 {
+		t.Errorf("GetTotalCount() was %v when receiver was nil", got)
+	}
+}
+
+func TestVerificationObject_GetPayload(t *testing.T) {
+	v := "x"
+	s := &VerificationObject{Payload: &v}
+	if got := s.GetPayload(); got != v {
+		t.Errorf("GetPayload() was %v (should have been %v)", got, v)
+	}
+	s = &VerificationObject{}
+	if got := s.GetPayload(); got != "" {
+		t.Errorf("GetPayload() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetPayload(); got != "" {
+		t.Errorf("GetPayload() was %v when receiver was nil", got)
+	}
+}
+
+func TestVerificationObject_GetReason(t *testing.T) {
+	v := "x"
+	s := &VerificationObject{Reason: &v}
+	if got := s.GetReason(); got != v {
+		t.Errorf("GetReason() was %v (should have been %v)", got, v)
+	}
+	s = &VerificationObject{}
+	if got := s.GetReason(); got != "" {
+		t.Errorf("GetReason() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetReason(); got != "" {
+		t.Errorf("GetReason() was %v when receiver was nil", got)
+	}
+}
+
+func TestVerificationObject_GetSignature(t *testing.T) {
+	v := "x"
+	s := &VerificationObject{Signature: &v}
+	if got := s.GetSignature(); got != v {
+		t.Errorf("GetSignature() was %v (should have been %v)", got, v)
+	}
+	s = &VerificationObject{}
+	if got := s.GetSignature(); got != "" {
+		t.Errorf("GetSignature() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetSignature(); got != "" {
+		t.Errorf("GetSignature() was %v when receiver was nil", got)
+	}
+}
+
+func TestVerificationObject_GetVerified(t *testing.T) {
+	v := true
+	s := &VerificationObject{Verified: &v}
+	if got := s.GetVerified(); got != v {
+		t.Errorf("GetVerified() was %v (should have been %v)", got, v)
+	}
+	s = &VerificationObject{}
+	if got := s.GetVerified(); got != false {
+		t.Errorf("GetVerified() was %v when field was nil", got)
+	}
+	s = nil
+	if got := s.GetVerified(); got != false {
+		t.Errorf("GetVerified() was %v when receiver was nil", got)
+	}
+}
@@ -17,13 +17,21 @@ package ghevent
 //
 // - All struct fields are pointers, which means that a missing JSON field will turn into
 //   a nil pointer. This allows the user to detect which fields were present in the JSON
-//   and which weren't (i.e. nil fields were NOT present)
+//   and which weren't (i.e. nil fields were NOT present, or were null). Use Presence
+//   (see presence.go) to tell missing fields from null ones
 //
 // - When the structs are used to encode JSON data, empty fields will not be encoded -
 //   all struct fields have an "omitempty" in their struct tags
 //
+// - Every field has a nil-safe GetX() accessor (see ghevent-accessors.go) that returns
+//   the zero value if the field, or the struct itself, is nil. This means you can do
+//   event.GetRepository().GetOwner().GetLogin() without checking for nil pointers
+//
+
+//go:generate go run gen-accessors.go
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
@@ -55,7 +63,12 @@ func (tw *TimeWrapper) UnmarshalJSON(data []byte) error {
 	if epochSecs, err := strconv.Atoi(s); err != nil {
 		return errors.New(fmt.Sprintf("Failed to parse time \"%s\" as either seconds since Epoch, or RFC3339-style string", s))
 	} else {
-		tw.t = time.Unix(int64(epochSecs), 0)
+		t := time.Unix(int64(epochSecs), 0)
+		// We can't encode what isn't representable as RFC3339
+		if t.Year() < 0 || t.Year() > 9999 {
+			return errors.New(fmt.Sprintf("Time \"%s\" (seconds since Epoch) is out of range", s))
+		}
+		tw.t = t
 		return nil
 	}
 }
@@ -89,47 +102,80 @@ type PushEvent struct {
 }
 
 type ForkEvent struct {
-	Forkee     *Repository `json:"forkee,omitempty"`
-	Repository *Repository `json:"repository,omitempty"`
-	Sender     *Account    `json:"sender,omitempty"`
+	Forkee       *Repository   `json:"forkee,omitempty"`
+	Installation *Installation `json:"installation,omitempty"`
+	Organization *Account      `json:"organization,omitempty"`
+	Repository   *Repository   `json:"repository,omitempty"`
+	Sender       *Account      `json:"sender,omitempty"`
 }
 
 type IssueCommentEvent struct {
-	Action     *string       `json:"action,omitempty"`
-	Issue      *Issue        `json:"issue,omitempty"`
-	Comment    *IssueComment `json:"comment,omitempty"`
-	Repository *Repository   `json:"repository,omitempty"`
-	Sender     *Account      `json:"sender,omitempty"`
+	Action       *string       `json:"action,omitempty"`
+	Issue        *Issue        `json:"issue,omitempty"`
+	Comment      *IssueComment `json:"comment,omitempty"`
+	Changes      *Changes      `json:"changes,omitempty"`
+	Installation *Installation `json:"installation,omitempty"`
+	Organization *Account      `json:"organization,omitempty"`
+	Repository   *Repository   `json:"repository,omitempty"`
+	Sender       *Account      `json:"sender,omitempty"`
 }
 
 type IssuesEvent struct {
-	Action *string `json:"action,omitempty"`
-	Issue  *Issue  `json:"issue,omitempty"`
-	// Changes ??? object `json:"changes,omitempty"`
-	Repository *Repository `json:"repository,omitempty"`
-	Sender     *Account    `json:"sender,omitempty"`
+	Action       *string       `json:"action,omitempty"`
+	Issue        *Issue        `json:"issue,omitempty"`
+	Changes      *Changes      `json:"changes,omitempty"`
+	Label        *Label        `json:"label,omitempty"`     // "labeled" | "unlabeled"
+	Assignee     *Account      `json:"assignee,omitempty"`  // "assigned" | "unassigned"
+	Milestone    *Milestone    `json:"milestone,omitempty"` // "milestoned" | "demilestoned"
+	Installation *Installation `json:"installation,omitempty"`
+	Organization *Account      `json:"organization,omitempty"`
+	Repository   *Repository   `json:"repository,omitempty"`
+	Sender       *Account      `json:"sender,omitempty"`
 }
 
 type LabelEvent struct {
-	Action     *string     `json:"action,omitempty"`
-	Label      *Label      `json:"label,omitempty"`
-	Repository *Repository `json:"repository,omitempty"`
-	Sender     *Account    `json:"sender,omitempty"`
+	Action       *string       `json:"action,omitempty"`
+	Label        *Label        `json:"label,omitempty"`
+	Changes      *Changes      `json:"changes,omitempty"`
+	Installation *Installation `json:"installation,omitempty"`
+	Organization *Account      `json:"organization,omitempty"`
+	Repository   *Repository   `json:"repository,omitempty"`
+	Sender       *Account      `json:"sender,omitempty"`
+}
+
+// X-Github-Event: "milestone"
+type MilestoneEvent struct {
+	Action       *string       `json:"action,omitempty"` // "created" | "closed" | "opened" | "edited" | "deleted"
+	Milestone    *Milestone    `json:"milestone,omitempty"`
+	Changes      *Changes      `json:"changes,omitempty"`
+	Installation *Installation `json:"installation,omitempty"`
+	Organization *Account      `json:"organization,omitempty"`
+	Repository   *Repository   `json:"repository,omitempty"`
+	Sender       *Account      `json:"sender,omitempty"`
 }
 
 type PullRequestEvent struct {
-	Action      *string      `json:"action,omitempty"`
-	Number      *int         `json:"number,omitempty"`
-	PullRequest *PullRequest `json:"pull_request,omitempty"`
-	Repository  *Repository  `json:"repository,omitempty"`
-	Sender      *Account     `json:"sender,omitempty"`
+	Action            *string       `json:"action,omitempty"`
+	Number            *int          `json:"number,omitempty"`
+	PullRequest       *PullRequest  `json:"pull_request,omitempty"`
+	Changes           *Changes      `json:"changes,omitempty"`
+	Label             *Label        `json:"label,omitempty"`              // "labeled" | "unlabeled"
+	Assignee          *Account      `json:"assignee,omitempty"`           // "assigned" | "unassigned"
+	RequestedReviewer *Account      `json:"requested_reviewer,omitempty"` // "review_requested" | "review_request_removed"
+	Before            *string       `json:"before,omitempty"`             // "synchronize"
+	After             *string       `json:"after,omitempty"`              // "synchronize"
+	Installation      *Installation `json:"installation,omitempty"`
+	Organization      *Account      `json:"organization,omitempty"`
+	Repository        *Repository   `json:"repository,omitempty"`
+	Sender            *Account      `json:"sender,omitempty"`
 }
 
 // X-Github-Event: "installation"
 type InstallationEvent struct {
-	Action       *string       `json:"action,omitempty"` // "created" | "deleted"
+	Action       *string       `json:"action,omitempty"` // "created" | "deleted" | "suspend" | "unsuspend" | "new_permissions_accepted"
 	Installation *Installation `json:"installation,omitempty"`
 	Repositories []Repository  `json:"repositories,omitempty"`
+	Requester    *Account      `json:"requester,omitempty"`
 	Sender       *Account      `json:"sender,omitempty"`
 }
 
@@ -137,15 +183,48 @@ type InstallationEvent struct {
 type InstallationRepositoriesEvent struct {
 	Action              *string       `json:"action,omitempty"` // "added" | "removed"
 	Installation        *Installation `json:"installation,omitempty"`
+	RepositorySelection *string       `json:"repository_selection,omitempty"`
 	RepositoriesAdded   []Repository  `json:"repositories_added,omitempty"`
 	RepositoriesRemoved []Repository  `json:"repositories_removed,omitempty"`
+	Requester           *Account      `json:"requester,omitempty"`
 	Sender              *Account      `json:"sender,omitempty"`
 }
 
+// X-Github-Event: "ping". Sent when a webhook is created. For app webhooks, the
+// hook has app_id instead of a repository/organization
+type PingEvent struct {
+	Zen          *string     `json:"zen,omitempty"`
+	HookID       *int        `json:"hook_id,omitempty"`
+	Hook         *Hook       `json:"hook,omitempty"`
+	Organization *Account    `json:"organization,omitempty"`
+	Repository   *Repository `json:"repository,omitempty"`
+	Sender       *Account    `json:"sender,omitempty"`
+}
+
 //
 // Objects
 //
 
+// The "changes" object sent with "edited" actions. Only the attributes that were
+// actually changed are present, each holding the previous value in "from"
+type Changes struct {
+	Title       *ChangeFrom  `json:"title,omitempty"`
+	Body        *ChangeFrom  `json:"body,omitempty"`
+	Name        *ChangeFrom  `json:"name,omitempty"`
+	Color       *ChangeFrom  `json:"color,omitempty"`
+	Description *ChangeFrom  `json:"description,omitempty"`
+	Base        *BaseChanges `json:"base,omitempty"`
+}
+
+type ChangeFrom struct {
+	From *string `json:"from,omitempty"`
+}
+
+type BaseChanges struct {
+	Ref *ChangeFrom `json:"ref,omitempty"`
+	SHA *ChangeFrom `json:"sha,omitempty"`
+}
+
 type IssueComment struct {
 	URL               *string      `json:"url,omitempty"`
 	HTMLURL           *string      `json:"html_url,omitempty"`
@@ -157,34 +236,54 @@ type IssueComment struct {
 	UpdatedAt         *TimeWrapper `json:"updated_at,omitempty"`
 	AuthorAssociation *string      `json:"author_association,omitempty"`
 	Body              *string      `json:"body,omitempty"`
+	// Set when the comment was made by a Github App, on behalf of a user
+	PerformedViaGithubApp *App `json:"performed_via_github_app,omitempty"`
 }
 
 type Issue struct {
-	ID               *int              `json:"id,omitempty"`
-	NodeID           *string           `json:"node_id,omitempty"`
-	URL              *string           `json:"url,omitempty"`
-	RepositoryURL    *string           `json:"repository_url,omitempty"`
-	LabelsURL        *string           `json:"labels_url,omitempty"`
-	CommentsURL      *string           `json:"comments_url,omitempty"`
-	EventsURL        *string           `json:"events_url,omitempty"`
-	HTMLURL          *string           `json:"html_url,omitempty"`
-	Number           *int              `json:"number,omitempty"`
-	State            *string           `json:"state,omitempty"`
-	Title            *string           `json:"title,omitempty"`
-	Body             *string           `json:"body,omitempty"`
-	User             *Account          `json:"user,omitempty"`
-	Labels           []Label           `json:"labels,omitempty"`
-	Assignee         *Account          `json:"assignee,omitempty"`
-	Assignees        []Account         `json:"assignees,omitempty"`
-	Milestone        *Milestone        `json:"milestone,omitempty"`
-	Locked           *bool             `json:"locked,omitempty"`
-	ActiveLockReason *string           `json:"active_lock_reason,omitempty"`
-	Comments         *int              `json:"comments,omitempty"`
-	PullRequest      *IssuePullRequest `json:"pull_request,omitempty"`
-	ClosedAt         *TimeWrapper      `json:"closed_at,omitempty"`
-	CreatedAt        *TimeWrapper      `json:"created_at,omitempty"`
-	UpdatedAt        *TimeWrapper      `json:"updated_at,omitempty"`
-	ClosedBy         *Account          `json:"closed_by,omitempty"`
+	ID                *int              `json:"id,omitempty"`
+	NodeID            *string           `json:"node_id,omitempty"`
+	URL               *string           `json:"url,omitempty"`
+	RepositoryURL     *string           `json:"repository_url,omitempty"`
+	LabelsURL         *string           `json:"labels_url,omitempty"`
+	CommentsURL       *string           `json:"comments_url,omitempty"`
+	EventsURL         *string           `json:"events_url,omitempty"`
+	HTMLURL           *string           `json:"html_url,omitempty"`
+	Number            *int              `json:"number,omitempty"`
+	State             *string           `json:"state,omitempty"`
+	Title             *string           `json:"title,omitempty"`
+	Body              *string           `json:"body,omitempty"`
+	User              *Account          `json:"user,omitempty"`
+	Labels            []Label           `json:"labels,omitempty"`
+	Assignee          *Account          `json:"assignee,omitempty"`
+	Assignees         []Account         `json:"assignees,omitempty"`
+	Milestone         *Milestone        `json:"milestone,omitempty"`
+	Locked            *bool             `json:"locked,omitempty"`
+	ActiveLockReason  *string           `json:"active_lock_reason,omitempty"`
+	Comments          *int              `json:"comments,omitempty"`
+	PullRequest       *IssuePullRequest `json:"pull_request,omitempty"`
+	ClosedAt          *TimeWrapper      `json:"closed_at,omitempty"`
+	CreatedAt         *TimeWrapper      `json:"created_at,omitempty"`
+	UpdatedAt         *TimeWrapper      `json:"updated_at,omitempty"`
+	ClosedBy          *Account          `json:"closed_by,omitempty"`
+	AuthorAssociation *string           `json:"author_association,omitempty"`
+	// Set when the issue was opened by a Github App, on behalf of a user
+	PerformedViaGithubApp *App `json:"performed_via_github_app,omitempty"`
+}
+
+type App struct {
+	ID          *int              `json:"id,omitempty"`
+	Slug        *string           `json:"slug,omitempty"`
+	NodeID      *string           `json:"node_id,omitempty"`
+	Owner       *Account          `json:"owner,omitempty"`
+	Name        *string           `json:"name,omitempty"`
+	Description *string           `json:"description,omitempty"`
+	ExternalURL *string           `json:"external_url,omitempty"`
+	HTMLURL     *string           `json:"html_url,omitempty"`
+	CreatedAt   *TimeWrapper      `json:"created_at,omitempty"`
+	UpdatedAt   *TimeWrapper      `json:"updated_at,omitempty"`
+	Permissions map[string]string `json:"permissions,omitempty"`
+	Events      []string          `json:"events,omitempty"`
 }
 
 type IssuePullRequest struct {
@@ -311,6 +410,7 @@ type Repository struct {
 
 type Account struct {
 	Login                    *string                   `json:"login,omitempty"`
+	Username                 *string                   `json:"username,omitempty"` // Commit authors in push events
 	ID                       *int                      `json:"id,omitempty"`
 	NodeID                   *string                   `json:"node_id,omitempty"`
 	AvatarURL                *string                   `json:"avatar_url,omitempty"`
@@ -343,6 +443,11 @@ type Account struct {
 	CreatedAt                *TimeWrapper              `json:"created_at,omitempty"`
 	UpdatedAt                *TimeWrapper              `json:"updated_at,omitempty"`
 	OrganizationBillingEmail *string                   `json:"organization_billing_email,omitempty"`
+	Description              *string                   `json:"description,omitempty"` // Organizations
+	HooksURL                 *string                   `json:"hooks_url,omitempty"`
+	IssuesURL                *string                   `json:"issues_url,omitempty"`
+	MembersURL               *string                   `json:"members_url,omitempty"`
+	PublicMembersURL         *string                   `json:"public_members_url,omitempty"`
 	MarketplacePendingChange *MarketplacePendingChange `json:"marketplace_pending_change,omitempty"`
 	MarketplacePurchase      *MarketplacePurchase      `json:"marketplace_purchase,omitempty"`
 }
@@ -380,6 +485,8 @@ type CommitData struct {
 	Verification *VerificationObject `json:"verification,omitempty"`
 }
 
+// Commits come in two shapes: the REST API one (sha, commit, parents...) and the
+// one found in push events (id, tree_id, added/removed/modified...). We model both.
 type Commit struct {
 	URL         *string      `json:"url,omitempty"`
 	SHA         *string      `json:"sha,omitempty"`
@@ -390,6 +497,14 @@ type Commit struct {
 	Author      *Account     `json:"author,omitempty"`
 	Committer   *Account     `json:"committer,omitempty"`
 	Parents     []TreeObject `json:"parents,omitempty"`
+	ID          *string      `json:"id,omitempty"`
+	TreeID      *string      `json:"tree_id,omitempty"`
+	Distinct    *bool        `json:"distinct,omitempty"`
+	Message     *string      `json:"message,omitempty"`
+	Timestamp   *TimeWrapper `json:"timestamp,omitempty"`
+	Added       []string     `json:"added,omitempty"`
+	Removed     []string     `json:"removed,omitempty"`
+	Modified    []string     `json:"modified,omitempty"`
 }
 
 type Installation struct {
@@ -449,7 +564,130 @@ type MarketplacePurchase struct {
 }
 
 type PullRequest struct {
-	// XXX TODO
+	URL                 *string            `json:"url,omitempty"`
+	ID                  *int               `json:"id,omitempty"`
+	NodeID              *string            `json:"node_id,omitempty"`
+	HTMLURL             *string            `json:"html_url,omitempty"`
+	DiffURL             *string            `json:"diff_url,omitempty"`
+	PatchURL            *string            `json:"patch_url,omitempty"`
+	IssueURL            *string            `json:"issue_url,omitempty"`
+	CommitsURL          *string            `json:"commits_url,omitempty"`
+	ReviewCommentsURL   *string            `json:"review_comments_url,omitempty"`
+	ReviewCommentURL    *string            `json:"review_comment_url,omitempty"`
+	CommentsURL         *string            `json:"comments_url,omitempty"`
+	StatusesURL         *string            `json:"statuses_url,omitempty"`
+	Number              *int               `json:"number,omitempty"`
+	State               *string            `json:"state,omitempty"`
+	Locked              *bool              `json:"locked,omitempty"`
+	Title               *string            `json:"title,omitempty"`
+	User                *Account           `json:"user,omitempty"`
+	Body                *string            `json:"body,omitempty"`
+	Labels              []Label            `json:"labels,omitempty"`
+	Milestone           *Milestone         `json:"milestone,omitempty"`
+	ActiveLockReason    *string            `json:"active_lock_reason,omitempty"`
+	CreatedAt           *TimeWrapper       `json:"created_at,omitempty"`
+	UpdatedAt           *TimeWrapper       `json:"updated_at,omitempty"`
+	ClosedAt            *TimeWrapper       `json:"closed_at,omitempty"`
+	MergedAt            *TimeWrapper       `json:"merged_at,omitempty"`
+	MergeCommitSHA      *string            `json:"merge_commit_sha,omitempty"`
+	Assignee            *Account           `json:"assignee,omitempty"`
+	Assignees           []Account          `json:"assignees,omitempty"`
+	RequestedReviewers  []Account          `json:"requested_reviewers,omitempty"`
+	RequestedTeams      []Team             `json:"requested_teams,omitempty"`
+	Head                *PullRequestBranch `json:"head,omitempty"`
+	Base                *PullRequestBranch `json:"base,omitempty"`
+	Links               *PullRequestLinks  `json:"_links,omitempty"`
+	AuthorAssociation   *string            `json:"author_association,omitempty"`
+	AutoMerge           *AutoMerge         `json:"auto_merge,omitempty"`
+	Draft               *bool              `json:"draft,omitempty"`
+	Merged              *bool              `json:"merged,omitempty"`
+	Mergeable           *bool              `json:"mergeable,omitempty"`
+	Rebaseable          *bool              `json:"rebaseable,omitempty"`
+	MergeableState      *string            `json:"mergeable_state,omitempty"`
+	MergedBy            *Account           `json:"merged_by,omitempty"`
+	Comments            *int               `json:"comments,omitempty"`
+	ReviewComments      *int               `json:"review_comments,omitempty"`
+	MaintainerCanModify *bool              `json:"maintainer_can_modify,omitempty"`
+	Commits             *int               `json:"commits,omitempty"`
+	Additions           *int               `json:"additions,omitempty"`
+	Deletions           *int               `json:"deletions,omitempty"`
+	ChangedFiles        *int               `json:"changed_files,omitempty"`
+}
+
+// The "head" and "base" of a pull request
+type PullRequestBranch struct {
+	Label *string     `json:"label,omitempty"`
+	Ref   *string     `json:"ref,omitempty"`
+	SHA   *string     `json:"sha,omitempty"`
+	User  *Account    `json:"user,omitempty"`
+	Repo  *Repository `json:"repo,omitempty"`
+}
+
+type PullRequestLinks struct {
+	Self           *Link `json:"self,omitempty"`
+	HTML           *Link `json:"html,omitempty"`
+	Issue          *Link `json:"issue,omitempty"`
+	Comments       *Link `json:"comments,omitempty"`
+	ReviewComments *Link `json:"review_comments,omitempty"`
+	ReviewComment  *Link `json:"review_comment,omitempty"`
+	Commits        *Link `json:"commits,omitempty"`
+	Statuses       *Link `json:"statuses,omitempty"`
+}
+
+type Link struct {
+	Href *string `json:"href,omitempty"`
+}
+
+type AutoMerge struct {
+	EnabledBy     *Account `json:"enabled_by,omitempty"`
+	MergeMethod   *string  `json:"merge_method,omitempty"`
+	CommitTitle   *string  `json:"commit_title,omitempty"`
+	CommitMessage *string  `json:"commit_message,omitempty"`
+}
+
+type Team struct {
+	ID              *int    `json:"id,omitempty"`
+	NodeID          *string `json:"node_id,omitempty"`
+	URL             *string `json:"url,omitempty"`
+	HTMLURL         *string `json:"html_url,omitempty"`
+	Name            *string `json:"name,omitempty"`
+	Slug            *string `json:"slug,omitempty"`
+	Description     *string `json:"description,omitempty"`
+	Privacy         *string `json:"privacy,omitempty"`
+	Permission      *string `json:"permission,omitempty"`
+	MembersURL      *string `json:"members_url,omitempty"`
+	RepositoriesURL *string `json:"repositories_url,omitempty"`
+}
+
+// A webhook (repository, organization or app)
+type Hook struct {
+	Type          *string       `json:"type,omitempty"` // "Repository" | "Organization" | "App"
+	ID            *int          `json:"id,omitempty"`
+	Name          *string       `json:"name,omitempty"`
+	Active        *bool         `json:"active,omitempty"`
+	Events        []string      `json:"events,omitempty"`
+	Config        *HookConfig   `json:"config,omitempty"`
+	AppID         *int          `json:"app_id,omitempty"`
+	UpdatedAt     *TimeWrapper  `json:"updated_at,omitempty"`
+	CreatedAt     *TimeWrapper  `json:"created_at,omitempty"`
+	URL           *string       `json:"url,omitempty"`
+	TestURL       *string       `json:"test_url,omitempty"`
+	PingURL       *string       `json:"ping_url,omitempty"`
+	DeliveriesURL *string       `json:"deliveries_url,omitempty"`
+	LastResponse  *HookResponse `json:"last_response,omitempty"`
+}
+
+type HookConfig struct {
+	ContentType *string `json:"content_type,omitempty"` // "json" | "form"
+	InsecureSSL *string `json:"insecure_ssl,omitempty"` // "0" | "1"
+	URL         *string `json:"url,omitempty"`
+	Secret      *string `json:"secret,omitempty"` // Always "********" when returned by Github
+}
+
+type HookResponse struct {
+	Code    *int    `json:"code,omitempty"`
+	Status  *string `json:"status,omitempty"`
+	Message *string `json:"message,omitempty"`
 }
 
 //
@@ -461,6 +699,41 @@ type UserInstallationsResponse struct {
 	TotalCount    int            `json:"total_count"`
 }
 
+// Endpoint: /installation/repositories
+type InstallationRepositoriesResponse struct {
+	TotalCount          int          `json:"total_count"`
+	RepositorySelection *string      `json:"repository_selection,omitempty"`
+	Repositories        []Repository `json:"repositories"`
+}
+
+// Endpoints: /events, /repos/{owner}/{repo}/events, /orgs/{org}/events etc. The
+// payload is a trimmed version of the webhook payload (see APIEvent.Decode)
+type APIEvent struct {
+	ID        *string         `json:"id,omitempty"`
+	Type      *string         `json:"type,omitempty"` // e.g. "PushEvent"
+	Actor     *Account        `json:"actor,omitempty"`
+	Repo      *APIEventRepo   `json:"repo,omitempty"`
+	Org       *Account        `json:"org,omitempty"`
+	Payload   json.RawMessage `json:"payload,omitempty"`
+	Public    *bool           `json:"public,omitempty"`
+	CreatedAt *TimeWrapper    `json:"created_at,omitempty"`
+}
+
+type APIEventRepo struct {
+	ID   *int    `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"` // "owner/repo"
+	URL  *string `json:"url,omitempty"`
+}
+
+// Endpoint: POST /app/installations/{installation_id}/access_tokens
+type InstallationToken struct {
+	Token               *string           `json:"token,omitempty"`
+	ExpiresAt           *TimeWrapper      `json:"expires_at,omitempty"`
+	Permissions         map[string]string `json:"permissions,omitempty"`
+	RepositorySelection *string           `json:"repository_selection,omitempty"`
+	Repositories        []Repository      `json:"repositories,omitempty"`
+}
+
 /*
 
 {
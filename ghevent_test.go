@@ -300,3 +300,52 @@ func TestJSONDecodeBoolSlice(t *testing.T) {
 		t.Error("ts.BoolSliceField was not <nil> when field was missing from input")
 	}
 }
+
+func FuzzTimeWrapperUnmarshalJSON(f *testing.F) {
+	for _, seed := range []string{
+		`"2019-05-15T15:20:41Z"`,
+		`"2021-01-14T07:35:08+01:00"`,
+		`1557933565`,
+		`-1`,
+		`"1557933565"`,
+		`""`,
+		`null`,
+		`"not a time"`,
+		`99999999999999`,
+	} {
+		f.Add([]byte(seed))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		tw := TimeWrapper{}
+		if err := tw.UnmarshalJSON(data); err != nil {
+			return
+		}
+		encoded, err := tw.MarshalJSON()
+		if err != nil {
+			t.Fatalf("%q decoded to %v, which failed to encode: %v", data, tw.Time(), err)
+		}
+		if !json.Valid(encoded) {
+			t.Fatalf("%q decoded to %v, which encoded to invalid JSON %q", data, tw.Time(), encoded)
+		}
+		// What we encode, we should be able to decode again
+		again := TimeWrapper{}
+		if err := again.UnmarshalJSON(encoded); err != nil {
+			t.Fatalf("%q re-encoded as %q, which failed to decode: %v", data, encoded, err)
+		}
+		if !again.Time().Equal(tw.Time()) {
+			t.Fatalf("%q decoded to %v, but its re-encoding %q decoded to %v", data, tw.Time(), encoded, again.Time())
+		}
+	})
+}
+
+func TestGetterChaining(t *testing.T) {
+	var event *PushEvent
+	if login := event.GetRepository().GetOwner().GetLogin(); login != "" {
+		t.Errorf("GetLogin() on a nil chain was \"%s\" (should have been \"\")", login)
+	}
+	login := "Codertocat"
+	event = &PushEvent{Repository: &Repository{Owner: &Account{Login: &login}}}
+	if got := event.GetRepository().GetOwner().GetLogin(); got != login {
+		t.Errorf("GetLogin() was \"%s\" (should have been \"%s\")", got, login)
+	}
+}
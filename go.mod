@@ -1,5 +1,5 @@
 module github.com/ragnarlonn/github-events
 
-go 1.14
+go 1.18
 
 require github.com/mattn/go-sqlite3 v1.14.6 // indirect
@@ -0,0 +1,447 @@
+package ghevent
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+//
+// Bookkeeping of a Github App's installations. An InstallationRegistry applies
+// installation and installation_repositories events, and answers which installation
+// covers a repository, and which repositories an installation sees:
+//
+//   registry := ghevent.NewInstallationRegistry()
+//   ...
+//   registry.Apply(event) // Other events are ignored
+//   if installation := registry.InstallationFor("Codertocat/Hello-World"); installation != nil {
+//       client := &http.Client{Transport: appTransport.ForInstallation(installation.Installation)}
+//   }
+//
+// An app that starts with installations in place can Load them from the API
+// (ListAppInstallations, and ListInstallationRepositories for each) first. The
+// registry is in memory, and can be saved and restored as JSON, or kept in an
+// InstallationStore (e.g. a SQLite database, see the sqlitestore package, which is a
+// module of its own so that only programs using it need cgo):
+//
+//   store, err := sqlitestore.Open("installations.db")
+//   ...
+//   registry, err := ghevent.NewStoredInstallationRegistry(store)
+//
+
+type InstallationRegistry struct {
+	mu            sync.RWMutex
+	installations map[int]*RegisteredInstallation
+	repositories  map[string]int // Lower case full name -> installation ID
+	store         InstallationStore
+	changed       map[int]bool // IDs of installations to save to the store
+}
+
+// InstallationStore keeps the installations of a registry. The registry still has
+// them all in memory; it loads them from the store when made, and saves the ones
+// an event or Load changes (or deletes them) before returning
+type InstallationStore interface {
+	LoadInstallations() ([]*RegisteredInstallation, error)
+	SaveInstallation(ri *RegisteredInstallation) error
+	DeleteInstallation(id int) error
+}
+
+type RegisteredInstallation struct {
+	ID                  int
+	Account             string // Login of the user or organization the app is installed on
+	Installation        *Installation
+	RepositorySelection string   // "all" or "selected"
+	Repositories        []string // Full names, sorted
+	Suspended           bool
+	Permissions         []PermissionSnapshot // Oldest first
+}
+
+// PermissionSnapshot is what an installation was granted at a time
+type PermissionSnapshot struct {
+	Action      string // Of the event, or "load"
+	At          time.Time
+	Permissions map[string]string
+	Events      []string
+}
+
+func NewInstallationRegistry() *InstallationRegistry {
+	return &InstallationRegistry{
+		installations: map[int]*RegisteredInstallation{},
+		repositories:  map[string]int{},
+	}
+}
+
+// NewStoredInstallationRegistry returns a registry with the installations in store,
+// which keeps them from then on
+func NewStoredInstallationRegistry(store InstallationStore) (*InstallationRegistry, error) {
+	installations, err := store.LoadInstallations()
+	if err != nil {
+		return nil, fmt.Errorf("loading installations: %w", err)
+	}
+	r := NewInstallationRegistry()
+	r.set(installations)
+	r.store = store
+	return r, nil
+}
+
+// Apply updates the registry from an InstallationEvent or
+// InstallationRepositoriesEvent. Other events are ignored. With a store, errors
+// saving are returned too, and the registry is updated anyway (saving is tried
+// again with the next change)
+func (r *InstallationRegistry) Apply(event interface{}) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var err error
+	switch e := event.(type) {
+	case *InstallationEvent:
+		err = r.applyInstallation(e)
+	case *InstallationRepositoriesEvent:
+		err = r.applyRepositories(e)
+	}
+	if saveErr := r.save(); err == nil {
+		err = saveErr
+	}
+	return err
+}
+
+func (r *InstallationRegistry) applyInstallation(e *InstallationEvent) error {
+	id := e.GetInstallation().GetID()
+	if id == 0 {
+		return fmt.Errorf("installation event has no installation ID")
+	}
+	action := e.GetAction()
+	switch action {
+	case "created":
+		ri := r.register(e.Installation)
+		ri.snapshot(action, e.Installation.GetCreatedAt())
+		r.setRepositories(ri, e.Repositories)
+	case "deleted":
+		r.unregister(id)
+	case "suspend", "unsuspend":
+		ri := r.register(e.Installation)
+		ri.Suspended = action == "suspend"
+	case "new_permissions_accepted":
+		ri := r.register(e.Installation)
+		ri.snapshot(action, e.Installation.GetUpdatedAt())
+	default:
+		return fmt.Errorf("unknown installation action \"%s\"", action)
+	}
+	return nil
+}
+
+func (r *InstallationRegistry) applyRepositories(e *InstallationRepositoriesEvent) error {
+	if e.GetInstallation().GetID() == 0 {
+		return fmt.Errorf("installation_repositories event has no installation ID")
+	}
+	ri := r.register(e.Installation)
+	if e.RepositorySelection != nil {
+		ri.RepositorySelection = e.GetRepositorySelection()
+	}
+	switch action := e.GetAction(); action {
+	case "added":
+		for _, repo := range e.RepositoriesAdded {
+			r.addRepository(ri, repo.GetFullName())
+		}
+	case "removed":
+		for _, repo := range e.RepositoriesRemoved {
+			r.removeRepository(ri, repo.GetFullName())
+		}
+	default:
+		return fmt.Errorf("unknown installation_repositories action \"%s\"", action)
+	}
+	return nil
+}
+
+// Load adds an installation and its repositories, as listed by the API, replacing
+// what the registry had for it
+func (r *InstallationRegistry) Load(installation *Installation, repositories []Repository) error {
+	if installation.GetID() == 0 {
+		return fmt.Errorf("installation has no ID")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	ri := r.register(installation)
+	ri.Suspended = installation.SuspendedAt != nil
+	ri.snapshot("load", installation.GetUpdatedAt())
+	r.setRepositories(ri, repositories)
+	return r.save()
+}
+
+// register returns the registered installation with the ID of installation, added
+// if there is none, and updated with what installation says
+func (r *InstallationRegistry) register(installation *Installation) *RegisteredInstallation {
+	if r.installations == nil {
+		r.installations = map[int]*RegisteredInstallation{}
+		r.repositories = map[string]int{}
+	}
+	ri, ok := r.installations[installation.GetID()]
+	if !ok {
+		ri = &RegisteredInstallation{ID: installation.GetID()}
+		r.installations[ri.ID] = ri
+	}
+	r.markChanged(ri.ID)
+	ri.Installation = installation
+	if login := installation.GetAccount().GetLogin(); login != "" {
+		ri.Account = login
+	}
+	if installation.RepositorySelection != nil {
+		ri.RepositorySelection = installation.GetRepositorySelection()
+	}
+	return ri
+}
+
+func (r *InstallationRegistry) unregister(id int) {
+	if ri, ok := r.installations[id]; ok {
+		r.setRepositories(ri, nil)
+		delete(r.installations, id)
+		r.markChanged(id)
+	}
+}
+
+func (r *InstallationRegistry) markChanged(id int) {
+	if r.store == nil {
+		return
+	}
+	if r.changed == nil {
+		r.changed = map[int]bool{}
+	}
+	r.changed[id] = true
+}
+
+// save saves the changed installations to the store, and deletes the removed ones.
+// Installations that fail to save stay marked as changed
+func (r *InstallationRegistry) save() error {
+	ids := make([]int, 0, len(r.changed))
+	for id := range r.changed {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	for _, id := range ids {
+		var err error
+		if ri, ok := r.installations[id]; ok {
+			err = r.store.SaveInstallation(ri.copy())
+		} else {
+			err = r.store.DeleteInstallation(id)
+		}
+		if err != nil {
+			return fmt.Errorf("saving installation %d: %w", id, err)
+		}
+		delete(r.changed, id)
+	}
+	return nil
+}
+
+// snapshot records the permissions and events of the installation, unless they
+// are the ones recorded last
+func (ri *RegisteredInstallation) snapshot(action string, at time.Time) {
+	s := PermissionSnapshot{
+		Action:      action,
+		At:          at,
+		Permissions: map[string]string{},
+		Events:      append([]string{}, ri.Installation.Events...),
+	}
+	for permission, level := range ri.Installation.Permissions {
+		s.Permissions[permission] = level
+	}
+	if n := len(ri.Permissions); n > 0 {
+		last := ri.Permissions[n-1]
+		if sameStrings(last.Events, s.Events) && sameLevels(last.Permissions, s.Permissions) {
+			return
+		}
+	}
+	ri.Permissions = append(ri.Permissions, s)
+}
+
+// sameStrings tells if a and b have the same strings, in any order
+func sameStrings(a, b []string) bool {
+	for _, s := range a {
+		if !containsString(b, s) {
+			return false
+		}
+	}
+	for _, s := range b {
+		if !containsString(a, s) {
+			return false
+		}
+	}
+	return true
+}
+
+func sameLevels(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for permission, level := range a {
+		if b[permission] != level {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *InstallationRegistry) setRepositories(ri *RegisteredInstallation, repositories []Repository) {
+	for _, fullName := range ri.Repositories {
+		delete(r.repositories, strings.ToLower(fullName))
+	}
+	ri.Repositories = nil
+	for _, repo := range repositories {
+		r.addRepository(ri, repo.GetFullName())
+	}
+}
+
+func (r *InstallationRegistry) addRepository(ri *RegisteredInstallation, fullName string) {
+	if fullName == "" {
+		return
+	}
+	key := strings.ToLower(fullName)
+	if other, ok := r.installations[r.repositories[key]]; ok && other != ri {
+		// Moved, e.g. by a transfer to an account the app is also installed on
+		other.Repositories = removeFold(other.Repositories, fullName)
+		r.markChanged(other.ID)
+	}
+	r.repositories[key] = ri.ID
+	ri.Repositories = append(removeFold(ri.Repositories, fullName), fullName)
+	sort.Strings(ri.Repositories)
+}
+
+func (r *InstallationRegistry) removeRepository(ri *RegisteredInstallation, fullName string) {
+	key := strings.ToLower(fullName)
+	if r.repositories[key] == ri.ID {
+		delete(r.repositories, key)
+	}
+	ri.Repositories = removeFold(ri.Repositories, fullName)
+}
+
+func removeFold(list []string, s string) []string {
+	kept := list[:0]
+	for _, item := range list {
+		if !strings.EqualFold(item, s) {
+			kept = append(kept, item)
+		}
+	}
+	return kept
+}
+
+// Installation returns a copy of the installation with an ID, or nil
+func (r *InstallationRegistry) Installation(id int) *RegisteredInstallation {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if ri, ok := r.installations[id]; ok {
+		return ri.copy()
+	}
+	return nil
+}
+
+// InstallationFor returns a copy of the installation that covers a repository
+// ("owner/name"), or nil. Repositories created after an installation for "all" of
+// an account's repositories are covered by it, even if no event listed them.
+// Suspended installations are returned too, with Suspended set
+func (r *InstallationRegistry) InstallationFor(fullName string) *RegisteredInstallation {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if ri, ok := r.installations[r.repositories[strings.ToLower(fullName)]]; ok {
+		return ri.copy()
+	}
+	owner, _, _ := strings.Cut(fullName, "/")
+	for _, ri := range r.installations {
+		if ri.RepositorySelection == "all" && strings.EqualFold(ri.Account, owner) {
+			return ri.copy()
+		}
+	}
+	return nil
+}
+
+// Repositories returns the full names of the repositories an installation sees,
+// sorted (nil for an unknown installation)
+func (r *InstallationRegistry) Repositories(id int) []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if ri, ok := r.installations[id]; ok {
+		return append([]string{}, ri.Repositories...)
+	}
+	return nil
+}
+
+// Installations returns copies of all the installations, sorted by ID
+func (r *InstallationRegistry) Installations() []*RegisteredInstallation {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	installations := make([]*RegisteredInstallation, 0, len(r.installations))
+	for _, ri := range r.installations {
+		installations = append(installations, ri.copy())
+	}
+	sort.Slice(installations, func(i, j int) bool { return installations[i].ID < installations[j].ID })
+	return installations
+}
+
+// copy copies what the registry changes in place. The Installation and snapshots
+// are replaced rather than changed, so they are shared
+func (ri *RegisteredInstallation) copy() *RegisteredInstallation {
+	c := *ri
+	c.Repositories = append([]string{}, ri.Repositories...)
+	c.Permissions = append([]PermissionSnapshot{}, ri.Permissions...)
+	return &c
+}
+
+// LatestPermissions returns the last permissions snapshot, or nil if there is none
+func (ri *RegisteredInstallation) LatestPermissions() *PermissionSnapshot {
+	if len(ri.Permissions) == 0 {
+		return nil
+	}
+	return &ri.Permissions[len(ri.Permissions)-1]
+}
+
+// Escalations returns the permissions raised by the last snapshot, compared to the
+// one before (or to none, if there is only one). Unknown permissions are errors,
+// but they are compared too (see ParsePermissions)
+func (ri *RegisteredInstallation) Escalations() ([]PermissionChange, error) {
+	n := len(ri.Permissions)
+	if n == 0 {
+		return []PermissionChange{}, nil
+	}
+	after, err := ParsePermissions(ri.Permissions[n-1].Permissions)
+	before := &Permissions{}
+	if n > 1 {
+		var beforeErr error
+		if before, beforeErr = ParsePermissions(ri.Permissions[n-2].Permissions); err == nil {
+			err = beforeErr
+		}
+	}
+	return after.Escalations(before), err
+}
+
+func (r *InstallationRegistry) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.Installations())
+}
+
+// UnmarshalJSON replaces what the registry (and its store) has with the
+// installations saved with MarshalJSON
+func (r *InstallationRegistry) UnmarshalJSON(data []byte) error {
+	installations := []*RegisteredInstallation{}
+	if err := json.Unmarshal(data, &installations); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for id := range r.installations {
+		r.markChanged(id)
+	}
+	r.set(installations)
+	return r.save()
+}
+
+// set replaces the installations
+func (r *InstallationRegistry) set(installations []*RegisteredInstallation) {
+	r.installations = map[int]*RegisteredInstallation{}
+	r.repositories = map[string]int{}
+	for _, ri := range installations {
+		r.installations[ri.ID] = ri
+		r.markChanged(ri.ID)
+		for _, fullName := range ri.Repositories {
+			r.repositories[strings.ToLower(fullName)] = ri.ID
+		}
+	}
+}
@@ -0,0 +1,228 @@
+package ghevent
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"reflect"
+	"testing"
+)
+
+func applyFixture(t *testing.T, r *InstallationRegistry, eventType, name string) {
+	t.Helper()
+	payload, err := ioutil.ReadFile("testdata/" + eventType + "/" + name + ".json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	event, err := ParseWebHook(eventType, payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Apply(event); err != nil {
+		t.Fatalf("%s/%s: %v", eventType, name, err)
+	}
+}
+
+func TestInstallationRegistry(t *testing.T) {
+	r := NewInstallationRegistry()
+	steps := []struct {
+		eventType, name string
+		repositories    []string
+		suspended       bool
+		snapshots       int
+	}{
+		{"installation", "created", []string{"Codertocat/Hello-World", "Codertocat/Space-Sim"}, false, 1},
+		{"installation_repositories", "added", []string{"Codertocat/Hello-World", "Codertocat/Moon-Base", "Codertocat/Space-Sim"}, false, 1},
+		{"installation_repositories", "removed", []string{"Codertocat/Hello-World", "Codertocat/Moon-Base"}, false, 1},
+		{"installation", "suspend", []string{"Codertocat/Hello-World", "Codertocat/Moon-Base"}, true, 1},
+		{"installation", "new_permissions_accepted", []string{"Codertocat/Hello-World", "Codertocat/Moon-Base"}, true, 2},
+	}
+	for _, step := range steps {
+		applyFixture(t, r, step.eventType, step.name)
+		ri := r.Installation(2311213)
+		if ri == nil {
+			t.Fatalf("%s: installation not registered (should be)", step.name)
+		}
+		if !reflect.DeepEqual(ri.Repositories, step.repositories) {
+			t.Errorf("%s: repositories are %v (should be %v)", step.name, ri.Repositories, step.repositories)
+		}
+		if ri.Suspended != step.suspended {
+			t.Errorf("%s: suspended is %t (should be %t)", step.name, ri.Suspended, step.suspended)
+		}
+		if len(ri.Permissions) != step.snapshots {
+			t.Errorf("%s: %d permission snapshots (should be %d)", step.name, len(ri.Permissions), step.snapshots)
+		}
+	}
+
+	if ri := r.InstallationFor("codertocat/moon-base"); ri == nil || ri.ID != 2311213 || ri.Account != "Codertocat" {
+		t.Errorf("InstallationFor(codertocat/moon-base) returned %+v (should be installation 2311213)", ri)
+	}
+	if ri := r.InstallationFor("Codertocat/Space-Sim"); ri != nil {
+		t.Errorf("InstallationFor(Codertocat/Space-Sim) returned installation %d (should be nil)", ri.ID)
+	}
+	latest := r.Installation(2311213).LatestPermissions()
+	if latest.Action != "new_permissions_accepted" || latest.Permissions["checks"] != "write" || !containsString(latest.Events, "check_run") {
+		t.Errorf("latest permissions are %+v (should have checks:write and check_run)", latest)
+	}
+
+	// Saved and restored
+	saved, err := json.Marshal(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	restored := NewInstallationRegistry()
+	if err := json.Unmarshal(saved, restored); err != nil {
+		t.Fatal(err)
+	}
+	if got := restored.Repositories(2311213); !reflect.DeepEqual(got, r.Repositories(2311213)) {
+		t.Errorf("restored repositories are %v (should be %v)", got, r.Repositories(2311213))
+	}
+	if ri := restored.InstallationFor("Codertocat/Hello-World"); ri == nil || !ri.Suspended || len(ri.Permissions) != 2 {
+		t.Errorf("restored InstallationFor(Codertocat/Hello-World) returned %+v (should be suspended, with 2 snapshots)", ri)
+	}
+
+	applyFixture(t, r, "installation", "deleted")
+	if ri := r.Installation(2311213); ri != nil {
+		t.Errorf("deleted installation is registered (should not be)")
+	}
+	if ri := r.InstallationFor("Codertocat/Hello-World"); ri != nil {
+		t.Errorf("InstallationFor(Codertocat/Hello-World) returned installation %d after delete (should be nil)", ri.ID)
+	}
+	if len(r.Installations()) != 0 {
+		t.Errorf("%d installations after delete (should be 0)", len(r.Installations()))
+	}
+}
+
+func TestInstallationRegistryLoad(t *testing.T) {
+	r := NewInstallationRegistry()
+	all := &Installation{ID: Ptr(1), Account: &Account{Login: Ptr("octo-org")}, RepositorySelection: Ptr("all")}
+	if err := r.Load(all, []Repository{{FullName: Ptr("octo-org/a")}}); err != nil {
+		t.Fatal(err)
+	}
+	if ri := r.InstallationFor("octo-org/created-later"); ri == nil || ri.ID != 1 {
+		t.Errorf("InstallationFor(octo-org/created-later) returned %+v (should be installation 1)", ri)
+	}
+	if ri := r.InstallationFor("other/a"); ri != nil {
+		t.Errorf("InstallationFor(other/a) returned installation %d (should be nil)", ri.ID)
+	}
+
+	// Transferred to an account with another installation
+	other := &Installation{ID: Ptr(2), Account: &Account{Login: Ptr("octocat")}, RepositorySelection: Ptr("selected")}
+	if err := r.Apply(&InstallationRepositoriesEvent{Action: Ptr("added"), Installation: other, RepositoriesAdded: []Repository{{FullName: Ptr("octo-org/a")}}}); err != nil {
+		t.Fatal(err)
+	}
+	if got := r.Repositories(1); len(got) != 0 {
+		t.Errorf("installation 1 has repositories %v (should have none)", got)
+	}
+	if ri := r.InstallationFor("octo-org/a"); ri == nil || ri.ID != 2 {
+		t.Errorf("InstallationFor(octo-org/a) returned %+v (should be installation 2)", ri)
+	}
+
+	if err := r.Apply(&InstallationEvent{Action: Ptr("created")}); err == nil {
+		t.Errorf("applying an event without installation succeeded (should fail)")
+	}
+	if err := r.Apply(&PushEvent{}); err != nil {
+		t.Errorf("applying a push failed (should be ignored): %v", err)
+	}
+}
+
+// memoryStore is an InstallationStore keeping installations as JSON, failing while
+// fail is set
+type memoryStore struct {
+	saved map[int][]byte
+	fail  bool
+}
+
+func (s *memoryStore) LoadInstallations() ([]*RegisteredInstallation, error) {
+	installations := []*RegisteredInstallation{}
+	for _, data := range s.saved {
+		ri := &RegisteredInstallation{}
+		if err := json.Unmarshal(data, ri); err != nil {
+			return nil, err
+		}
+		installations = append(installations, ri)
+	}
+	return installations, nil
+}
+
+func (s *memoryStore) SaveInstallation(ri *RegisteredInstallation) error {
+	if s.fail {
+		return errors.New("store is down")
+	}
+	data, err := json.Marshal(ri)
+	s.saved[ri.ID] = data
+	return err
+}
+
+func (s *memoryStore) DeleteInstallation(id int) error {
+	if s.fail {
+		return errors.New("store is down")
+	}
+	delete(s.saved, id)
+	return nil
+}
+
+func TestInstallationRegistryStore(t *testing.T) {
+	store := &memoryStore{saved: map[int][]byte{}}
+	r, err := NewStoredInstallationRegistry(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	applyFixture(t, r, "installation", "created")
+	applyFixture(t, r, "installation_repositories", "added")
+	if len(store.saved) != 1 {
+		t.Fatalf("store has %d installations (should have 1)", len(store.saved))
+	}
+
+	// A failed save is returned, and tried again with the next change
+	store.fail = true
+	moved := &Installation{ID: Ptr(2), Account: &Account{Login: Ptr("octocat")}}
+	if err := r.Load(moved, []Repository{{FullName: Ptr("Codertocat/Hello-World")}}); err == nil {
+		t.Errorf("Load with the store down succeeded (should fail)")
+	}
+	store.fail = false
+	applyFixture(t, r, "installation", "suspend")
+	restored, err := NewStoredInstallationRegistry(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, _ := json.Marshal(r)
+	if got, _ := json.Marshal(restored); string(got) != string(want) {
+		t.Errorf("restored registry is %s (should be %s)", got, want)
+	}
+	if ri := restored.InstallationFor("Codertocat/Hello-World"); ri == nil || ri.ID != 2 {
+		t.Errorf("restored InstallationFor(Codertocat/Hello-World) returned %+v (should be installation 2)", ri)
+	}
+
+	applyFixture(t, r, "installation", "deleted")
+	if _, ok := store.saved[2311213]; ok || len(store.saved) != 1 {
+		t.Errorf("store has installations %v after deletion (should have only 2)", store.saved)
+	}
+}
+
+// A zero InstallationRegistry can be used
+func TestInstallationRegistryZero(t *testing.T) {
+	r := &InstallationRegistry{}
+	applyFixture(t, r, "installation", "created")
+	if ri := r.InstallationFor("Codertocat/Hello-World"); ri == nil || ri.ID != 2311213 {
+		t.Errorf("InstallationFor(Codertocat/Hello-World) returned %+v (should be installation 2311213)", ri)
+	}
+}
+
+func TestSameStrings(t *testing.T) {
+	tests := []struct {
+		a, b []string
+		same bool
+	}{
+		{nil, []string{}, true},
+		{[]string{"push", "issues"}, []string{"issues", "push"}, true},
+		{[]string{"a", "a"}, []string{"a", "b"}, false},
+		{[]string{"a", "b"}, []string{"a", "a"}, false},
+		{[]string{"a"}, []string{"a", "b"}, false},
+	}
+	for _, test := range tests {
+		if same := sameStrings(test.a, test.b); same != test.same {
+			t.Errorf("sameStrings(%v, %v) returned %t (should be %t)", test.a, test.b, same, test.same)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package ghevent
+
+import "sync"
+
+//
+// String interning, for holding many decoded events in memory. The same URLs,
+// logins and names are in almost every event about a repository, and with a shared
+// pool they are only stored once:
+//
+//   pool := ghevent.NewStringPool()
+//   event, err := ghevent.DecodeWebHook(eventType, r, ghevent.DecodeOptions{Intern: pool})
+//
+// The pool keeps every string it has been given, so it should be dropped (or Reset)
+// with the events.
+//
+
+// InternedFields are the fields whose values are interned by default: the ones
+// that repeat across events
+var InternedFields = []string{
+	"url", "*_url", "login", "node_id", "full_name", "name", "type", "ref",
+	"default_branch", "label", "email", "username", "gravatar_id", "state",
+	"author_association", "color", "description",
+}
+
+type StringPool struct {
+	mu      sync.Mutex
+	strings map[string]string
+}
+
+func NewStringPool() *StringPool {
+	return &StringPool{strings: map[string]string{}}
+}
+
+// Intern returns the pool's copy of s, adding s to the pool if it has none
+func (p *StringPool) Intern(s string) string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if interned, ok := p.strings[s]; ok {
+		return interned
+	}
+	p.strings[s] = s
+	return s
+}
+
+// intern is Intern for bytes, only allocating a string for new values
+func (p *StringPool) intern(b []byte) string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if interned, ok := p.strings[string(b)]; ok {
+		return interned
+	}
+	s := string(b)
+	p.strings[s] = s
+	return s
+}
+
+// Len returns the number of strings in the pool
+func (p *StringPool) Len() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.strings)
+}
+
+// Reset empties the pool. Strings interned before stay valid, but aren't shared
+// with the ones interned after
+func (p *StringPool) Reset() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.strings = map[string]string{}
+}
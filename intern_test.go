@@ -0,0 +1,125 @@
+package ghevent
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"testing"
+	"unsafe"
+)
+
+// corpus returns n payloads, cycling through the fixtures
+func corpus(tb testing.TB, n int) ([]string, [][]byte) {
+	tb.Helper()
+	files, err := filepath.Glob(filepath.Join("testdata", "*", "*.json"))
+	if err != nil {
+		tb.Fatal(err)
+	}
+	eventTypes, payloads := []string{}, [][]byte{}
+	for len(payloads) < n {
+		file := files[len(payloads)%len(files)]
+		payload, err := ioutil.ReadFile(file)
+		if err != nil {
+			tb.Fatal(err)
+		}
+		eventTypes = append(eventTypes, filepath.Base(filepath.Dir(file)))
+		payloads = append(payloads, payload)
+	}
+	return eventTypes, payloads
+}
+
+func stringData(s string) uintptr {
+	return (*reflect.StringHeader)(unsafe.Pointer(&s)).Data
+}
+
+func TestDecoderIntern(t *testing.T) {
+	eventTypes, payloads := corpus(t, 40)
+	pool := NewStringPool()
+	events := []interface{}{}
+	for i, payload := range payloads {
+		event, err := DecodeWebHook(eventTypes[i], bytes.NewReader(payload), DecodeOptions{Intern: pool})
+		if err != nil {
+			t.Fatal(err)
+		}
+		// The same values as without interning
+		if want, _ := ParseWebHook(eventTypes[i], payload); !reflect.DeepEqual(event, want) {
+			t.Errorf("event %d (%s) decoded differently with interning", i, eventTypes[i])
+		}
+		events = append(events, event)
+	}
+	first := events[0].(interface{ GetRepository() *Repository }).GetRepository()
+	last := events[len(events)-1].(interface{ GetRepository() *Repository }).GetRepository()
+	if first.GetFullName() != last.GetFullName() {
+		t.Fatalf("fixtures are about %s and %s", first.GetFullName(), last.GetFullName())
+	}
+	if stringData(first.GetFullName()) != stringData(last.GetFullName()) || stringData(first.GetHTMLURL()) != stringData(last.GetHTMLURL()) {
+		t.Errorf("repository strings weren't shared")
+	}
+	if first.FullName == last.FullName {
+		t.Errorf("*string fields were shared")
+	}
+	if n := pool.Len(); n == 0 || n > 2000 {
+		t.Errorf("pool has %d strings", n)
+	}
+
+	// Only the fields asked for
+	pool.Reset()
+	if _, err := DecodeWebHook(eventTypes[0], bytes.NewReader(payloads[0]), DecodeOptions{Intern: pool, InternFields: []string{"login"}}); err != nil {
+		t.Fatal(err)
+	}
+	if pool.Len() == 0 || pool.Len() > 10 {
+		t.Errorf("pool has %d strings after interning logins", pool.Len())
+	}
+	if pool.Intern("Codertocat") == "" {
+		t.Errorf("Intern() lost the string")
+	}
+}
+
+// BenchmarkRetainedEvents decodes a corpus of events and keeps them, reporting the
+// heap they use
+func BenchmarkRetainedEvents(b *testing.B) {
+	eventTypes, payloads := corpus(b, 5000)
+	decoders := map[string]func(i int, pool *StringPool) (interface{}, error){
+		"ParseWebHook": func(i int, pool *StringPool) (interface{}, error) {
+			return ParseWebHook(eventTypes[i], payloads[i])
+		},
+		"Decoder": func(i int, pool *StringPool) (interface{}, error) {
+			return DecodeWebHook(eventTypes[i], bytes.NewReader(payloads[i]), DecodeOptions{})
+		},
+		"Decoder with StringPool": func(i int, pool *StringPool) (interface{}, error) {
+			return DecodeWebHook(eventTypes[i], bytes.NewReader(payloads[i]), DecodeOptions{Intern: pool})
+		},
+	}
+	for _, name := range []string{"ParseWebHook", "Decoder", "Decoder with StringPool"} {
+		decode := decoders[name]
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			var events []interface{}
+			heap := uint64(0)
+			for n := 0; n < b.N; n++ {
+				events = nil
+				runtime.GC()
+				before := runtime.MemStats{}
+				runtime.ReadMemStats(&before)
+				pool := NewStringPool()
+				events = make([]interface{}, len(payloads))
+				for i := range payloads {
+					event, err := decode(i, pool)
+					if err != nil {
+						b.Fatal(err)
+					}
+					events[i] = event
+				}
+				runtime.GC()
+				after := runtime.MemStats{}
+				runtime.ReadMemStats(&after)
+				heap += after.HeapAlloc - before.HeapAlloc
+				runtime.KeepAlive(pool)
+			}
+			b.ReportMetric(float64(heap)/float64(b.N*len(payloads)), "heap-B/event")
+			runtime.KeepAlive(events)
+		})
+	}
+}
@@ -0,0 +1,231 @@
+package ghevent
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+//
+// Lazy decoding, for handlers that look at a few fields to decide whether an event
+// is for them, and discard most events:
+//
+//   e, err := ghevent.ParseLazy(eventType, payload)
+//   if e.Action != "opened" || e.RepositoryFullName != "Codertocat/Hello-World" {
+//       return
+//   }
+//   issue, err := e.Issue()
+//
+// ParseLazy decodes action, repository.full_name and sender.login, and only notes
+// where the other top level fields are in the payload. They are decoded on first
+// access, and kept for later ones. The whole payload is checked to be JSON up
+// front, so decoding them later can only fail on values of the wrong type.
+//
+
+type LazyEvent struct {
+	EventType          string // X-Github-Event
+	Action             string
+	RepositoryFullName string
+	SenderLogin        string
+
+	payload []byte
+	fields  map[string][2]int // Top level field -> start and end in payload
+
+	mu      sync.Mutex
+	decoded map[string]interface{} // Top level field (or "" for the event) -> value
+}
+
+var (
+	lazyEventType  = reflect.TypeOf(LazyEvent{})
+	lazyObjectType = reflect.TypeOf(struct{}{})
+)
+
+// ParseLazy decodes the header fields of a payload. The payload is kept, and must
+// not be modified
+func ParseLazy(eventType string, payload []byte) (*LazyEvent, error) {
+	if _, ok := eventTypes[eventType]; !ok {
+		return nil, fmt.Errorf("unknown event type \"%s\"", eventType)
+	}
+	e := &LazyEvent{EventType: eventType, payload: payload, fields: map[string][2]int{}}
+	d := newBytesDecoder(payload)
+	if err := d.startObject(lazyEventType); err != nil {
+		return nil, err
+	}
+	for first := true; ; first = false {
+		key, escaped, ok, err := d.nextKey(first)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			break
+		}
+		if escaped {
+			if key, ok = unescapeJSON(key); !ok {
+				return nil, d.syntaxError("invalid escape in key")
+			}
+		}
+		name := string(key)
+		if _, err := d.peek(); err != nil {
+			return nil, err
+		}
+		start := d.pos
+		switch name {
+		case "action":
+			err = d.decodeValue(reflect.ValueOf(&e.Action).Elem())
+		case "repository":
+			e.RepositoryFullName, err = d.nestedString("full_name")
+		case "sender":
+			e.SenderLogin, err = d.nestedString("login")
+		default:
+			err = d.skipValue()
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", name, err)
+		}
+		e.fields[name] = [2]int{start, d.pos}
+	}
+	if c, err := d.peek(); err != nil {
+		return nil, err
+	} else if c != 0 {
+		return nil, d.unexpected(c, "the end of the payload")
+	}
+	return e, nil
+}
+
+// nestedString consumes an object (or null), returning the value of one of its
+// string fields
+func (d *Decoder) nestedString(field string) (string, error) {
+	if c, err := d.peek(); err != nil {
+		return "", err
+	} else if c == 'n' {
+		_, err := d.scanLiteral()
+		return "", err
+	}
+	if err := d.startObject(lazyObjectType); err != nil {
+		return "", err
+	}
+	value := ""
+	for first := true; ; first = false {
+		key, _, ok, err := d.nextKey(first)
+		if err != nil || !ok {
+			return value, err
+		}
+		if string(key) == field {
+			err = d.decodeValue(reflect.ValueOf(&value).Elem())
+		} else {
+			err = d.skipValue()
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+}
+
+// Payload returns the payload
+func (e *LazyEvent) Payload() []byte {
+	return e.payload
+}
+
+// Raw returns a top level field as it is in the payload, or nil if there is no such
+// field
+func (e *LazyEvent) Raw(field string) json.RawMessage {
+	span, ok := e.fields[field]
+	if !ok {
+		return nil
+	}
+	return json.RawMessage(e.payload[span[0]:span[1]])
+}
+
+// Has tells if the payload has a top level field
+func (e *LazyEvent) Has(field string) bool {
+	_, ok := e.fields[field]
+	return ok
+}
+
+// Event decodes the whole payload, like ParseWebHook
+func (e *LazyEvent) Event() (interface{}, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if event, ok := e.decoded[""]; ok {
+		return event, nil
+	}
+	event, err := ParseWebHook(e.EventType, e.payload)
+	if err != nil {
+		return nil, err
+	}
+	e.cache("", event)
+	return event, nil
+}
+
+func (e *LazyEvent) cache(field string, v interface{}) {
+	if e.decoded == nil {
+		e.decoded = map[string]interface{}{}
+	}
+	e.decoded[field] = v
+}
+
+// lazyField decodes a top level field of e into a new T, or returns the one decoded
+// before. It returns nil if the payload doesn't have the field
+func lazyField[T any](e *LazyEvent, field string) (*T, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if v, ok := e.decoded[field]; ok {
+		return v.(*T), nil
+	}
+	raw := e.Raw(field)
+	if raw == nil {
+		return nil, nil
+	}
+	v := new(T)
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return nil, fmt.Errorf("%s: %v", field, err)
+	}
+	e.cache(field, v)
+	return v, nil
+}
+
+func (e *LazyEvent) Repository() (*Repository, error) {
+	return lazyField[Repository](e, "repository")
+}
+
+func (e *LazyEvent) Sender() (*Account, error) {
+	return lazyField[Account](e, "sender")
+}
+
+func (e *LazyEvent) Organization() (*Account, error) {
+	return lazyField[Account](e, "organization")
+}
+
+func (e *LazyEvent) Installation() (*Installation, error) {
+	return lazyField[Installation](e, "installation")
+}
+
+func (e *LazyEvent) Issue() (*Issue, error) {
+	return lazyField[Issue](e, "issue")
+}
+
+func (e *LazyEvent) Comment() (*IssueComment, error) {
+	return lazyField[IssueComment](e, "comment")
+}
+
+func (e *LazyEvent) PullRequest() (*PullRequest, error) {
+	return lazyField[PullRequest](e, "pull_request")
+}
+
+func (e *LazyEvent) Label() (*Label, error) {
+	return lazyField[Label](e, "label")
+}
+
+func (e *LazyEvent) Changes() (*Changes, error) {
+	return lazyField[Changes](e, "changes")
+}
+
+// Commits returns the commits of a push
+func (e *LazyEvent) Commits() ([]Commit, error) {
+	commits, err := lazyField[[]Commit](e, "commits")
+	if commits == nil {
+		return nil, err
+	}
+	return *commits, err
+}
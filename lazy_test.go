@@ -0,0 +1,126 @@
+package ghevent
+
+import (
+	"bytes"
+	"io/ioutil"
+	"reflect"
+	"testing"
+)
+
+func TestParseLazy(t *testing.T) {
+	payload, err := ioutil.ReadFile("testdata/issue_comment/created.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	e, err := ParseLazy("issue_comment", payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	event, err := ParseWebHook("issue_comment", payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := event.(*IssueCommentEvent)
+	if e.Action != want.GetAction() || e.RepositoryFullName != want.GetRepository().GetFullName() || e.SenderLogin != want.GetSender().GetLogin() {
+		t.Errorf("header fields are %s, %s, %s", e.Action, e.RepositoryFullName, e.SenderLogin)
+	}
+
+	issue, err := e.Issue()
+	if err != nil || !reflect.DeepEqual(issue, want.Issue) {
+		t.Errorf("Issue() returned %+v, %v", issue, err)
+	}
+	if again, _ := e.Issue(); again != issue {
+		t.Errorf("Issue() decoded the issue again")
+	}
+	if comment, err := e.Comment(); err != nil || !reflect.DeepEqual(comment, want.Comment) {
+		t.Errorf("Comment() returned %+v, %v", comment, err)
+	}
+	if repo, err := e.Repository(); err != nil || !reflect.DeepEqual(repo, want.Repository) {
+		t.Errorf("Repository() returned %+v, %v", repo, err)
+	}
+	if pr, err := e.PullRequest(); pr != nil || err != nil || e.Has("pull_request") {
+		t.Errorf("PullRequest() returned %+v, %v for an issue comment", pr, err)
+	}
+	if full, err := e.Event(); err != nil || !reflect.DeepEqual(full, event) {
+		t.Errorf("Event() returned %+v, %v", full, err)
+	}
+	if raw := e.Raw("action"); string(raw) != `"created"` {
+		t.Errorf("Raw(\"action\") returned %s", raw)
+	}
+}
+
+func TestParseLazyErrors(t *testing.T) {
+	tests := map[string]string{
+		`{"action": "opened", "repository": {"full_name": "a/b"}, "sender": null}`: "",
+		`{"action": "opened", "issue": {"number": 1}`:                              "decoding at offset 43: unexpected end of input (expected ',' or '}')",
+		`{"action": 1}`:           "action: decoding at offset 11: can't decode number into string",
+		`{"repository": "a/b"}`:   "repository: decoding at offset 15: can't decode string into struct {}",
+		`{"action": "opened"} {}`: "decoding at offset 21: unexpected '{' (expected the end of the payload)",
+		// Fields that are only decoded later are still checked
+		`{"zen":"x","hook":[}}`:        "hook: decoding at offset 19: unexpected '}' (expected a value)",
+		`{"hook":{1 2}}`:               "hook: decoding at offset 9: unexpected '1' (expected '\"')",
+		`{"hook":{"a" "b"}}`:           "hook: decoding at offset 13: unexpected '\"' (expected ':')",
+		`{"issue": {"labels": [1 2]}}`: "issue: decoding at offset 24: unexpected '2' (expected ',' or ']')",
+	}
+	for payload, want := range tests {
+		got := ""
+		if _, err := ParseLazy("issues", []byte(payload)); err != nil {
+			got = err.Error()
+		}
+		if got != want {
+			t.Errorf("ParseLazy(%s) returned %s (should be %s)", payload, got, want)
+		}
+	}
+	if _, err := ParseLazy("nope", []byte(`{}`)); err == nil {
+		t.Errorf("ParseLazy() should fail for unknown event types")
+	}
+	e, err := ParseLazy("issues", []byte(`{"issue": {"number": "1"}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.Issue(); err == nil {
+		t.Errorf("Issue() should fail for a broken issue")
+	}
+}
+
+// Handlers that discard most events, after looking at the action and repository
+func BenchmarkDiscardEvent(b *testing.B) {
+	payloads := map[string][]byte{"pull_request": nil, "push": bigPushPayload(b, 300)}
+	var err error
+	if payloads["pull_request"], err = ioutil.ReadFile("testdata/pull_request/opened.json"); err != nil {
+		b.Fatal(err)
+	}
+	for eventType, payload := range payloads {
+		payload := payload
+		b.Run(eventType+"/ParseWebHook", func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(len(payload)))
+			for i := 0; i < b.N; i++ {
+				event, err := ParseWebHook(eventType, payload)
+				if err != nil || event.(interface{ GetRepository() *Repository }).GetRepository().GetFullName() == "" {
+					b.Fatal(err)
+				}
+			}
+		})
+		b.Run(eventType+"/ParseLazy", func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(len(payload)))
+			for i := 0; i < b.N; i++ {
+				e, err := ParseLazy(eventType, payload)
+				if err != nil || e.RepositoryFullName == "" {
+					b.Fatal(err)
+				}
+			}
+		})
+		b.Run(eventType+"/DecodeWebHook", func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(len(payload)))
+			for i := 0; i < b.N; i++ {
+				event, err := DecodeWebHook(eventType, bytes.NewReader(payload), DecodeOptions{})
+				if err != nil || event.(interface{ GetRepository() *Repository }).GetRepository().GetFullName() == "" {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,227 @@
+package ghevent
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+//
+// Filtering of events by the files they changed, using doublestar-style globs:
+//
+// - "*" matches any sequence of characters except "/"
+// - "?" matches any single character except "/"
+// - "[abc]", "[a-z]" and "[^a-z]" match character classes, like in path.Match
+// - "**" as a whole path segment matches zero or more segments, e.g. "docs/**/*.md"
+//   matches both "docs/a.md" and "docs/x/y/a.md"
+// - "{a,b}" matches either alternative, e.g. "*.{yml,yaml}"
+//
+// Github truncates push payloads: only the first 20 commits are listed, and file
+// lists stop after a total of 2048 files. If a push looks truncated we can't know for
+// sure that no file matched, so unless a FetchFiles function is provided (e.g. one
+// calling the compare API) a truncated push is always considered a match.
+//
+
+const (
+	MaxPushCommits = 20   // Commits listed in a push event
+	MaxPushFiles   = 2048 // Files listed, in total, in a push event
+)
+
+type PathFilter struct {
+	include [][]string // brace-expanded patterns, split into segments
+	exclude [][]string
+
+	// FetchFiles is called to get the complete list of changed files when a push
+	// event is truncated. If nil, truncated pushes are considered to match
+	FetchFiles func(e *PushEvent) ([]string, error)
+}
+
+// PathFilterResult tells if an event matched, and why
+type PathFilterResult struct {
+	Match     bool     // Should the event be considered a match?
+	Matches   []string // Changed files that matched
+	Truncated bool     // Was the list of changed files in the event incomplete?
+	Fetched   bool     // Did we use FetchFiles to get the complete list?
+}
+
+// NewPathFilter returns a filter matching files that match at least one include
+// pattern (or any file, if there are no include patterns) and no exclude pattern
+func NewPathFilter(include, exclude []string) (*PathFilter, error) {
+	f := &PathFilter{}
+	var err error
+	if f.include, err = compileGlobs(include); err != nil {
+		return nil, err
+	}
+	if f.exclude, err = compileGlobs(exclude); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func compileGlobs(patterns []string) ([][]string, error) {
+	compiled := [][]string{}
+	for _, pattern := range patterns {
+		expanded, err := expandBraces(pattern)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range expanded {
+			segments := strings.Split(strings.TrimPrefix(p, "/"), "/")
+			for _, segment := range segments {
+				if segment == "**" {
+					continue
+				}
+				if _, err := path.Match(segment, ""); err != nil {
+					return nil, fmt.Errorf("invalid pattern \"%s\": %v", pattern, err)
+				}
+			}
+			compiled = append(compiled, segments)
+		}
+	}
+	return compiled, nil
+}
+
+// expandBraces turns "a/{b,c}/*.{yml,yaml}" into four patterns without braces
+func expandBraces(pattern string) ([]string, error) {
+	start := strings.IndexByte(pattern, '{')
+	if start < 0 {
+		if strings.IndexByte(pattern, '}') >= 0 {
+			return nil, fmt.Errorf("invalid pattern \"%s\": unmatched \"}\"", pattern)
+		}
+		return []string{pattern}, nil
+	}
+	depth, end, alternatives, last := 0, -1, []string{}, start+1
+	for i := start; i < len(pattern) && end < 0; i++ {
+		switch pattern[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				alternatives = append(alternatives, pattern[last:i])
+				end = i
+			}
+		case ',':
+			if depth == 1 {
+				alternatives = append(alternatives, pattern[last:i])
+				last = i + 1
+			}
+		}
+	}
+	if end < 0 {
+		return nil, fmt.Errorf("invalid pattern \"%s\": unmatched \"{\"", pattern)
+	}
+	expanded := []string{}
+	for _, alternative := range alternatives {
+		more, err := expandBraces(pattern[:start] + alternative + pattern[end+1:])
+		if err != nil {
+			return nil, err
+		}
+		expanded = append(expanded, more...)
+	}
+	return expanded, nil
+}
+
+func matchSegments(pattern, segments []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			for i := 0; i <= len(segments); i++ {
+				if matchSegments(pattern[1:], segments[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(segments) == 0 {
+			return false
+		}
+		if ok, _ := path.Match(pattern[0], segments[0]); !ok {
+			return false
+		}
+		pattern, segments = pattern[1:], segments[1:]
+	}
+	return len(segments) == 0
+}
+
+func matchAny(patterns [][]string, segments []string) bool {
+	for _, pattern := range patterns {
+		if matchSegments(pattern, segments) {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchPath tells if a single file path matches the filter
+func (f *PathFilter) MatchPath(file string) bool {
+	segments := strings.Split(strings.TrimPrefix(file, "/"), "/")
+	if len(f.include) > 0 && !matchAny(f.include, segments) {
+		return false
+	}
+	return !matchAny(f.exclude, segments)
+}
+
+// MatchFiles returns the files that match the filter
+func (f *PathFilter) MatchFiles(files []string) []string {
+	matches := []string{}
+	for _, file := range files {
+		if f.MatchPath(file) {
+			matches = append(matches, file)
+		}
+	}
+	return matches
+}
+
+// IsTruncated tells if the commit or file lists of a push event are (probably)
+// incomplete
+func (e *PushEvent) IsTruncated() bool {
+	if len(e.GetCommits()) >= MaxPushCommits {
+		return true
+	}
+	files := 0
+	for _, commit := range e.GetCommits() {
+		files += len(commit.Added) + len(commit.Removed) + len(commit.Modified)
+	}
+	if files >= MaxPushFiles {
+		return true
+	}
+	// The head commit is the last one pushed. If it isn't listed, commits are missing
+	if head := e.GetHeadCommit().GetID(); head != "" && len(e.Commits) > 0 && e.Commits[len(e.Commits)-1].GetID() != head {
+		return true
+	}
+	return false
+}
+
+// MatchPush matches the files changed by a push event (see PushEvent.ChangedFiles)
+func (f *PathFilter) MatchPush(e *PushEvent) (PathFilterResult, error) {
+	result := PathFilterResult{Truncated: e.IsTruncated()}
+	files := e.ChangedFiles().All()
+	if result.Truncated {
+		if f.FetchFiles == nil {
+			result.Matches = f.MatchFiles(files)
+			result.Match = true
+			return result, nil
+		}
+		fetched, err := f.FetchFiles(e)
+		if err != nil {
+			return result, err
+		}
+		files = fetched
+		result.Fetched = true
+	}
+	result.Matches = f.MatchFiles(files)
+	result.Match = len(result.Matches) > 0
+	return result, nil
+}
+
+// MatchPullRequest matches the files changed by a pull request. Pull request events
+// don't list files, so they have to be fetched by the caller (e.g. from the
+// /repos/{owner}/{repo}/pulls/{number}/files endpoint). If there are fewer files than
+// pull_request.changed_files says, the list is considered truncated, and the pull
+// request a match
+func (f *PathFilter) MatchPullRequest(e *PullRequestEvent, files []string) PathFilterResult {
+	result := PathFilterResult{Matches: f.MatchFiles(files)}
+	result.Truncated = len(files) < e.GetPullRequest().GetChangedFiles()
+	result.Match = result.Truncated || len(result.Matches) > 0
+	return result
+}
@@ -0,0 +1,135 @@
+package ghevent
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestPathFilterMatchPath(t *testing.T) {
+	tests := []struct {
+		include, exclude []string
+		file             string
+		want             bool
+	}{
+		{nil, nil, "anything/at/all.go", true},
+		{[]string{"*.go"}, nil, "main.go", true},
+		{[]string{"*.go"}, nil, "cmd/main.go", false},
+		{[]string{"**/*.go"}, nil, "cmd/main.go", true},
+		{[]string{"**/*.go"}, nil, "main.go", true},
+		{[]string{"docs/**"}, nil, "docs/a/b/c.md", true},
+		{[]string{"docs/**"}, nil, "docs", true},
+		{[]string{"docs/**"}, nil, "src/docs/a.md", false},
+		{[]string{"services/*/Dockerfile"}, nil, "services/api/Dockerfile", true},
+		{[]string{"services/*/Dockerfile"}, nil, "services/api/v2/Dockerfile", false},
+		{[]string{"**/*.{yml,yaml}"}, nil, ".github/workflows/ci.yaml", true},
+		{[]string{"{frontend,backend}/**"}, nil, "backend/x.go", true},
+		{[]string{"{frontend,backend}/**"}, nil, "infra/x.tf", false},
+		{[]string{"file?.txt"}, nil, "file1.txt", true},
+		{[]string{"[a-c]*.txt"}, nil, "b.txt", true},
+		{[]string{"[a-c]*.txt"}, nil, "d.txt", false},
+		{[]string{"**"}, []string{"**/*.md"}, "docs/README.md", false},
+		{[]string{"**"}, []string{"**/*.md"}, "main.go", true},
+		{nil, []string{"vendor/**"}, "vendor/x/y.go", false},
+		{[]string{"/rooted/*.go"}, nil, "rooted/a.go", true},
+	}
+	for _, test := range tests {
+		f, err := NewPathFilter(test.include, test.exclude)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := f.MatchPath(test.file); got != test.want {
+			t.Errorf("include %v, exclude %v: MatchPath(\"%s\") was %v (should have been %v)", test.include, test.exclude, test.file, got, test.want)
+		}
+	}
+}
+
+func TestPathFilterInvalidPatterns(t *testing.T) {
+	for _, pattern := range []string{"[a-", "{a,b", "a}", "x/[/y"} {
+		if _, err := NewPathFilter([]string{pattern}, nil); err == nil {
+			t.Errorf("NewPathFilter() didn't fail on \"%s\"", pattern)
+		}
+	}
+}
+
+func TestPathFilterMatchPush(t *testing.T) {
+	owner := NewAccountBuilder("Codertocat").Build()
+	repo := NewRepositoryBuilder(owner, "Hello-World").Build()
+	f, err := NewPathFilter([]string{"backend/**"}, []string{"**/*.md"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := NewPushEventBuilder(repo).
+		Commit("one", []string{"backend/api.go"}, nil, []string{"README.md"}).
+		Commit("two", nil, nil, []string{"backend/docs.md", "frontend/app.js"}).
+		Build()
+	result, err := f.MatchPush(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result.Match || result.Truncated || !reflect.DeepEqual(result.Matches, []string{"backend/api.go"}) {
+		t.Errorf("MatchPush() was %+v", result)
+	}
+	e = NewPushEventBuilder(repo).Commit("one", nil, nil, []string{"frontend/app.js"}).Build()
+	if result, _ := f.MatchPush(e); result.Match {
+		t.Errorf("MatchPush() matched a push that only changed frontend/app.js: %+v", result)
+	}
+}
+
+func TestPathFilterTruncatedPush(t *testing.T) {
+	owner := NewAccountBuilder("Codertocat").Build()
+	repo := NewRepositoryBuilder(owner, "Hello-World").Build()
+	b := NewPushEventBuilder(repo)
+	for i := 0; i < MaxPushCommits; i++ {
+		b.Commit(fmt.Sprintf("commit %d", i), nil, nil, []string{"frontend/app.js"})
+	}
+	e := b.Build()
+	f, _ := NewPathFilter([]string{"backend/**"}, nil)
+	result, err := f.MatchPush(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result.Truncated || !result.Match || len(result.Matches) != 0 {
+		t.Errorf("MatchPush() on a truncated push without FetchFiles was %+v", result)
+	}
+	f.FetchFiles = func(*PushEvent) ([]string, error) {
+		return []string{"frontend/app.js", "backend/db.go"}, nil
+	}
+	result, err = f.MatchPush(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result.Fetched || !result.Match || !reflect.DeepEqual(result.Matches, []string{"backend/db.go"}) {
+		t.Errorf("MatchPush() on a truncated push with FetchFiles was %+v", result)
+	}
+	f.FetchFiles = func(*PushEvent) ([]string, error) {
+		return nil, errors.New("API is down")
+	}
+	if _, err := f.MatchPush(e); err == nil {
+		t.Error("MatchPush() didn't return the FetchFiles error")
+	}
+	// The head commit not being the last listed commit also means truncation
+	short := NewPushEventBuilder(repo).Commit("one", nil, nil, nil).Build()
+	short.HeadCommit = &Commit{ID: Ptr("ffffffffffffffffffffffffffffffffffffffff")}
+	if !short.IsTruncated() {
+		t.Error("IsTruncated() was false when head_commit wasn't among the commits")
+	}
+}
+
+func TestPathFilterMatchPullRequest(t *testing.T) {
+	owner := NewAccountBuilder("Codertocat").Build()
+	repo := NewRepositoryBuilder(owner, "Hello-World").Build()
+	e := NewPullRequestEventBuilder("opened", repo, 1).Build()
+	e.PullRequest.ChangedFiles = Ptr(2)
+	f, _ := NewPathFilter([]string{"backend/**"}, nil)
+	if result := f.MatchPullRequest(e, []string{"frontend/a.js", "backend/b.go"}); !result.Match || result.Truncated {
+		t.Errorf("MatchPullRequest() was %+v", result)
+	}
+	if result := f.MatchPullRequest(e, []string{"frontend/a.js", "frontend/b.js"}); result.Match {
+		t.Errorf("MatchPullRequest() was %+v", result)
+	}
+	if result := f.MatchPullRequest(e, []string{"frontend/a.js"}); !result.Match || !result.Truncated {
+		t.Errorf("MatchPullRequest() with fewer files than changed_files was %+v", result)
+	}
+}
@@ -0,0 +1,339 @@
+package ghevent
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+//
+// Typed installation permissions and event subscriptions. Installation.Permissions
+// and Installation.Events are as Github sends them, a map and a list of strings;
+// Permissions and EventSet are the same checked against the names Github (and this
+// package) knows:
+//
+//   permissions, err := event.Installation.TypedPermissions()
+//   if permissions.Contents < ghevent.WritePermission {
+//       ...
+//   }
+//   for _, change := range permissions.Escalations(previous) {
+//       fmt.Printf("%s: %s -> %s\n", change.Permission, change.From, change.To)
+//   }
+//
+
+type PermissionLevel int
+
+const (
+	NoPermission PermissionLevel = iota
+	ReadPermission
+	WritePermission
+	AdminPermission
+)
+
+var permissionLevelNames = []string{"none", "read", "write", "admin"}
+
+func (l PermissionLevel) String() string {
+	if l < 0 || int(l) >= len(permissionLevelNames) {
+		return fmt.Sprintf("PermissionLevel(%d)", int(l))
+	}
+	return permissionLevelNames[l]
+}
+
+// ParsePermissionLevel returns the level for "read", "write" or "admin" ("" and
+// "none" are NoPermission)
+func ParsePermissionLevel(s string) (PermissionLevel, error) {
+	if s == "" {
+		return NoPermission, nil
+	}
+	for i, name := range permissionLevelNames {
+		if s == name {
+			return PermissionLevel(i), nil
+		}
+	}
+	return NoPermission, fmt.Errorf("unknown permission level \"%s\"", s)
+}
+
+func (l PermissionLevel) MarshalJSON() ([]byte, error) {
+	return json.Marshal(l.String())
+}
+
+func (l *PermissionLevel) UnmarshalJSON(data []byte) error {
+	s := ""
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	level, err := ParsePermissionLevel(s)
+	*l = level
+	return err
+}
+
+// Permissions of a Github App installation. Names are the ones in payloads.
+// Permissions without a field (ones Github added since) are kept in Other, so they
+// are still compared
+type Permissions struct {
+	Actions                       PermissionLevel `json:"actions,omitempty"`
+	Administration                PermissionLevel `json:"administration,omitempty"`
+	Checks                        PermissionLevel `json:"checks,omitempty"`
+	Codespaces                    PermissionLevel `json:"codespaces,omitempty"`
+	Contents                      PermissionLevel `json:"contents,omitempty"`
+	DependabotSecrets             PermissionLevel `json:"dependabot_secrets,omitempty"`
+	Deployments                   PermissionLevel `json:"deployments,omitempty"`
+	Discussions                   PermissionLevel `json:"discussions,omitempty"`
+	Environments                  PermissionLevel `json:"environments,omitempty"`
+	Issues                        PermissionLevel `json:"issues,omitempty"`
+	MergeQueues                   PermissionLevel `json:"merge_queues,omitempty"`
+	Metadata                      PermissionLevel `json:"metadata,omitempty"`
+	Packages                      PermissionLevel `json:"packages,omitempty"`
+	Pages                         PermissionLevel `json:"pages,omitempty"`
+	PullRequests                  PermissionLevel `json:"pull_requests,omitempty"`
+	RepositoryCustomProperties    PermissionLevel `json:"repository_custom_properties,omitempty"`
+	RepositoryHooks               PermissionLevel `json:"repository_hooks,omitempty"`
+	RepositoryProjects            PermissionLevel `json:"repository_projects,omitempty"`
+	SecretScanningAlerts          PermissionLevel `json:"secret_scanning_alerts,omitempty"`
+	Secrets                       PermissionLevel `json:"secrets,omitempty"`
+	SecurityEvents                PermissionLevel `json:"security_events,omitempty"`
+	SingleFile                    PermissionLevel `json:"single_file,omitempty"`
+	Statuses                      PermissionLevel `json:"statuses,omitempty"`
+	VulnerabilityAlerts           PermissionLevel `json:"vulnerability_alerts,omitempty"`
+	Workflows                     PermissionLevel `json:"workflows,omitempty"`
+	Members                       PermissionLevel `json:"members,omitempty"`
+	OrganizationAdministration    PermissionLevel `json:"organization_administration,omitempty"`
+	OrganizationHooks             PermissionLevel `json:"organization_hooks,omitempty"`
+	OrganizationPackages          PermissionLevel `json:"organization_packages,omitempty"`
+	OrganizationPlan              PermissionLevel `json:"organization_plan,omitempty"`
+	OrganizationProjects          PermissionLevel `json:"organization_projects,omitempty"`
+	OrganizationSecrets           PermissionLevel `json:"organization_secrets,omitempty"`
+	OrganizationSelfHostedRunners PermissionLevel `json:"organization_self_hosted_runners,omitempty"`
+	OrganizationUserBlocking      PermissionLevel `json:"organization_user_blocking,omitempty"`
+	TeamDiscussions               PermissionLevel `json:"team_discussions,omitempty"`
+	Emails                        PermissionLevel `json:"emails,omitempty"`
+
+	Other map[string]PermissionLevel `json:"-"` // Permission name -> level
+}
+
+// permissionFields maps permission names to Permissions field indexes
+var permissionFields = func() map[string]int {
+	fields := map[string]int{}
+	t := reflect.TypeOf(Permissions{})
+	for i := 0; i < t.NumField(); i++ {
+		if name, ok := jsonFieldName(t.Field(i)); ok {
+			fields[name] = i
+		}
+	}
+	return fields
+}()
+
+// PermissionNames returns the (sorted) permission names Permissions has fields for
+func PermissionNames() []string {
+	names := make([]string, 0, len(permissionFields))
+	for name := range permissionFields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// names returns the (sorted) permission names with fields, and the ones in the Other
+// of any of ps
+func (p *Permissions) names(ps ...*Permissions) []string {
+	names := PermissionNames()
+	for _, p := range append(ps, p) {
+		if p == nil {
+			continue
+		}
+		for name := range p.Other {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	unique := names[:0]
+	for i, name := range names {
+		if i == 0 || name != names[i-1] {
+			unique = append(unique, name)
+		}
+	}
+	return unique
+}
+
+// ParsePermissions converts permissions as Github sends them. Unknown permission
+// names or levels are errors, but everything else is still set: permissions with an
+// unknown name (and a known level) go in Other
+func ParsePermissions(m map[string]string) (*Permissions, error) {
+	p := &Permissions{}
+	unknown := []string{}
+	for name, s := range m {
+		level, err := ParsePermissionLevel(s)
+		if err != nil {
+			unknown = append(unknown, fmt.Sprintf("%s level \"%s\"", name, s))
+			continue
+		}
+		if !p.Set(name, level) {
+			unknown = append(unknown, fmt.Sprintf("\"%s\"", name))
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return p, fmt.Errorf("unknown permissions: %s", strings.Join(unknown, ", "))
+	}
+	return p, nil
+}
+
+// Get returns the level of a permission, from Other for names without a field
+// (NoPermission if p is nil)
+func (p *Permissions) Get(name string) PermissionLevel {
+	if p == nil {
+		return NoPermission
+	}
+	i, ok := permissionFields[name]
+	if !ok {
+		return p.Other[name]
+	}
+	return PermissionLevel(reflect.ValueOf(p).Elem().Field(i).Int())
+}
+
+// Set sets the level of a permission, in Other for names without a field, and
+// tells if the name has a field
+func (p *Permissions) Set(name string, level PermissionLevel) bool {
+	i, ok := permissionFields[name]
+	switch {
+	case ok:
+		reflect.ValueOf(p).Elem().Field(i).SetInt(int64(level))
+	case level == NoPermission:
+		delete(p.Other, name)
+	default:
+		if p.Other == nil {
+			p.Other = map[string]PermissionLevel{}
+		}
+		p.Other[name] = level
+	}
+	return ok
+}
+
+// Map returns the permissions as Github sends them, without NoPermission ones
+func (p *Permissions) Map() map[string]string {
+	m := map[string]string{}
+	for _, name := range p.names() {
+		if level := p.Get(name); level != NoPermission {
+			m[name] = level.String()
+		}
+	}
+	return m
+}
+
+func (p *Permissions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.Map())
+}
+
+// UnmarshalJSON sets the permissions in data, keeping what p has for others
+func (p *Permissions) UnmarshalJSON(data []byte) error {
+	m := map[string]PermissionLevel{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return err
+	}
+	for name, level := range m {
+		p.Set(name, level)
+	}
+	return nil
+}
+
+// Covers tells if p has at least the levels of required
+func (p *Permissions) Covers(required *Permissions) bool {
+	for _, name := range p.names(required) {
+		if p.Get(name) < required.Get(name) {
+			return false
+		}
+	}
+	return true
+}
+
+type PermissionChange struct {
+	Permission string
+	From, To   PermissionLevel
+}
+
+// Changes returns the permissions that differ from before, sorted by name
+func (p *Permissions) Changes(before *Permissions) []PermissionChange {
+	changes := []PermissionChange{}
+	for _, name := range p.names(before) {
+		if from, to := before.Get(name), p.Get(name); from != to {
+			changes = append(changes, PermissionChange{Permission: name, From: from, To: to})
+		}
+	}
+	return changes
+}
+
+// Escalations returns the permissions that are higher than before, sorted by name
+func (p *Permissions) Escalations(before *Permissions) []PermissionChange {
+	escalations := []PermissionChange{}
+	for _, change := range p.Changes(before) {
+		if change.To > change.From {
+			escalations = append(escalations, change)
+		}
+	}
+	return escalations
+}
+
+// TypedPermissions returns the installation's permissions as Permissions (see
+// ParsePermissions)
+func (i *Installation) TypedPermissions() (*Permissions, error) {
+	return ParsePermissions(i.GetPermissions())
+}
+
+// EventSet is a set of X-Github-Event names this package can decode
+type EventSet map[string]bool
+
+// ParseEventSet returns the set of events, as in Installation.Events. Events this
+// package doesn't know are errors, but the known ones are still in the set
+func ParseEventSet(names []string) (EventSet, error) {
+	set := EventSet{}
+	unknown := []string{}
+	for _, name := range names {
+		if _, ok := eventTypes[name]; !ok {
+			unknown = append(unknown, fmt.Sprintf("\"%s\"", name))
+			continue
+		}
+		set[name] = true
+	}
+	if len(unknown) > 0 {
+		return set, fmt.Errorf("unknown event types: %s", strings.Join(unknown, ", "))
+	}
+	return set, nil
+}
+
+// Has tells if an event type is in the set
+func (s EventSet) Has(eventType string) bool {
+	return s[eventType]
+}
+
+// HasEvent tells if the type of an event struct is in the set
+func (s EventSet) HasEvent(event interface{}) bool {
+	return s[EventTypeOf(event)]
+}
+
+// Names returns the (sorted) event types in the set
+func (s EventSet) Names() []string {
+	names := make([]string, 0, len(s))
+	for name := range s {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Added returns the (sorted) event types in s that aren't in before
+func (s EventSet) Added(before EventSet) []string {
+	added := []string{}
+	for _, name := range s.Names() {
+		if !before[name] {
+			added = append(added, name)
+		}
+	}
+	return added
+}
+
+// EventSet returns the installation's events as an EventSet (see ParseEventSet)
+func (i *Installation) EventSet() (EventSet, error) {
+	return ParseEventSet(i.Events)
+}
@@ -0,0 +1,129 @@
+package ghevent
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParsePermissions(t *testing.T) {
+	p, err := ParsePermissions(map[string]string{"contents": "read", "pull_requests": "write", "administration": "admin"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Contents != ReadPermission || p.PullRequests != WritePermission || p.Administration != AdminPermission || p.Issues != NoPermission {
+		t.Errorf("parsed permissions are %+v (should be contents:read, pull_requests:write, administration:admin)", p)
+	}
+	if m := p.Map(); !reflect.DeepEqual(m, map[string]string{"contents": "read", "pull_requests": "write", "administration": "admin"}) {
+		t.Errorf("Map returned %v (should be what was parsed)", m)
+	}
+
+	p, err = ParsePermissions(map[string]string{"pull_request": "write", "issues": "writ", "metadata": "read"})
+	if err == nil || !strings.Contains(err.Error(), `"pull_request"`) || !strings.Contains(err.Error(), `issues level "writ"`) {
+		t.Errorf("parsing typos returned error %v (should name both)", err)
+	}
+	if p.Metadata != ReadPermission {
+		t.Errorf("metadata is %s with typos in other permissions (should be read)", p.Metadata)
+	}
+	if p.Get("pull_request") != WritePermission || p.Other["pull_request"] != WritePermission {
+		t.Errorf("unknown permission pull_request is %s (should be kept in Other as write)", p.Get("pull_request"))
+	}
+
+	p, err = ParsePermissions(map[string]string{"discussions": "write", "emails": "read", "merge_queues": "write"})
+	if err != nil || p.Discussions != WritePermission || p.Emails != ReadPermission || p.MergeQueues != WritePermission {
+		t.Errorf("parsed permissions are %+v, %v (should be discussions:write, emails:read, merge_queues:write)", p, err)
+	}
+
+	data, err := json.Marshal(&Permissions{Checks: WritePermission})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"checks":"write"}` {
+		t.Errorf("marshaled permissions are %s (should be {\"checks\":\"write\"})", data)
+	}
+	decoded := &Permissions{}
+	if err := json.Unmarshal([]byte(`{"checks":"write","issues":"none","future":"read"}`), decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Checks != WritePermission || decoded.Issues != NoPermission || decoded.Get("future") != ReadPermission {
+		t.Errorf("unmarshaled permissions are %+v (should be checks:write, future:read)", decoded)
+	}
+	if data, _ := json.Marshal(decoded); string(data) != `{"checks":"write","future":"read"}` {
+		t.Errorf("marshaled permissions are %s (should include future:read)", data)
+	}
+	if err := json.Unmarshal([]byte(`{"checks":"owner"}`), decoded); err == nil {
+		t.Errorf("unmarshaling level \"owner\" succeeded (should fail)")
+	}
+}
+
+func TestPermissionEscalations(t *testing.T) {
+	before := &Permissions{Contents: ReadPermission, Issues: WritePermission, Metadata: ReadPermission}
+	after := &Permissions{Contents: WritePermission, Issues: ReadPermission, Metadata: ReadPermission, Checks: WritePermission}
+	expected := []PermissionChange{
+		{Permission: "checks", From: NoPermission, To: WritePermission},
+		{Permission: "contents", From: ReadPermission, To: WritePermission},
+	}
+	if escalations := after.Escalations(before); !reflect.DeepEqual(escalations, expected) {
+		t.Errorf("Escalations returned %v (should be %v)", escalations, expected)
+	}
+	if changes := after.Changes(before); len(changes) != 3 {
+		t.Errorf("Changes returned %v (should have 3 changes)", changes)
+	}
+	if after.Covers(before) {
+		t.Errorf("after covers before (should not, issues was lowered)")
+	}
+	if !after.Covers(&Permissions{Contents: ReadPermission}) {
+		t.Errorf("after doesn't cover contents:read (should)")
+	}
+
+	// Permissions without a field are compared too
+	before, _ = ParsePermissions(map[string]string{"metadata": "read", "future": "read", "gone": "read"})
+	after, _ = ParsePermissions(map[string]string{"metadata": "read", "future": "admin"})
+	expected = []PermissionChange{{Permission: "future", From: ReadPermission, To: AdminPermission}}
+	if escalations := after.Escalations(before); !reflect.DeepEqual(escalations, expected) {
+		t.Errorf("Escalations returned %v (should be %v)", escalations, expected)
+	}
+	if changes := after.Changes(before); len(changes) != 2 || after.Covers(before) || !before.Covers(&Permissions{}) {
+		t.Errorf("Changes returned %v (should have future and gone), or Covers ignores other permissions", changes)
+	}
+
+	// From the fixtures, through the registry
+	r := NewInstallationRegistry()
+	applyFixture(t, r, "installation", "created")
+	applyFixture(t, r, "installation", "new_permissions_accepted")
+	escalations, err := r.Installation(2311213).Escalations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = []PermissionChange{
+		{Permission: "checks", From: NoPermission, To: WritePermission},
+		{Permission: "contents", From: ReadPermission, To: WritePermission},
+	}
+	if !reflect.DeepEqual(escalations, expected) {
+		t.Errorf("registry escalations are %v (should be %v)", escalations, expected)
+	}
+}
+
+func TestParseEventSet(t *testing.T) {
+	set, err := ParseEventSet([]string{"push", "issues", "pull_requests"})
+	if err == nil || !strings.Contains(err.Error(), `"pull_requests"`) {
+		t.Errorf("parsing \"pull_requests\" returned error %v (should name it)", err)
+	}
+	if names := set.Names(); !reflect.DeepEqual(names, []string{"issues", "push"}) {
+		t.Errorf("set has %v (should have [issues push])", names)
+	}
+	if !set.Has("push") || set.Has("label") {
+		t.Errorf("set has push: %t, label: %t (should be true, false)", set.Has("push"), set.Has("label"))
+	}
+	if !set.HasEvent(&IssuesEvent{}) || set.HasEvent(&LabelEvent{}) {
+		t.Errorf("HasEvent doesn't match the set")
+	}
+	more, err := ParseEventSet([]string{"push", "issues", "label"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if added := more.Added(set); !reflect.DeepEqual(added, []string{"label"}) {
+		t.Errorf("Added returned %v (should be [label])", added)
+	}
+}
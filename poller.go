@@ -0,0 +1,199 @@
+package ghevent
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+//
+// Polling the Events API, for when webhooks can't be used:
+//
+//   poller := ghevent.NewRepositoryPoller(client, "Codertocat", "Hello-World")
+//   events := make(chan ghevent.PolledEvent)
+//   go poller.Run(ctx, events)
+//   for e := range events {
+//       switch event := e.Event.(type) {
+//       case *ghevent.PushEvent:
+//       ...
+//
+// - Polls are no more frequent than X-Poll-Interval says (or Interval, if longer)
+// - Polls are conditional (ETag), so unchanged responses don't use up the rate limit
+// - Polls follow the next page links until a page has only events seen before, so
+//   no events are missed when more than a page of them happened between polls
+// - Events are de-duplicated by ID, and emitted oldest first
+//
+
+const (
+	DefaultPollInterval = time.Minute
+	maxSeenEvents       = 1000 // The API returns at most 300 events, so this is plenty
+)
+
+type Poller struct {
+	Client       *Client
+	Path         string        // e.g. "/repos/{owner}/{repo}/events"
+	Interval     time.Duration // The shortest time between polls, defaults to DefaultPollInterval
+	SkipExisting bool          // Only emit events that show up after the first poll
+	OnError      func(error)   // Called with errors if set; otherwise Run returns them
+
+	mu           sync.Mutex
+	seen         map[string]bool
+	seenOrder    []string
+	polled       bool
+	pollInterval time.Duration // From X-Poll-Interval
+}
+
+// PolledEvent is an Events API event, and the payload decoded into an event struct
+// (see APIEvent.Decode). Event is nil for types this package can't decode, with Err
+// set if decoding failed
+type PolledEvent struct {
+	API   *APIEvent
+	Event interface{}
+	Err   error
+}
+
+// NewRepositoryPoller returns a poller for the events of a repository
+func NewRepositoryPoller(client *Client, owner, repo string) *Poller {
+	return &Poller{Client: client, Path: repoPath(owner, repo) + "/events"}
+}
+
+// NewOrganizationPoller returns a poller for the events of an organization
+func NewOrganizationPoller(client *Client, org string) *Poller {
+	return &Poller{Client: client, Path: "/orgs/" + url.PathEscape(org) + "/events"}
+}
+
+// NextInterval returns how long to wait between polls
+func (p *Poller) NextInterval() time.Duration {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	interval := p.Interval
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+	if p.pollInterval > interval {
+		interval = p.pollInterval
+	}
+	return interval
+}
+
+// Poll gets the latest events, and returns the ones not seen before, oldest first.
+// Pages are requested until one has only events seen before (or, on a first poll
+// skipping existing events, only the first)
+func (p *Poller) Poll(ctx context.Context) ([]PolledEvent, error) {
+	query := url.Values{"per_page": {strconv.Itoa(defaultPerPage)}}
+	u := p.Client.url(p.Path, query)
+	apiEvents := []*APIEvent{}
+	for page := 1; u != ""; page++ {
+		body, header, err := p.Client.get(ctx, u)
+		if err != nil {
+			return nil, err
+		}
+		if page == 1 {
+			p.setPollInterval(header)
+		}
+		pageEvents := []*APIEvent{}
+		if err := json.Unmarshal(body, &pageEvents); err != nil {
+			return nil, err
+		}
+		apiEvents = append(apiEvents, pageEvents...)
+		if p.seenAll(pageEvents) {
+			break
+		}
+		u = nextPageURL(header.Get("Link"))
+	}
+	// Newest first, usually, but the order of events with the same created_at isn't
+	// defined
+	sort.SliceStable(apiEvents, func(i, j int) bool {
+		return apiEvents[i].GetCreatedAt().Before(apiEvents[j].GetCreatedAt())
+	})
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	skip := p.SkipExisting && !p.polled
+	p.polled = true
+	events := []PolledEvent{}
+	for _, apiEvent := range apiEvents {
+		if !p.markSeen(apiEvent.GetID()) || skip {
+			continue
+		}
+		polled := PolledEvent{API: apiEvent}
+		if apiEvent.EventType() != "" {
+			polled.Event, polled.Err = apiEvent.Decode()
+		}
+		events = append(events, polled)
+	}
+	return events, nil
+}
+
+func (p *Poller) setPollInterval(header http.Header) {
+	if seconds, err := strconv.Atoi(header.Get("X-Poll-Interval")); err == nil {
+		p.mu.Lock()
+		p.pollInterval = time.Duration(seconds) * time.Second
+		p.mu.Unlock()
+	}
+}
+
+// seenAll tells if there is no need to look further back than a page of events:
+// all of them were seen before, or existing events are being skipped
+func (p *Poller) seenAll(events []*APIEvent) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.SkipExisting && !p.polled {
+		return true
+	}
+	for _, event := range events {
+		if !p.seen[event.GetID()] {
+			return false
+		}
+	}
+	return true
+}
+
+// markSeen records an event ID, returning false if it had been seen already
+func (p *Poller) markSeen(id string) bool {
+	if p.seen == nil {
+		p.seen = map[string]bool{}
+	}
+	if p.seen[id] {
+		return false
+	}
+	p.seen[id] = true
+	p.seenOrder = append(p.seenOrder, id)
+	if len(p.seenOrder) > maxSeenEvents {
+		delete(p.seen, p.seenOrder[0])
+		p.seenOrder = p.seenOrder[1:]
+	}
+	return true
+}
+
+// Run polls until ctx is done, sending new events to events. It returns ctx.Err(),
+// or the first error polling if OnError is nil
+func (p *Poller) Run(ctx context.Context, events chan<- PolledEvent) error {
+	for {
+		polled, err := p.Poll(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if p.OnError == nil {
+				return err
+			}
+			p.OnError(err)
+		}
+		for _, event := range polled {
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		if err := p.Client.pause(ctx, p.NextInterval()); err != nil {
+			return err
+		}
+	}
+}
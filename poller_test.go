@@ -0,0 +1,213 @@
+package ghevent
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// eventsHandler serves the events set by setEvents, newest first and in pages of
+// per_page, with ETags and X-Poll-Interval (interval, or 90)
+type eventsHandler struct {
+	mu       sync.Mutex
+	events   []string
+	etag     string
+	interval string
+}
+
+func (h *eventsHandler) setEvents(ids ...int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.events = nil
+	for _, id := range ids {
+		created := builderTime.Add(time.Duration(id) * time.Minute).Format(time.RFC3339)
+		h.events = append([]string{fmt.Sprintf(`{"id":"%d","type":"IssuesEvent","actor":{"login":"Codertocat"},"repo":{"name":"Codertocat/Hello-World"},"payload":{"action":"opened","issue":{"number":%d}},"created_at":"%s"}`, id, id, created)}, h.events...)
+	}
+	h.events = append(h.events, `{"id":"0","type":"WatchEvent","payload":{"action":"started"},"created_at":"2021-01-14T07:35:08Z"}`)
+	h.etag = fmt.Sprint(ids)
+}
+
+func (h *eventsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	interval := h.interval
+	if interval == "" {
+		interval = "90"
+	}
+	w.Header().Set("X-Poll-Interval", interval)
+	perPage, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	if page == 0 {
+		page = 1
+	}
+	etag := fmt.Sprintf(`"%s-%d"`, h.etag, page)
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	first, last := (page-1)*perPage, page*perPage
+	if last >= len(h.events) {
+		last = len(h.events)
+	} else {
+		next := *r.URL
+		q := next.Query()
+		q.Set("page", strconv.Itoa(page+1))
+		next.RawQuery = q.Encode()
+		w.Header().Set("Link", fmt.Sprintf(`<http://%s%s>; rel="next"`, r.Host, next.RequestURI()))
+	}
+	if first > last {
+		first = last
+	}
+	w.Header().Set("ETag", etag)
+	fmt.Fprintf(w, "[%s]", strings.Join(h.events[first:last], ","))
+}
+
+func polledIssueNumbers(events []PolledEvent) string {
+	numbers := []string{}
+	for _, e := range events {
+		if issues, ok := e.Event.(*IssuesEvent); ok {
+			numbers = append(numbers, fmt.Sprint(issues.GetIssue().GetNumber()))
+		} else {
+			numbers = append(numbers, e.API.GetType())
+		}
+	}
+	return strings.Join(numbers, " ")
+}
+
+func TestPollerPoll(t *testing.T) {
+	handler := &eventsHandler{}
+	api := &fakeAPI{handlers: map[string]http.HandlerFunc{"/repos/Codertocat/Hello-World/events": handler.ServeHTTP}}
+	client, _ := newTestClient(t, api)
+	poller := NewRepositoryPoller(client, "Codertocat", "Hello-World")
+	ctx := context.Background()
+
+	tests := []struct {
+		ids  []int
+		want string
+	}{
+		{[]int{1, 2}, "WatchEvent 1 2"},
+		{[]int{1, 2}, ""}, // Not modified
+		{[]int{2, 3, 4}, "3 4"},
+		{[]int{5}, "5"},
+	}
+	for i, test := range tests {
+		handler.setEvents(test.ids...)
+		events, err := poller.Poll(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := polledIssueNumbers(events); got != test.want {
+			t.Errorf("poll %d returned %s (should be %s)", i+1, got, test.want)
+		}
+	}
+	if n := poller.NextInterval(); n != 90*time.Second {
+		t.Errorf("next interval is %v (should be 90s, from X-Poll-Interval)", n)
+	}
+	poller.Interval = 2 * time.Minute
+	if n := poller.NextInterval(); n != 2*time.Minute {
+		t.Errorf("next interval is %v (should be Interval)", n)
+	}
+
+	poller = NewRepositoryPoller(client, "Codertocat", "Hello-World")
+	poller.SkipExisting = true
+	for i, want := range []string{"", "6"} {
+		handler.setEvents(5, i+5)
+		if events, err := poller.Poll(ctx); err != nil || polledIssueNumbers(events) != want {
+			t.Errorf("poll %d skipping existing events returned %s, %v (should be %s)", i+1, polledIssueNumbers(events), err, want)
+		}
+	}
+}
+
+// Polls look further back than the first page until they get to events seen before
+func TestPollerPages(t *testing.T) {
+	handler := &eventsHandler{}
+	api := &fakeAPI{handlers: map[string]http.HandlerFunc{"/repos/Codertocat/Hello-World/events": handler.ServeHTTP}}
+	client, _ := newTestClient(t, api)
+	poller := NewRepositoryPoller(client, "Codertocat", "Hello-World")
+	ctx := context.Background()
+	ids := func(first, last int) []int {
+		ids := []int{}
+		for id := first; id <= last; id++ {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+
+	tests := []struct {
+		ids      []int
+		events   int
+		requests int
+	}{
+		{ids(1, 250), 251, 3}, // 250 issues and the watch event
+		{ids(1, 260), 10, 2},  // The second page has only events seen before
+		{ids(1, 260), 0, 1},   // Not modified
+	}
+	for i, test := range tests {
+		handler.setEvents(test.ids...)
+		before := api.requestCount()
+		events, err := poller.Poll(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(events) != test.events || api.requestCount()-before != test.requests {
+			t.Errorf("poll %d returned %d events in %d requests (should be %d in %d)", i+1, len(events), api.requestCount()-before, test.events, test.requests)
+		}
+	}
+
+	// X-Poll-Interval is taken from the response, even if it is Not Modified
+	handler.mu.Lock()
+	handler.interval = "120"
+	handler.mu.Unlock()
+	if _, err := poller.Poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if n := poller.NextInterval(); n != 120*time.Second {
+		t.Errorf("next interval is %v after a Not Modified poll (should be 120s)", n)
+	}
+}
+
+func TestPollerRun(t *testing.T) {
+	handler := &eventsHandler{}
+	handler.setEvents(1)
+	api := &fakeAPI{handlers: map[string]http.HandlerFunc{"/orgs/Octocoders/events": handler.ServeHTTP}}
+	client, slept := newTestClient(t, api)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	polls := 0
+	client.sleep = func(ctx context.Context, d time.Duration) error {
+		*slept = append(*slept, d)
+		polls++
+		handler.setEvents(1, polls+1)
+		return nil
+	}
+	poller := NewOrganizationPoller(client, "Octocoders")
+	events := make(chan PolledEvent)
+	done := make(chan error)
+	go func() { done <- poller.Run(ctx, events) }()
+
+	got := []PolledEvent{}
+	for len(got) < 4 {
+		got = append(got, <-events)
+	}
+	cancel()
+	if err := <-done; err != context.Canceled {
+		t.Errorf("Run() returned %v (should be context.Canceled)", err)
+	}
+	if s := polledIssueNumbers(got); s != "WatchEvent 1 2 3" {
+		t.Errorf("Run() emitted %s", s)
+	}
+	if (*slept)[0] != 90*time.Second {
+		t.Errorf("Run() slept %v between polls (should be 90s)", (*slept)[0])
+	}
+
+	// Errors end Run, unless there's an OnError
+	poller = NewRepositoryPoller(client, "Codertocat", "nope")
+	if err := poller.Run(context.Background(), events); err == nil {
+		t.Errorf("Run() on a missing repository should fail")
+	}
+}
@@ -0,0 +1,198 @@
+package ghevent
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+//
+// A nil pointer in a decoded struct means the field was either missing from the JSON,
+// or explicitly null - encoding/json doesn't tell us which. Presence keeps track of
+// that, so users can check that they got the fields they need from an endpoint.
+//
+// Paths are the JSON field names joined with dots, e.g. "repository.owner.login".
+// Array elements are addressed by index ("commits.0.id"), and "*" matches any array
+// index or object key ("commits.*.id").
+//
+
+type Presence struct {
+	fields map[string]bool // path -> was it null?
+}
+
+// NewPresence records all field paths present in a JSON document
+func NewPresence(data []byte) (*Presence, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	p := &Presence{fields: map[string]bool{}}
+	p.walk("", v)
+	return p, nil
+}
+
+// DecodeWithPresence decodes data into v (like json.Unmarshal does) and also
+// returns the Presence of all fields in data
+func DecodeWithPresence(data []byte, v interface{}) (*Presence, error) {
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, err
+	}
+	return NewPresence(data)
+}
+
+func (p *Presence) walk(prefix string, v interface{}) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			p.add(prefix+key, value)
+		}
+	case []interface{}:
+		for i, value := range v {
+			p.add(prefix+strconv.Itoa(i), value)
+		}
+	}
+}
+
+func (p *Presence) add(path string, v interface{}) {
+	p.fields[path] = v == nil
+	p.walk(path+".", v)
+}
+
+// Has returns true if the field was present in the JSON, even if it was null
+func (p *Presence) Has(path string) bool {
+	return len(p.match(path)) > 0
+}
+
+// IsNull returns true if the field was present in the JSON, with a null value. With
+// wildcards, all matching fields have to be null
+func (p *Presence) IsNull(path string) bool {
+	matches := p.match(path)
+	for _, isNull := range matches {
+		if !isNull {
+			return false
+		}
+	}
+	return len(matches) > 0
+}
+
+// Fields returns the (sorted) paths of all fields that were present in the JSON
+func (p *Presence) Fields() []string {
+	paths := make([]string, 0, len(p.fields))
+	for path := range p.fields {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// Missing returns those of the given paths that were either absent or null, i.e.
+// the ones that will be nil in a decoded struct
+func (p *Presence) Missing(paths ...string) []string {
+	missing := []string{}
+	for _, path := range paths {
+		if !p.Has(path) || p.IsNull(path) {
+			missing = append(missing, path)
+		}
+	}
+	return missing
+}
+
+// match returns the null-ness of every recorded path matching the (possibly wildcarded) path
+func (p *Presence) match(path string) []bool {
+	if !strings.Contains(path, "*") {
+		if isNull, ok := p.fields[path]; ok {
+			return []bool{isNull}
+		}
+		return nil
+	}
+	want := strings.Split(path, ".")
+	matches := []bool{}
+	for field, isNull := range p.fields {
+		got := strings.Split(field, ".")
+		if len(got) != len(want) {
+			continue
+		}
+		ok := true
+		for i := range want {
+			if want[i] != "*" && want[i] != got[i] {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			matches = append(matches, isNull)
+		}
+	}
+	return matches
+}
+
+// UnmodelledFields returns the paths of fields in the JSON that v (e.g. a *PushEvent)
+// has no struct field for, i.e. data that is lost when decoding into v. Fields inside
+// an unmodelled field aren't listed
+func UnmodelledFields(data []byte, v interface{}) ([]string, error) {
+	unmodelled, _, err := compareModel(data, v)
+	return unmodelled, err
+}
+
+// AbsentFields returns the paths of struct fields of v (e.g. a *PushEvent) that are
+// missing from the JSON. Only fields of objects that are in the JSON are listed, e.g.
+// "repository.homepage" is only listed if there is a "repository" object
+func AbsentFields(data []byte, v interface{}) ([]string, error) {
+	_, absent, err := compareModel(data, v)
+	return absent, err
+}
+
+func compareModel(data []byte, v interface{}) (unmodelled, absent []string, err error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var doc interface{}
+	if err := dec.Decode(&doc); err != nil {
+		return nil, nil, err
+	}
+	unmodelled, absent = []string{}, []string{}
+	walkModel("", doc, reflect.TypeOf(v), &unmodelled, &absent)
+	sort.Strings(unmodelled)
+	sort.Strings(absent)
+	return unmodelled, absent, nil
+}
+
+func walkModel(prefix string, doc interface{}, t reflect.Type, unmodelled, absent *[]string) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch doc := doc.(type) {
+	case map[string]interface{}:
+		switch {
+		case t.Kind() == reflect.Map:
+			for key, value := range doc {
+				walkModel(prefix+key+".", value, t.Elem(), unmodelled, absent)
+			}
+		case t.Kind() == reflect.Struct && t != timeWrapperType:
+			for key, value := range doc {
+				i, ok := jsonFieldIndex(t, key)
+				if !ok {
+					*unmodelled = append(*unmodelled, prefix+key)
+					continue
+				}
+				walkModel(prefix+key+".", value, t.Field(i).Type, unmodelled, absent)
+			}
+			for i := 0; i < t.NumField(); i++ {
+				name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+				if _, ok := doc[name]; !ok && name != "" && name != "-" {
+					*absent = append(*absent, prefix+name)
+				}
+			}
+		}
+	case []interface{}:
+		if t.Kind() == reflect.Slice {
+			for i, value := range doc {
+				walkModel(prefix+strconv.Itoa(i)+".", value, t.Elem(), unmodelled, absent)
+			}
+		}
+	}
+}
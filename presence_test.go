@@ -0,0 +1,100 @@
+package ghevent
+
+import (
+	"io/ioutil"
+	"reflect"
+	"testing"
+)
+
+func TestPresence(t *testing.T) {
+	jsonStr := `{"ref":"refs/heads/master","base_ref":null,"commits":[{"id":"a"},{"id":"b","message":null}],"repository":{"owner":{"login":"Codertocat"}}}`
+	event := PushEvent{}
+	p, err := DecodeWithPresence([]byte(jsonStr), &event)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event.GetRef() != "refs/heads/master" {
+		t.Errorf("DecodeWithPresence() didn't decode the event (ref was \"%s\")", event.GetRef())
+	}
+	tests := []struct {
+		path   string
+		has    bool
+		isNull bool
+	}{
+		{"ref", true, false},
+		{"base_ref", true, true},
+		{"head_commit", false, false},
+		{"repository.owner.login", true, false},
+		{"repository.owner.id", false, false},
+		{"commits.0.id", true, false},
+		{"commits.1.message", true, true},
+		{"commits.2.id", false, false},
+		{"commits.*.id", true, false},
+		{"commits.*.message", true, true},
+		{"*.owner.login", true, false},
+	}
+	for _, test := range tests {
+		if has := p.Has(test.path); has != test.has {
+			t.Errorf("Has(\"%s\") was %v (should have been %v)", test.path, has, test.has)
+		}
+		if isNull := p.IsNull(test.path); isNull != test.isNull {
+			t.Errorf("IsNull(\"%s\") was %v (should have been %v)", test.path, isNull, test.isNull)
+		}
+	}
+	missing := p.Missing("ref", "base_ref", "head_commit", "repository.owner.login")
+	if !reflect.DeepEqual(missing, []string{"base_ref", "head_commit"}) {
+		t.Errorf("Missing() was %v (should have been [base_ref head_commit])", missing)
+	}
+	want := []string{"base_ref", "commits", "commits.0", "commits.0.id", "commits.1", "commits.1.id", "commits.1.message", "ref", "repository", "repository.owner", "repository.owner.login"}
+	if fields := p.Fields(); !reflect.DeepEqual(fields, want) {
+		t.Errorf("Fields() was %v (should have been %v)", fields, want)
+	}
+}
+
+func TestPresenceFixture(t *testing.T) {
+	payload, err := ioutil.ReadFile("testdata/push/tag_deleted.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := NewPresence(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !p.IsNull("head_commit") || !p.IsNull("repository.license") {
+		t.Error("IsNull() was false for fields that were null in the fixture")
+	}
+	if p.Has("installation") {
+		t.Error("Has(\"installation\") was true when the field was missing from the fixture")
+	}
+}
+
+func TestPresenceInvalidJSON(t *testing.T) {
+	if _, err := NewPresence([]byte(`{"ref":`)); err == nil {
+		t.Error("NewPresence() didn't fail on invalid JSON")
+	}
+}
+
+func TestUnmodelledAndAbsentFields(t *testing.T) {
+	jsonStr := `{"ref":"refs/heads/master","shiny":{"new":1},"commits":[{"id":"a","flavour":"vanilla"}],"installation":{"id":1,"permissions":{"issues":"write"}}}`
+	unmodelled, err := UnmodelledFields([]byte(jsonStr), &PushEvent{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"commits.0.flavour", "shiny"}; !reflect.DeepEqual(unmodelled, want) {
+		t.Errorf("UnmodelledFields() returned %v (should be %v)", unmodelled, want)
+	}
+	absent, err := AbsentFields([]byte(jsonStr), &PushEvent{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range []string{"before", "commits.0.message", "installation.account"} {
+		if !containsString(absent, path) {
+			t.Errorf("AbsentFields() didn't return %s", path)
+		}
+	}
+	for _, path := range []string{"ref", "commits.0.id", "repository.owner", "installation.permissions.issues"} {
+		if containsString(absent, path) {
+			t.Errorf("AbsentFields() returned %s", path)
+		}
+	}
+}
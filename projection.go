@@ -0,0 +1,393 @@
+package ghevent
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+//
+// The current state of issues and pull requests, from their events. Issue payloads
+// carry the whole issue as it was after the change, so an IssueProjection keeps the
+// newest one seen for each issue (by updated_at), which makes delivery order mostly
+// irrelevant:
+//
+//   projection := ghevent.NewIssueProjection()
+//   projection.Apply(event) // issues, issue_comment, pull_request, label and milestone
+//   state := projection.Issue("Codertocat/Hello-World", 1)
+//   fmt.Println(state.Title, state.State, state.Labels, state.Comments)
+//
+// updated_at only has seconds, so events from the same second are applied on top of
+// each other (the label added, the assignee removed etc). Label and milestone events
+// rename or remove labels and milestones on the issues that have them. They have no
+// timestamp, so an older issue payload delivered after a rename can bring back the
+// old name until the issue's next event.
+//
+// A projection can be saved and restored as JSON, or rebuilt from stored Deliveries
+// (see ReadDeliveries) with RebuildIssueProjection.
+//
+
+type IssueState struct {
+	Repository      string // Full name
+	Number          int
+	PullRequest     bool
+	Title           string
+	State           string // "open" | "closed"
+	Merged          bool
+	Labels          []string // Sorted
+	Assignees       []string // Logins, sorted
+	Milestone       string   // Title
+	MilestoneNumber int
+	Locked          bool
+	LockReason      string
+	Comments        int
+	Deleted         bool // Deleted, or transferred to another repository
+	UpdatedAt       time.Time
+}
+
+type IssueProjection struct {
+	mu     sync.RWMutex
+	issues map[string]*IssueState // issueKey -> state
+}
+
+// projectedEventTypes are the events an IssueProjection applies
+var projectedEventTypes = []string{"issues", "issue_comment", "label", "milestone", "pull_request"}
+
+func NewIssueProjection() *IssueProjection {
+	return &IssueProjection{issues: map[string]*IssueState{}}
+}
+
+func issueKey(repository string, number int) string {
+	return strings.ToLower(repository) + "#" + strconv.Itoa(number)
+}
+
+// Apply updates the projection from an event, and tells if anything changed. Events
+// other than IssuesEvent, IssueCommentEvent, PullRequestEvent, LabelEvent and
+// MilestoneEvent are ignored
+func (p *IssueProjection) Apply(event interface{}) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	switch e := event.(type) {
+	case *IssuesEvent:
+		if e.Issue == nil {
+			return false
+		}
+		return p.apply(issueState(e.GetRepository().GetFullName(), e.Issue), e.GetAction(), issueDeletions, func(s *IssueState) {
+			switch e.GetAction() {
+			case "labeled":
+				s.Labels = addSorted(s.Labels, e.GetLabel().GetName())
+			case "unlabeled":
+				s.Labels = removeFold(s.Labels, e.GetLabel().GetName())
+			case "assigned":
+				s.Assignees = addSorted(s.Assignees, e.GetAssignee().GetLogin())
+			case "unassigned":
+				s.Assignees = removeFold(s.Assignees, e.GetAssignee().GetLogin())
+			case "milestoned":
+				s.Milestone, s.MilestoneNumber = e.GetMilestone().GetTitle(), e.GetMilestone().GetNumber()
+			case "demilestoned":
+				s.Milestone, s.MilestoneNumber = "", 0
+			case "closed", "reopened":
+				s.State = e.Issue.GetState()
+			case "locked", "unlocked":
+				s.Locked, s.LockReason = e.Issue.GetLocked(), e.Issue.GetActiveLockReason()
+			case "edited":
+				s.Title = e.Issue.GetTitle()
+			}
+		})
+	case *IssueCommentEvent:
+		if e.Issue == nil {
+			return false
+		}
+		snapshot := issueState(e.GetRepository().GetFullName(), e.Issue)
+		// Deleting a comment doesn't delete the issue
+		return p.apply(snapshot, e.GetAction(), nil, func(s *IssueState) {
+			switch e.GetAction() {
+			case "created":
+				if snapshot.Comments > s.Comments {
+					s.Comments = snapshot.Comments
+				}
+			case "deleted":
+				if snapshot.Comments < s.Comments {
+					s.Comments = snapshot.Comments
+				}
+			}
+		})
+	case *PullRequestEvent:
+		if e.PullRequest == nil {
+			return false
+		}
+		pr := e.PullRequest
+		return p.apply(pullRequestState(e.GetRepository().GetFullName(), pr), e.GetAction(), nil, func(s *IssueState) {
+			switch e.GetAction() {
+			case "labeled":
+				s.Labels = addSorted(s.Labels, e.GetLabel().GetName())
+			case "unlabeled":
+				s.Labels = removeFold(s.Labels, e.GetLabel().GetName())
+			case "assigned":
+				s.Assignees = addSorted(s.Assignees, e.GetAssignee().GetLogin())
+			case "unassigned":
+				s.Assignees = removeFold(s.Assignees, e.GetAssignee().GetLogin())
+			case "closed", "reopened":
+				s.State, s.Merged = pr.GetState(), pr.GetMerged()
+			case "locked", "unlocked":
+				s.Locked, s.LockReason = pr.GetLocked(), pr.GetActiveLockReason()
+			case "edited":
+				s.Title = pr.GetTitle()
+			}
+		})
+	case *LabelEvent:
+		return p.applyLabel(e)
+	case *MilestoneEvent:
+		return p.applyMilestone(e)
+	}
+	return false
+}
+
+// issueDeletions are the IssuesEvent actions after which the issue is gone from its
+// repository
+var issueDeletions = []string{"deleted", "transferred"}
+
+// apply replaces the state of an issue with a newer snapshot, or applies an event
+// from the same second to it with sameSecond. Older snapshots are ignored, except
+// for deletions: events with one of the actions in deletions
+func (p *IssueProjection) apply(snapshot *IssueState, action string, deletions []string, sameSecond func(s *IssueState)) bool {
+	if snapshot.Repository == "" || snapshot.Number == 0 {
+		return false
+	}
+	key := issueKey(snapshot.Repository, snapshot.Number)
+	deleted := containsString(deletions, action)
+	current, ok := p.issues[key]
+	switch {
+	case !ok || snapshot.UpdatedAt.After(current.UpdatedAt):
+		snapshot.Deleted = deleted || (ok && current.Deleted)
+		// Issue payloads (e.g. of comments on pull requests) don't say if it was merged
+		snapshot.Merged = snapshot.Merged || (ok && current.Merged && snapshot.State == "closed")
+		p.issues[key] = snapshot
+	case snapshot.UpdatedAt.Equal(current.UpdatedAt):
+		before := current.copy()
+		sameSecond(current)
+		current.Deleted = current.Deleted || deleted
+		return !reflect.DeepEqual(before, current.copy())
+	case deleted && !current.Deleted:
+		current.Deleted = true
+	default:
+		return false
+	}
+	return true
+}
+
+func issueState(repository string, issue *Issue) *IssueState {
+	s := &IssueState{
+		Repository:      repository,
+		Number:          issue.GetNumber(),
+		PullRequest:     issue.PullRequest != nil,
+		Title:           issue.GetTitle(),
+		State:           issue.GetState(),
+		Milestone:       issue.GetMilestone().GetTitle(),
+		MilestoneNumber: issue.GetMilestone().GetNumber(),
+		Locked:          issue.GetLocked(),
+		LockReason:      issue.GetActiveLockReason(),
+		Comments:        issue.GetComments(),
+		UpdatedAt:       issue.GetUpdatedAt(),
+	}
+	for _, label := range issue.Labels {
+		s.Labels = addSorted(s.Labels, label.GetName())
+	}
+	for _, assignee := range issue.Assignees {
+		s.Assignees = addSorted(s.Assignees, assignee.GetLogin())
+	}
+	return s
+}
+
+func pullRequestState(repository string, pr *PullRequest) *IssueState {
+	s := &IssueState{
+		Repository:      repository,
+		Number:          pr.GetNumber(),
+		PullRequest:     true,
+		Title:           pr.GetTitle(),
+		State:           pr.GetState(),
+		Merged:          pr.GetMerged(),
+		Milestone:       pr.GetMilestone().GetTitle(),
+		MilestoneNumber: pr.GetMilestone().GetNumber(),
+		Locked:          pr.GetLocked(),
+		LockReason:      pr.GetActiveLockReason(),
+		Comments:        pr.GetComments(),
+		UpdatedAt:       pr.GetUpdatedAt(),
+	}
+	for _, label := range pr.Labels {
+		s.Labels = addSorted(s.Labels, label.GetName())
+	}
+	for _, assignee := range pr.Assignees {
+		s.Assignees = addSorted(s.Assignees, assignee.GetLogin())
+	}
+	return s
+}
+
+func hasFold(list []string, s string) bool {
+	for _, item := range list {
+		if strings.EqualFold(item, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func addSorted(list []string, s string) []string {
+	if s == "" {
+		return list
+	}
+	list = append(removeFold(list, s), s)
+	sort.Strings(list)
+	return list
+}
+
+// applyLabel renames or removes a repository label on the issues that have it
+func (p *IssueProjection) applyLabel(e *LabelEvent) bool {
+	name := e.GetLabel().GetName()
+	switch e.GetAction() {
+	case "edited":
+		if from := e.GetChanges().GetName().GetFrom(); from != "" && from != name {
+			return p.eachIssue(e.GetRepository().GetFullName(), func(s *IssueState) bool {
+				if !hasFold(s.Labels, from) {
+					return false
+				}
+				s.Labels = addSorted(removeFold(s.Labels, from), name)
+				return true
+			})
+		}
+	case "deleted":
+		return p.eachIssue(e.GetRepository().GetFullName(), func(s *IssueState) bool {
+			if !hasFold(s.Labels, name) {
+				return false
+			}
+			s.Labels = removeFold(s.Labels, name)
+			return true
+		})
+	}
+	return false
+}
+
+// applyMilestone renames or removes a milestone on the issues that have it
+func (p *IssueProjection) applyMilestone(e *MilestoneEvent) bool {
+	number, title := e.GetMilestone().GetNumber(), e.GetMilestone().GetTitle()
+	if number == 0 { // Would match the issues without milestone
+		return false
+	}
+	switch e.GetAction() {
+	case "edited":
+		return p.eachIssue(e.GetRepository().GetFullName(), func(s *IssueState) bool {
+			if s.MilestoneNumber != number || s.Milestone == title {
+				return false
+			}
+			s.Milestone = title
+			return true
+		})
+	case "deleted":
+		return p.eachIssue(e.GetRepository().GetFullName(), func(s *IssueState) bool {
+			if s.MilestoneNumber != number {
+				return false
+			}
+			s.Milestone, s.MilestoneNumber = "", 0
+			return true
+		})
+	}
+	return false
+}
+
+func (p *IssueProjection) eachIssue(repository string, f func(s *IssueState) bool) bool {
+	prefix := strings.ToLower(repository) + "#"
+	changed := false
+	for key, s := range p.issues {
+		if strings.HasPrefix(key, prefix) && f(s) {
+			changed = true
+		}
+	}
+	return changed
+}
+
+// Issue returns a copy of the state of an issue or pull request, or nil
+func (p *IssueProjection) Issue(repository string, number int) *IssueState {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if s, ok := p.issues[issueKey(repository, number)]; ok {
+		return s.copy()
+	}
+	return nil
+}
+
+// Issues returns copies of the states of a repository's issues and pull requests
+// (or of all of them, for ""), sorted by repository and number
+func (p *IssueProjection) Issues(repository string) []*IssueState {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	issues := []*IssueState{}
+	for _, s := range p.issues {
+		if repository == "" || strings.EqualFold(s.Repository, repository) {
+			issues = append(issues, s.copy())
+		}
+	}
+	sort.Slice(issues, func(i, j int) bool {
+		if a, b := strings.ToLower(issues[i].Repository), strings.ToLower(issues[j].Repository); a != b {
+			return a < b
+		}
+		return issues[i].Number < issues[j].Number
+	})
+	return issues
+}
+
+func (s *IssueState) copy() *IssueState {
+	c := *s
+	c.Labels = append([]string(nil), s.Labels...)
+	c.Assignees = append([]string(nil), s.Assignees...)
+	return &c
+}
+
+func (p *IssueProjection) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.Issues(""))
+}
+
+// UnmarshalJSON replaces what the projection has with the issues saved with
+// MarshalJSON
+func (p *IssueProjection) UnmarshalJSON(data []byte) error {
+	issues := []*IssueState{}
+	if err := json.Unmarshal(data, &issues); err != nil {
+		return err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.issues = map[string]*IssueState{}
+	for _, s := range issues {
+		p.issues[issueKey(s.Repository, s.Number)] = s
+	}
+	return nil
+}
+
+// ApplyDelivery decodes and applies a stored delivery. Deliveries of events the
+// projection doesn't use aren't decoded
+func (p *IssueProjection) ApplyDelivery(d *Delivery) error {
+	if !containsString(projectedEventTypes, d.EventType()) {
+		return nil
+	}
+	event, err := d.Decode()
+	if err != nil {
+		return fmt.Errorf("delivery %s: %v", d.GUID(), err)
+	}
+	p.Apply(event)
+	return nil
+}
+
+// RebuildIssueProjection returns a projection of stored deliveries
+func RebuildIssueProjection(deliveries []*Delivery) (*IssueProjection, error) {
+	p := NewIssueProjection()
+	for _, d := range deliveries {
+		if err := p.ApplyDelivery(d); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
@@ -0,0 +1,159 @@
+package ghevent
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func projectionDeliveries(t *testing.T, names ...string) []*Delivery {
+	deliveries := []*Delivery{}
+	for i, name := range names {
+		data, err := ioutil.ReadFile("testdata/" + name + ".json")
+		if err != nil {
+			t.Fatal(err)
+		}
+		d := &Delivery{Header: http.Header{}, Body: data}
+		d.Header.Set(EventHeader, strings.Split(name, "/")[0])
+		d.Header.Set(DeliveryHeader, fmt.Sprintf("guid-%d", i))
+		deliveries = append(deliveries, d)
+	}
+	return deliveries
+}
+
+func TestIssueProjectionOrder(t *testing.T) {
+	names := []string{
+		"issues/opened", "issues/milestoned", "issue_comment/created", "issues/labeled",
+		"issues/edited", "issue_comment/edited", "issues/closed", "push/branch", "pull_request/opened",
+	}
+	expected := &IssueState{
+		Repository:      "Codertocat/Hello-World",
+		Number:          1,
+		Title:           "Spelling error in the README file",
+		State:           "closed",
+		Labels:          []string{"bug"},
+		Assignees:       []string{"Codertocat"},
+		Milestone:       "v1.0",
+		MilestoneNumber: 1,
+		Comments:        1,
+		UpdatedAt:       time.Date(2019, 5, 15, 15, 23, 45, 0, time.UTC),
+	}
+	reversed := []string{}
+	for i := len(names) - 1; i >= 0; i-- {
+		reversed = append(reversed, names[i])
+	}
+	for _, order := range [][]string{names, reversed} {
+		p, err := RebuildIssueProjection(projectionDeliveries(t, order...))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := p.Issue("codertocat/hello-world", 1); !reflect.DeepEqual(got, expected) {
+			t.Errorf("issue after %v is %+v (should be %+v)", order, got, expected)
+		}
+		if issues := p.Issues("Codertocat/Hello-World"); len(issues) != 2 || !issues[1].PullRequest {
+			t.Errorf("Issues returned %+v (should be the issue and pull request 2)", issues)
+		}
+	}
+}
+
+func TestIssueProjectionSameSecond(t *testing.T) {
+	at := NewTimeWrapper(builderTime)
+	repo := &Repository{FullName: Ptr("octocat/hello")}
+	issue := func(labels ...string) *Issue {
+		i := &Issue{Number: Ptr(7), Title: Ptr("Crash"), State: Ptr("open"), UpdatedAt: at}
+		for _, label := range labels {
+			i.Labels = append(i.Labels, Label{Name: Ptr(label)})
+		}
+		return i
+	}
+	p := NewIssueProjection()
+	// Two labels added in the same second, delivered in the wrong order
+	p.Apply(&IssuesEvent{Action: Ptr("labeled"), Repository: repo, Issue: issue("bug", "p1"), Label: &Label{Name: Ptr("p1")}})
+	if p.Apply(&IssuesEvent{Action: Ptr("labeled"), Repository: repo, Issue: issue("bug"), Label: &Label{Name: Ptr("bug")}}) {
+		t.Errorf("applying a label that is already there changed the projection (should not)")
+	}
+	if labels := p.Issue("octocat/hello", 7).Labels; !reflect.DeepEqual(labels, []string{"bug", "p1"}) {
+		t.Errorf("labels are %v (should be [bug p1])", labels)
+	}
+	p.Apply(&IssuesEvent{Action: Ptr("unlabeled"), Repository: repo, Issue: issue("p1"), Label: &Label{Name: Ptr("bug")}})
+	if labels := p.Issue("octocat/hello", 7).Labels; !reflect.DeepEqual(labels, []string{"p1"}) {
+		t.Errorf("labels are %v (should be [p1])", labels)
+	}
+
+	// Older snapshots are ignored, but not deletions
+	old := issue()
+	old.UpdatedAt = NewTimeWrapper(builderTime.Add(-time.Minute))
+	if p.Apply(&IssuesEvent{Action: Ptr("unlabeled"), Repository: repo, Issue: old, Label: &Label{Name: Ptr("p1")}}) {
+		t.Errorf("applying an older snapshot changed the projection (should not)")
+	}
+	if !p.Apply(&IssuesEvent{Action: Ptr("deleted"), Repository: repo, Issue: old}) || !p.Issue("octocat/hello", 7).Deleted {
+		t.Errorf("older deletion was not applied (should be)")
+	}
+
+	// Deleting a comment, in the same second or later, doesn't delete the issue
+	commented := issue()
+	commented.Number = Ptr(8)
+	p.Apply(&IssuesEvent{Action: Ptr("opened"), Repository: repo, Issue: commented})
+	for _, at := range []time.Time{builderTime, builderTime.Add(time.Minute)} {
+		commented.UpdatedAt = NewTimeWrapper(at)
+		p.Apply(&IssueCommentEvent{Action: Ptr("deleted"), Repository: repo, Issue: commented, Comment: &IssueComment{}})
+		if p.Issue("octocat/hello", 8).Deleted {
+			t.Errorf("deleting a comment at %v deleted the issue (should not)", at)
+		}
+	}
+}
+
+func TestIssueProjectionLabelsAndMilestones(t *testing.T) {
+	p := NewIssueProjection()
+	repo := &Repository{FullName: Ptr("Codertocat/Hello-World")}
+	p.Apply(&IssuesEvent{Action: Ptr("opened"), Repository: repo, Issue: &Issue{
+		Number:    Ptr(3),
+		State:     Ptr("open"),
+		Labels:    []Label{{Name: Ptr("severity-1")}, {Name: Ptr("wontfix")}},
+		Milestone: &Milestone{Number: Ptr(1), Title: Ptr("1.0")},
+		UpdatedAt: NewTimeWrapper(builderTime),
+	}})
+
+	// Renames severity-1 to sev1, and milestone 1 from 1.0 to v1.0
+	for _, name := range []string{"label/edited", "milestone/edited"} {
+		for _, d := range projectionDeliveries(t, name) {
+			if err := p.ApplyDelivery(d); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	p.Apply(&IssuesEvent{Action: Ptr("opened"), Repository: repo, Issue: &Issue{Number: Ptr(4), State: Ptr("open"), UpdatedAt: NewTimeWrapper(builderTime)}})
+	if p.Apply(&MilestoneEvent{Action: Ptr("edited"), Repository: repo, Milestone: &Milestone{Title: Ptr("2.0")}}) {
+		t.Errorf("applying a milestone without number changed the projection (should not)")
+	}
+	if s := p.Issue("Codertocat/Hello-World", 4); s.Milestone != "" {
+		t.Errorf("issue without milestone has milestone \"%s\" (should have none)", s.Milestone)
+	}
+	p.Apply(&LabelEvent{Action: Ptr("deleted"), Repository: repo, Label: &Label{Name: Ptr("wontfix")}})
+	s := p.Issue("Codertocat/Hello-World", 3)
+	if !reflect.DeepEqual(s.Labels, []string{"sev1"}) || s.Milestone != "v1.0" {
+		t.Errorf("labels are %v and milestone \"%s\" (should be [sev1] and \"v1.0\")", s.Labels, s.Milestone)
+	}
+	p.Apply(&MilestoneEvent{Action: Ptr("deleted"), Repository: repo, Milestone: &Milestone{Number: Ptr(1)}})
+	if s := p.Issue("Codertocat/Hello-World", 3); s.Milestone != "" || s.MilestoneNumber != 0 {
+		t.Errorf("milestone is \"%s\" (%d) after it was deleted (should be none)", s.Milestone, s.MilestoneNumber)
+	}
+
+	// Saved and restored
+	saved, err := json.Marshal(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	restored := NewIssueProjection()
+	if err := json.Unmarshal(saved, restored); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(restored.Issues(""), p.Issues("")) {
+		t.Errorf("restored projection has %+v (should have %+v)", restored.Issues(""), p.Issues(""))
+	}
+}
@@ -0,0 +1,149 @@
+package ghevent
+
+import (
+	"sort"
+	"strings"
+)
+
+//
+// Helpers for the questions everyone asks about a PushEvent
+//
+
+// Ref is a parsed git ref, e.g. "refs/heads/feature/x"
+type Ref struct {
+	Full string // The full ref, e.g. "refs/heads/feature/x"
+	Kind string // "branch", "tag", or "" for anything else (e.g. "refs/pull/1/head")
+	Name string // The short name, e.g. "feature/x". Same as Full if Kind is ""
+}
+
+// ParseRef parses a full ref. Refs that aren't branches or tags get an empty Kind
+func ParseRef(ref string) Ref {
+	switch {
+	case strings.HasPrefix(ref, "refs/heads/"):
+		return Ref{Full: ref, Kind: "branch", Name: strings.TrimPrefix(ref, "refs/heads/")}
+	case strings.HasPrefix(ref, "refs/tags/"):
+		return Ref{Full: ref, Kind: "tag", Name: strings.TrimPrefix(ref, "refs/tags/")}
+	}
+	return Ref{Full: ref, Name: ref}
+}
+
+func (r Ref) IsBranch() bool {
+	return r.Kind == "branch"
+}
+
+func (r Ref) IsTag() bool {
+	return r.Kind == "tag"
+}
+
+func (r Ref) String() string {
+	return r.Full
+}
+
+// ParsedRef returns the pushed ref, parsed
+func (e *PushEvent) ParsedRef() Ref {
+	return ParseRef(e.GetRef())
+}
+
+// IsBranch returns true if a branch was pushed to
+func (e *PushEvent) IsBranch() bool {
+	return e.ParsedRef().IsBranch()
+}
+
+// IsTag returns true if a tag was pushed
+func (e *PushEvent) IsTag() bool {
+	return e.ParsedRef().IsTag()
+}
+
+// IsDeletion returns true if the push deleted the ref
+func (e *PushEvent) IsDeletion() bool {
+	return e.GetDeleted() || e.GetAfter() == zeroSHA
+}
+
+// IsCreation returns true if the push created the ref
+func (e *PushEvent) IsCreation() bool {
+	return e.GetCreated() || e.GetBefore() == zeroSHA
+}
+
+// IsNewBranch returns true if the push created a branch
+func (e *PushEvent) IsNewBranch() bool {
+	return e.IsCreation() && e.IsBranch()
+}
+
+// IsForcePush returns true if the push rewrote history
+func (e *PushEvent) IsForcePush() bool {
+	return e.GetForced()
+}
+
+// IsDefaultBranch returns true if the push was to the default branch of the
+// repository. Needs repository.default_branch to be present
+func (e *PushEvent) IsDefaultBranch() bool {
+	ref := e.ParsedRef()
+	defaultBranch := e.GetRepository().GetDefaultBranch()
+	return ref.IsBranch() && defaultBranch != "" && ref.Name == defaultBranch
+}
+
+// ChangedFiles is the net effect of a number of commits on a set of files
+type ChangedFiles struct {
+	Added    []string
+	Modified []string
+	Removed  []string
+}
+
+// All returns every changed file, sorted
+func (cf ChangedFiles) All() []string {
+	all := make([]string, 0, len(cf.Added)+len(cf.Modified)+len(cf.Removed))
+	all = append(all, cf.Added...)
+	all = append(all, cf.Modified...)
+	all = append(all, cf.Removed...)
+	sort.Strings(all)
+	return all
+}
+
+// ChangedFiles aggregates the added/modified/removed files of all commits in the
+// push, in order. A file that was added and then modified is "added", one that was
+// added and then removed isn't included at all, and so on. Note that Github only
+// includes the first 20 commits of a push in the event (see PushEvent.IsTruncated)
+func (e *PushEvent) ChangedFiles() ChangedFiles {
+	const (
+		added = iota + 1
+		modified
+		removed
+	)
+	status := map[string]int{}
+	for _, commit := range e.Commits {
+		for _, path := range commit.Added {
+			if status[path] == removed {
+				status[path] = modified
+			} else {
+				status[path] = added
+			}
+		}
+		for _, path := range commit.Modified {
+			if status[path] != added {
+				status[path] = modified
+			}
+		}
+		for _, path := range commit.Removed {
+			if status[path] == added {
+				delete(status, path)
+			} else {
+				status[path] = removed
+			}
+		}
+	}
+	cf := ChangedFiles{Added: []string{}, Modified: []string{}, Removed: []string{}}
+	for path, s := range status {
+		switch s {
+		case added:
+			cf.Added = append(cf.Added, path)
+		case modified:
+			cf.Modified = append(cf.Modified, path)
+		case removed:
+			cf.Removed = append(cf.Removed, path)
+		}
+	}
+	sort.Strings(cf.Added)
+	sort.Strings(cf.Modified)
+	sort.Strings(cf.Removed)
+	return cf
+}
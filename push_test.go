@@ -0,0 +1,124 @@
+package ghevent
+
+import (
+	"io/ioutil"
+	"reflect"
+	"testing"
+)
+
+func TestParseRef(t *testing.T) {
+	tests := []struct {
+		ref  string
+		want Ref
+	}{
+		{"refs/heads/master", Ref{"refs/heads/master", "branch", "master"}},
+		{"refs/heads/feature/x", Ref{"refs/heads/feature/x", "branch", "feature/x"}},
+		{"refs/tags/v1.0.0", Ref{"refs/tags/v1.0.0", "tag", "v1.0.0"}},
+		{"refs/pull/12/head", Ref{"refs/pull/12/head", "", "refs/pull/12/head"}},
+		{"", Ref{"", "", ""}},
+	}
+	for _, test := range tests {
+		if got := ParseRef(test.ref); got != test.want {
+			t.Errorf("ParseRef(\"%s\") was %+v (should have been %+v)", test.ref, got, test.want)
+		}
+	}
+}
+
+func TestPushEventSemantics(t *testing.T) {
+	owner := NewAccountBuilder("Codertocat").Build()
+	repo := NewRepositoryBuilder(owner, "Hello-World").DefaultBranch("main").Build()
+	tests := []struct {
+		name                                                  string
+		event                                                 *PushEvent
+		branch, tag, deletion, creation, newBranch, force, df bool
+	}{
+		{"push to default branch", NewPushEventBuilder(repo).Commit("x", nil, nil, nil).Build(), true, false, false, false, false, false, true},
+		{"push to other branch", NewPushEventBuilder(repo).Branch("feature").Commit("x", nil, nil, nil).Build(), true, false, false, false, false, false, false},
+		{"new branch", NewPushEventBuilder(repo).Branch("feature").Created().Commit("x", nil, nil, nil).Build(), true, false, false, true, true, false, false},
+		{"force push", NewPushEventBuilder(repo).Forced().Commit("x", nil, nil, nil).Build(), true, false, false, false, false, true, true},
+		{"deleted branch", NewPushEventBuilder(repo).Branch("feature").Deleted().Build(), true, false, true, false, false, false, false},
+		{"new tag", NewPushEventBuilder(repo).Tag("v1.0").Created().Build(), false, true, false, true, false, false, false},
+		{"branch named like the default branch, but a tag", NewPushEventBuilder(repo).Tag("main").Build(), false, true, false, false, false, false, false},
+		{"nil event", nil, false, false, false, false, false, false, false},
+	}
+	for _, test := range tests {
+		e := test.event
+		got := []bool{e.IsBranch(), e.IsTag(), e.IsDeletion(), e.IsCreation(), e.IsNewBranch(), e.IsForcePush(), e.IsDefaultBranch()}
+		want := []bool{test.branch, test.tag, test.deletion, test.creation, test.newBranch, test.force, test.df}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: IsBranch/IsTag/IsDeletion/IsCreation/IsNewBranch/IsForcePush/IsDefaultBranch were %v (should have been %v)", test.name, got, want)
+		}
+	}
+}
+
+// Only the after SHA tells us about deletions in some payloads (e.g. the Events API)
+func TestPushEventDeletionBySHA(t *testing.T) {
+	e := &PushEvent{Ref: Ptr("refs/heads/x"), After: Ptr(zeroSHA)}
+	if !e.IsDeletion() {
+		t.Error("IsDeletion() was false when after was all zeros")
+	}
+}
+
+func TestChangedFiles(t *testing.T) {
+	tests := []struct {
+		name    string
+		commits [][3][]string // added, removed, modified
+		want    ChangedFiles
+	}{
+		{
+			"single commit",
+			[][3][]string{{{"a"}, {"b"}, {"c"}}},
+			ChangedFiles{Added: []string{"a"}, Modified: []string{"c"}, Removed: []string{"b"}},
+		},
+		{
+			"added then modified is added",
+			[][3][]string{{{"a"}, nil, nil}, {nil, nil, {"a"}}},
+			ChangedFiles{Added: []string{"a"}, Modified: []string{}, Removed: []string{}},
+		},
+		{
+			"added then removed is nothing",
+			[][3][]string{{{"a"}, nil, nil}, {nil, {"a"}, nil}},
+			ChangedFiles{Added: []string{}, Modified: []string{}, Removed: []string{}},
+		},
+		{
+			"removed then added is modified",
+			[][3][]string{{nil, {"a"}, nil}, {{"a"}, nil, nil}},
+			ChangedFiles{Added: []string{}, Modified: []string{"a"}, Removed: []string{}},
+		},
+		{
+			"modified then removed is removed",
+			[][3][]string{{nil, nil, {"a", "b"}}, {nil, {"a"}, nil}},
+			ChangedFiles{Added: []string{}, Modified: []string{"b"}, Removed: []string{"a"}},
+		},
+	}
+	owner := NewAccountBuilder("Codertocat").Build()
+	repo := NewRepositoryBuilder(owner, "Hello-World").Build()
+	for _, test := range tests {
+		b := NewPushEventBuilder(repo)
+		for i, c := range test.commits {
+			b.Commit(string(rune('A'+i)), c[0], c[1], c[2])
+		}
+		if got := b.Build().ChangedFiles(); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: ChangedFiles() was %+v (should have been %+v)", test.name, got, test.want)
+		}
+	}
+}
+
+func TestChangedFilesFixture(t *testing.T) {
+	payload, err := ioutil.ReadFile("testdata/push/branch.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	event, err := ParseWebHook("push", payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := event.(*PushEvent)
+	if !e.IsDefaultBranch() || e.IsNewBranch() || e.IsDeletion() {
+		t.Error("fixture push wasn't a plain push to the default branch")
+	}
+	want := []string{"Gemfile", "README.md", "bin/launcher", "docs/sim.md", "models/spaceship.rb", "sim/launch.rb"}
+	if got := e.ChangedFiles().All(); !reflect.DeepEqual(got, want) {
+		t.Errorf("ChangedFiles().All() was %v (should have been %v)", got, want)
+	}
+}
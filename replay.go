@@ -0,0 +1,255 @@
+package ghevent
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+//
+// Replaying of stored deliveries, e.g. to reproduce a bug against a dev server.
+// Deliveries are read from a directory, with one delivery per file (in the format
+// ReadDelivery reads), or from a JSONL file with one delivery per line:
+//
+//   {"delivered_at": "2021-01-14T07:35:08Z", "headers": {"X-GitHub-Event": "push", ...}, "payload": {...}}
+//
+// Replay doesn't care where deliveries come from, so anything else that can
+// produce them (e.g. a database of received webhooks) can be replayed too.
+//
+
+// ReplayOrder is the order deliveries are replayed in
+type ReplayOrder string
+
+const (
+	ReplayAsRead  ReplayOrder = ""        // Order of the files (by name), or lines
+	ReplayByTime  ReplayOrder = "time"    // Oldest DeliveredAt first
+	ReplayReverse ReplayOrder = "reverse" // Newest DeliveredAt first
+)
+
+type ReplayOptions struct {
+	Secret []byte  // Re-sign with this secret. If empty, old signatures are removed
+	Rate   float64 // Deliveries per second, or 0 for no limit
+	Order  ReplayOrder
+	Filter func(d *Delivery) bool // Only replay deliveries this returns true for (if set)
+	Client *http.Client           // Defaults to a client with a 10 second timeout
+	Result func(res ReplayResult) // Called after each delivery (if set)
+}
+
+// ReplayResult is the outcome of replaying one delivery
+type ReplayResult struct {
+	Delivery *Delivery
+	Status   int // HTTP status, or 0 if the request failed
+	Duration time.Duration
+	Err      error // Request error, or non-2xx status
+}
+
+// MarshalJSON encodes a delivery as a JSONL record, with the first value of each
+// header
+func (d *Delivery) MarshalJSON() ([]byte, error) {
+	record := deliveryRecord{Headers: map[string]string{}, Payload: d.Body}
+	for name := range d.Header {
+		record.Headers[name] = d.Header.Get(name)
+	}
+	if !d.DeliveredAt.IsZero() {
+		record.DeliveredAt = &d.DeliveredAt
+	}
+	return json.Marshal(record)
+}
+
+// UnmarshalJSON decodes a delivery from a JSONL record
+func (d *Delivery) UnmarshalJSON(data []byte) error {
+	record := deliveryRecord{}
+	if err := json.Unmarshal(data, &record); err != nil {
+		return err
+	}
+	if len(record.Payload) == 0 || string(record.Payload) == "null" {
+		return fmt.Errorf("delivery has no payload")
+	}
+	d.Header = http.Header{}
+	for name, value := range record.Headers {
+		d.Header.Set(name, value)
+	}
+	d.Body = record.Payload
+	d.DeliveredAt = time.Time{}
+	if record.DeliveredAt != nil {
+		d.DeliveredAt = *record.DeliveredAt
+	}
+	return nil
+}
+
+type deliveryRecord struct {
+	DeliveredAt *time.Time        `json:"delivered_at,omitempty"`
+	Headers     map[string]string `json:"headers"`
+	Payload     json.RawMessage   `json:"payload"`
+}
+
+// ReadDeliveries reads the deliveries in a directory (where each file's modification
+// time is its DeliveredAt), a JSONL file (if the name ends with ".jsonl"), or a single
+// delivery file
+func ReadDeliveries(path string) ([]*Delivery, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		if strings.HasSuffix(path, ".jsonl") {
+			return readDeliveriesJSONL(path)
+		}
+		d, err := readDeliveryFile(path)
+		if err != nil {
+			return nil, err
+		}
+		return []*Delivery{d}, nil
+	}
+	entries, err := ioutil.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+	deliveries := []*Delivery{}
+	for _, entry := range entries {
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		d, err := readDeliveryFile(filepath.Join(path, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, d)
+	}
+	return deliveries, nil
+}
+
+func readDeliveryFile(path string) (*Delivery, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	d, err := ReadDelivery(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	if info, err := f.Stat(); err == nil {
+		d.DeliveredAt = info.ModTime()
+	}
+	return d, nil
+}
+
+func readDeliveriesJSONL(path string) ([]*Delivery, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	deliveries := []*Delivery{}
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 64*1024*1024) // Payloads can be big
+	for line := 1; scanner.Scan(); line++ {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		d := &Delivery{}
+		if err := json.Unmarshal(scanner.Bytes(), d); err != nil {
+			return nil, fmt.Errorf("%s:%d: %v", path, line, err)
+		}
+		deliveries = append(deliveries, d)
+	}
+	return deliveries, scanner.Err()
+}
+
+// Replay POSTs the deliveries to url, with their original X-Github-* headers. It
+// stops early if ctx is done, and returns the results of the deliveries sent
+func Replay(ctx context.Context, url string, deliveries []*Delivery, opts ReplayOptions) ([]ReplayResult, error) {
+	client := opts.Client
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	selected := []*Delivery{}
+	for _, d := range deliveries {
+		if opts.Filter == nil || opts.Filter(d) {
+			selected = append(selected, d)
+		}
+	}
+	switch opts.Order {
+	case ReplayAsRead:
+	case ReplayByTime, ReplayReverse:
+		sort.SliceStable(selected, func(i, j int) bool {
+			if opts.Order == ReplayReverse {
+				return selected[i].DeliveredAt.After(selected[j].DeliveredAt)
+			}
+			return selected[i].DeliveredAt.Before(selected[j].DeliveredAt)
+		})
+	default:
+		return nil, fmt.Errorf("unknown replay order \"%s\"", opts.Order)
+	}
+
+	var interval time.Duration
+	if opts.Rate > 0 {
+		interval = time.Duration(float64(time.Second) / opts.Rate)
+	}
+	results := []ReplayResult{}
+	next := time.Now()
+	for _, d := range selected {
+		if wait := time.Until(next); wait > 0 {
+			select {
+			case <-ctx.Done():
+				return results, ctx.Err()
+			case <-time.After(wait):
+			}
+		} else if err := ctx.Err(); err != nil {
+			return results, err
+		}
+		next = time.Now().Add(interval)
+		res := replayOne(ctx, client, url, d, opts.Secret)
+		results = append(results, res)
+		if opts.Result != nil {
+			opts.Result(res)
+		}
+	}
+	return results, nil
+}
+
+func replayOne(ctx context.Context, client *http.Client, url string, d *Delivery, secret []byte) ReplayResult {
+	res := ReplayResult{Delivery: d}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(d.Body))
+	if err != nil {
+		res.Err = err
+		return res
+	}
+	for name, values := range d.Header {
+		canonical := http.CanonicalHeaderKey(name)
+		if strings.HasPrefix(canonical, "X-Github-") || canonical == "User-Agent" {
+			req.Header[canonical] = values
+		}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if len(secret) > 0 {
+		signed := &Delivery{Header: http.Header{}, Body: d.Body}
+		signed.Sign(secret)
+		req.Header.Set(SignatureHeader, signed.Header.Get(SignatureHeader))
+		req.Header.Set(SignatureSHA1Header, signed.Header.Get(SignatureSHA1Header))
+	}
+	start := time.Now()
+	resp, err := client.Do(req)
+	res.Duration = time.Since(start)
+	if err != nil {
+		res.Err = err
+		return res
+	}
+	defer resp.Body.Close()
+	ioutil.ReadAll(resp.Body)
+	res.Status = resp.StatusCode
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		res.Err = fmt.Errorf("%s", resp.Status)
+	}
+	return res
+}
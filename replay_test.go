@@ -0,0 +1,169 @@
+package ghevent
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type receivedDelivery struct {
+	event, guid string
+	verified    bool
+}
+
+func newReplayServer(t *testing.T, secret []byte, status int) (*httptest.Server, func() []receivedDelivery) {
+	var mu sync.Mutex
+	received := []receivedDelivery{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		d := &Delivery{Header: r.Header, Body: body}
+		mu.Lock()
+		received = append(received, receivedDelivery{event: d.EventType(), guid: d.GUID(), verified: d.Verify(secret) == nil})
+		mu.Unlock()
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+	return server, func() []receivedDelivery {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]receivedDelivery{}, received...)
+	}
+}
+
+func testDeliveries(t *testing.T) []*Delivery {
+	deliveries := []*Delivery{}
+	for i, name := range []string{"push/branch", "issues/opened", "issues/labeled"} {
+		data, err := ioutil.ReadFile("testdata/" + name + ".json")
+		if err != nil {
+			t.Fatal(err)
+		}
+		d := &Delivery{Header: http.Header{}, Body: data, DeliveredAt: builderTime.Add(time.Duration(-i) * time.Minute)}
+		d.Header.Set(EventHeader, strings.Split(name, "/")[0])
+		d.Header.Set(DeliveryHeader, fmt.Sprintf("guid-%d", i))
+		d.Header.Set(SignatureHeader, "sha256=stale")
+		deliveries = append(deliveries, d)
+	}
+	return deliveries
+}
+
+func TestReplay(t *testing.T) {
+	secret := []byte("s3cret")
+	server, received := newReplayServer(t, secret, http.StatusOK)
+	results, err := Replay(context.Background(), server.URL, testDeliveries(t), ReplayOptions{Secret: secret, Order: ReplayByTime})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []receivedDelivery{{"issues", "guid-2", true}, {"issues", "guid-1", true}, {"push", "guid-0", true}}
+	if got := received(); !reflect.DeepEqual(got, want) {
+		t.Errorf("received %v (should have received %v)", got, want)
+	}
+	for _, res := range results {
+		if res.Status != http.StatusOK || res.Err != nil {
+			t.Errorf("result %+v", res)
+		}
+	}
+
+	// Filtering, rate limiting and errors
+	server, received = newReplayServer(t, secret, http.StatusInternalServerError)
+	start := time.Now()
+	results, err = Replay(context.Background(), server.URL, testDeliveries(t), ReplayOptions{
+		Rate:   20,
+		Filter: func(d *Delivery) bool { return d.EventType() == "issues" },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("2 deliveries at 20/s took %v", elapsed)
+	}
+	want = []receivedDelivery{{"issues", "guid-1", false}, {"issues", "guid-2", false}}
+	if got := received(); !reflect.DeepEqual(got, want) {
+		t.Errorf("received %v (should have received %v)", got, want)
+	}
+	if len(results) != 2 || results[0].Status != http.StatusInternalServerError || results[0].Err == nil {
+		t.Errorf("results %+v", results)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := Replay(ctx, server.URL, testDeliveries(t), ReplayOptions{}); err != context.Canceled {
+		t.Errorf("Replay() with a cancelled context returned %v", err)
+	}
+	if _, err := Replay(context.Background(), server.URL, nil, ReplayOptions{Order: "random"}); err == nil {
+		t.Error("unknown order should fail")
+	}
+}
+
+func TestReadDeliveries(t *testing.T) {
+	dir := t.TempDir()
+	deliveries := testDeliveries(t)
+
+	jsonl := filepath.Join(dir, "deliveries.jsonl")
+	lines := []string{}
+	for _, d := range deliveries {
+		data, err := json.Marshal(d)
+		if err != nil {
+			t.Fatal(err)
+		}
+		lines = append(lines, string(data))
+	}
+	if err := ioutil.WriteFile(jsonl, []byte(strings.Join(lines, "\n")+"\n\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	read, err := ReadDeliveries(jsonl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(read) != len(deliveries) {
+		t.Fatalf("read %d deliveries (should be %d)", len(read), len(deliveries))
+	}
+	for i, d := range read {
+		if d.GUID() != deliveries[i].GUID() || !d.DeliveredAt.Equal(deliveries[i].DeliveredAt) || compactJSON(t, d.Body) != compactJSON(t, deliveries[i].Body) {
+			t.Errorf("delivery %d was read as %s %v", i, d.GUID(), d.DeliveredAt)
+		}
+	}
+
+	files := filepath.Join(dir, "files")
+	os.Mkdir(files, 0755)
+	for i, d := range deliveries {
+		path := filepath.Join(files, fmt.Sprintf("%d.txt", i))
+		content := fmt.Sprintf("X-GitHub-Event: %s\nX-GitHub-Delivery: %s\n\n%s", d.EventType(), d.GUID(), d.Body)
+		if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		os.Chtimes(path, d.DeliveredAt, d.DeliveredAt)
+	}
+	ioutil.WriteFile(filepath.Join(files, ".DS_Store"), []byte("junk"), 0644)
+	if read, err = ReadDeliveries(files); err != nil {
+		t.Fatal(err)
+	}
+	for i, d := range read {
+		if d.GUID() != deliveries[i].GUID() || !d.DeliveredAt.Equal(deliveries[i].DeliveredAt) {
+			t.Errorf("delivery %d was read as %s %v", i, d.GUID(), d.DeliveredAt)
+		}
+	}
+
+	ioutil.WriteFile(jsonl, []byte(`{"headers": {}}`), 0644)
+	if _, err := ReadDeliveries(jsonl); err == nil || !strings.Contains(err.Error(), ":1:") {
+		t.Errorf("reading a record without payload returned %v", err)
+	}
+}
+
+func compactJSON(t *testing.T, data []byte) string {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, data); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
@@ -0,0 +1,414 @@
+package ghevent
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+)
+
+//
+// Router routes decoded events to named handlers, according to rules loaded from a
+// JSON file like this one:
+//
+//   {
+//     "rules": [
+//       {
+//         "name": "triage sev labels",
+//         "handler": "pager",
+//         "events": ["issues"],
+//         "actions": ["labeled"],
+//         "repositories": ["octo-org/*"],
+//         "labels": ["sev*"]
+//       },
+//       {
+//         "name": "deploy",
+//         "handler": "deployer",
+//         "events": ["push"],
+//         "branches": ["main", "release/**"],
+//         "sender_type": "user"
+//       }
+//     ]
+//   }
+//
+// All conditions of a rule have to match, and a condition with several values
+// matches if any of them does. Empty conditions match anything. Repositories,
+// branches and labels are globs (see PathFilter). Anything else can be matched with an
+// "if" expression, e.g. "issue.comments > 10" (see CompileExpr). Rules are evaluated
+// in order, and the first matching one wins, unless it has "continue": true.
+//
+// Only JSON is read. Rules kept in YAML have to be converted first, e.g. with
+// "yq -o json rules.yaml > rules.json".
+//
+
+type Rule struct {
+	Name         string   `json:"name"`
+	Handler      string   `json:"handler"`
+	Events       []string `json:"events,omitempty"`       // X-Github-Event names
+	Actions      []string `json:"actions,omitempty"`      // The "action" of the event
+	Repositories []string `json:"repositories,omitempty"` // repository.full_name globs
+	SenderType   string   `json:"sender_type,omitempty"`  // "bot" or "user"
+	Labels       []string `json:"labels,omitempty"`       // Label name globs
+	Branches     []string `json:"branches,omitempty"`     // Branch name globs
+	If           string   `json:"if,omitempty"`           // Expression that has to be true (see CompileExpr)
+	Continue     bool     `json:"continue,omitempty"`     // Keep evaluating rules after this one matched
+}
+
+type RouterConfig struct {
+	Rules []Rule `json:"rules"`
+}
+
+// Handler is called with the X-Github-Event name and the decoded event
+type Handler func(eventType string, event interface{}) error
+
+// Match is a rule that matched an event
+type Match struct {
+	Rule    string // Name of the rule (or "#<index>" if it has no name)
+	Index   int    // Index of the rule in the configuration
+	Handler string
+}
+
+// RuleResult explains why a rule did, or didn't, match an event
+type RuleResult struct {
+	Match
+	Matched bool
+	Reason  string // The first condition that didn't match, if any
+}
+
+type compiledRule struct {
+	Rule
+	name         string
+	repositories [][]string
+	labels       [][]string
+	branches     [][]string
+	expr         *Expr
+}
+
+type Router struct {
+	mu       sync.RWMutex
+	rules    []compiledRule
+	handlers map[string]Handler
+	path     string
+	modTime  time.Time
+	size     int
+	hash     [sha256.Size]byte
+
+	// OnReloadError is called by Watch when reloading fails. The old rules are kept
+	OnReloadError func(err error)
+}
+
+// NewRouter returns a router using the given rules
+func NewRouter(rules []Rule) (*Router, error) {
+	compiled, err := compileRules(rules)
+	if err != nil {
+		return nil, err
+	}
+	return &Router{rules: compiled, handlers: map[string]Handler{}}, nil
+}
+
+// LoadRouter returns a router using the rules in a JSON file. Use Reload or Watch to
+// pick up changes to it
+func LoadRouter(path string) (*Router, error) {
+	r := &Router{handlers: map[string]Handler{}, path: path}
+	if err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func compileRules(rules []Rule) ([]compiledRule, error) {
+	compiled := make([]compiledRule, len(rules))
+	for i, rule := range rules {
+		c := compiledRule{Rule: rule, name: rule.Name}
+		if c.name == "" {
+			c.name = fmt.Sprintf("#%d", i)
+		}
+		if rule.Handler == "" {
+			return nil, fmt.Errorf("rule %s has no handler", c.name)
+		}
+		switch strings.ToLower(rule.SenderType) {
+		case "", "bot", "user":
+		default:
+			return nil, fmt.Errorf("rule %s: sender_type must be \"bot\" or \"user\", not \"%s\"", c.name, rule.SenderType)
+		}
+		var err error
+		if c.repositories, err = compileGlobs(rule.Repositories); err != nil {
+			return nil, fmt.Errorf("rule %s: %v", c.name, err)
+		}
+		if c.labels, err = compileGlobs(rule.Labels); err != nil {
+			return nil, fmt.Errorf("rule %s: %v", c.name, err)
+		}
+		if c.branches, err = compileGlobs(rule.Branches); err != nil {
+			return nil, fmt.Errorf("rule %s: %v", c.name, err)
+		}
+		if rule.If != "" {
+			if c.expr, err = CompileExpr(rule.If, rule.Events...); err != nil {
+				return nil, fmt.Errorf("rule %s: %v", c.name, err)
+			}
+		}
+		compiled[i] = c
+	}
+	return compiled, nil
+}
+
+// Reload re-reads the rules file, if it has changed: its modification time, its size
+// or its contents (which catches rewrites within the resolution of the modification
+// time). On error, the old rules are kept
+func (r *Router) Reload() error {
+	if r.path == "" {
+		return errors.New("router wasn't loaded from a file")
+	}
+	info, err := os.Stat(r.path)
+	if err != nil {
+		return err
+	}
+	data, err := ioutil.ReadFile(r.path)
+	if err != nil {
+		return err
+	}
+	hash := sha256.Sum256(data)
+	r.mu.RLock()
+	unchanged := r.rules != nil && info.ModTime().Equal(r.modTime) && len(data) == r.size && bytes.Equal(hash[:], r.hash[:])
+	r.mu.RUnlock()
+	if unchanged {
+		return nil
+	}
+	config := RouterConfig{}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return fmt.Errorf("%s: %v", r.path, err)
+	}
+	compiled, err := compileRules(config.Rules)
+	if err != nil {
+		return fmt.Errorf("%s: %v", r.path, err)
+	}
+	r.mu.Lock()
+	r.rules = compiled
+	r.modTime = info.ModTime()
+	r.size = len(data)
+	r.hash = hash
+	r.mu.Unlock()
+	return nil
+}
+
+// Watch checks the rules file for changes every interval, until ctx is done
+func (r *Router) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := r.Reload(); err != nil && r.OnReloadError != nil {
+				r.OnReloadError(err)
+			}
+		}
+	}
+}
+
+// Handle registers the handler with the given name
+func (r *Router) Handle(name string, handler Handler) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.handlers[name] = handler
+}
+
+// Explain evaluates every rule against the event, and tells why each did or didn't
+// match. Unlike Match, it ignores "continue"
+func (r *Router) Explain(eventType string, event interface{}) []RuleResult {
+	r.mu.RLock()
+	rules := r.rules
+	r.mu.RUnlock()
+	fields := routingFieldsOf(event)
+	results := make([]RuleResult, len(rules))
+	for i, rule := range rules {
+		reason := rule.mismatch(eventType, event, fields)
+		results[i] = RuleResult{
+			Match:   Match{Rule: rule.name, Index: i, Handler: rule.Handler},
+			Matched: reason == "",
+			Reason:  reason,
+		}
+	}
+	return results
+}
+
+// Match returns the rules matching the event: the first one, plus any following
+// ones for as long as the matching rules have "continue" set
+func (r *Router) Match(eventType string, event interface{}) []Match {
+	r.mu.RLock()
+	rules := r.rules
+	r.mu.RUnlock()
+	fields := routingFieldsOf(event)
+	matches := []Match{}
+	for i, rule := range rules {
+		if rule.mismatch(eventType, event, fields) != "" {
+			continue
+		}
+		matches = append(matches, Match{Rule: rule.name, Index: i, Handler: rule.Handler})
+		if !rule.Continue {
+			break
+		}
+	}
+	return matches
+}
+
+// Route calls the handlers of the matching rules, and returns the matches. It stops
+// at the first handler returning an error
+func (r *Router) Route(eventType string, event interface{}) ([]Match, error) {
+	matches := r.Match(eventType, event)
+	for _, match := range matches {
+		r.mu.RLock()
+		handler, ok := r.handlers[match.Handler]
+		r.mu.RUnlock()
+		if !ok {
+			return matches, fmt.Errorf("rule %s: no handler named \"%s\"", match.Rule, match.Handler)
+		}
+		if err := handler(eventType, event); err != nil {
+			return matches, fmt.Errorf("rule %s: handler \"%s\": %v", match.Rule, match.Handler, err)
+		}
+	}
+	return matches, nil
+}
+
+// The parts of an event that rules can match on
+type routingFields struct {
+	action     *string
+	repository string
+	sender     *Account
+	labels     []string
+	branch     *string
+}
+
+// routingFieldsOf digs out the fields rules match on. All events have (some of)
+// Action, Repository and Sender, so those are found by name
+func routingFieldsOf(event interface{}) routingFields {
+	f := routingFields{}
+	v := reflect.ValueOf(event)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return f
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return f
+	}
+	if field := v.FieldByName("Action"); field.IsValid() {
+		f.action, _ = field.Interface().(*string)
+	}
+	if field := v.FieldByName("Repository"); field.IsValid() {
+		repo, _ := field.Interface().(*Repository)
+		f.repository = repo.GetFullName()
+	}
+	if field := v.FieldByName("Sender"); field.IsValid() {
+		f.sender, _ = field.Interface().(*Account)
+	}
+	addLabels := func(labels ...Label) {
+		for _, label := range labels {
+			if label.Name != nil {
+				f.labels = append(f.labels, *label.Name)
+			}
+		}
+	}
+	switch e := event.(type) {
+	case *IssuesEvent:
+		if e.Label != nil {
+			addLabels(*e.Label)
+		}
+		addLabels(e.GetIssue().GetLabels()...)
+	case *IssueCommentEvent:
+		addLabels(e.GetIssue().GetLabels()...)
+	case *LabelEvent:
+		if e.Label != nil {
+			addLabels(*e.Label)
+		}
+	case *PullRequestEvent:
+		if e.Label != nil {
+			addLabels(*e.Label)
+		}
+		addLabels(e.GetPullRequest().GetLabels()...)
+		if ref := e.GetPullRequest().GetBase().Ref; ref != nil {
+			f.branch = ref
+		}
+	case *PushEvent:
+		if ref := e.ParsedRef(); ref.IsBranch() {
+			f.branch = &ref.Name
+		}
+	}
+	return f
+}
+
+// mismatch returns the first condition of the rule that the event doesn't match, or
+// "" if it matches
+func (rule compiledRule) mismatch(eventType string, event interface{}, f routingFields) string {
+	if len(rule.Events) > 0 && !containsString(rule.Events, eventType) {
+		return fmt.Sprintf("event \"%s\" not in %v", eventType, rule.Events)
+	}
+	if len(rule.Actions) > 0 {
+		if f.action == nil {
+			return "event has no action"
+		}
+		if !containsString(rule.Actions, *f.action) {
+			return fmt.Sprintf("action \"%s\" not in %v", *f.action, rule.Actions)
+		}
+	}
+	if len(rule.repositories) > 0 && !matchAny(rule.repositories, strings.Split(f.repository, "/")) {
+		return fmt.Sprintf("repository \"%s\" doesn't match %v", f.repository, rule.Repositories)
+	}
+	if rule.SenderType != "" {
+		if f.sender == nil {
+			return "event has no sender"
+		}
+		isBot := IsBot(f.sender)
+		if want := strings.ToLower(rule.SenderType); (want == "bot") != isBot {
+			return fmt.Sprintf("sender \"%s\" is not a %s", f.sender.GetLogin(), want)
+		}
+	}
+	if len(rule.labels) > 0 {
+		found := false
+		for _, label := range f.labels {
+			if matchAny(rule.labels, strings.Split(label, "/")) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Sprintf("labels %v don't match %v", f.labels, rule.Labels)
+		}
+	}
+	if len(rule.branches) > 0 {
+		if f.branch == nil {
+			return "event has no branch"
+		}
+		if !matchAny(rule.branches, strings.Split(*f.branch, "/")) {
+			return fmt.Sprintf("branch \"%s\" doesn't match %v", *f.branch, rule.Branches)
+		}
+	}
+	if rule.expr != nil {
+		ok, err := rule.expr.Eval(eventType, event)
+		if err != nil {
+			return fmt.Sprintf("if: %v", err)
+		}
+		if !ok {
+			return fmt.Sprintf("\"%s\" is false", rule.If)
+		}
+	}
+	return ""
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,190 @@
+package ghevent
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testRouterConfig = `{
+  "rules": [
+    {"name": "sev", "handler": "pager", "events": ["issues"], "actions": ["labeled"], "repositories": ["Codertocat/*"], "labels": ["sev*"]},
+    {"name": "bots", "handler": "audit", "sender_type": "bot", "continue": true},
+    {"name": "deploy", "handler": "deployer", "events": ["push"], "branches": ["master", "release/**"], "sender_type": "user"},
+    {"name": "prs into release", "handler": "release-bot", "events": ["pull_request"], "branches": ["release/*"]},
+    {"handler": "catch-all"}
+  ]
+}`
+
+func writeRouterConfig(t *testing.T, dir, config string, modTime time.Time) string {
+	t.Helper()
+	// Written next to it and renamed, so that a watching router never reads half of it
+	path, tmp := filepath.Join(dir, "rules.json"), filepath.Join(dir, "rules.json.tmp")
+	if err := ioutil.WriteFile(tmp, []byte(config), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(tmp, modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func matchedRules(matches []Match) []string {
+	names := []string{}
+	for _, match := range matches {
+		names = append(names, match.Rule)
+	}
+	return names
+}
+
+func TestRouterMatch(t *testing.T) {
+	path := writeRouterConfig(t, t.TempDir(), testRouterConfig, time.Now())
+	router, err := LoadRouter(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	owner := NewAccountBuilder("Codertocat").Build()
+	bot := NewAccountBuilder("dependabot").Bot().Build()
+	repo := NewRepositoryBuilder(owner, "Hello-World").Build()
+	otherRepo := NewRepositoryBuilder(NewAccountBuilder("octocat").Build(), "Spoon-Knife").Build()
+	tests := []struct {
+		name      string
+		eventType string
+		event     interface{}
+		want      []string
+	}{
+		{"sev label", "issues", NewIssuesEventBuilder("labeled", repo, 1).Label("sev1").Build(), []string{"sev"}},
+		{"other label", "issues", NewIssuesEventBuilder("labeled", repo, 1).Label("bug").Build(), []string{"#4"}},
+		{"sev label already on issue", "issues", NewIssuesEventBuilder("labeled", repo, 1).Labels("sev2").Label("bug").Build(), []string{"sev"}},
+		{"sev label in other repo", "issues", NewIssuesEventBuilder("labeled", otherRepo, 1).Label("sev1").Build(), []string{"#4"}},
+		{"push to master", "push", NewPushEventBuilder(repo).Build(), []string{"deploy"}},
+		{"push to release branch", "push", NewPushEventBuilder(repo).Branch("release/1.x/hotfix").Build(), []string{"deploy"}},
+		{"push to feature branch", "push", NewPushEventBuilder(repo).Branch("feature").Build(), []string{"#4"}},
+		{"push to tag", "push", NewPushEventBuilder(repo).Tag("master").Build(), []string{"#4"}},
+		{"push by bot", "push", NewPushEventBuilder(repo).Sender(bot).Build(), []string{"bots", "#4"}},
+		{"pull request into release", "pull_request", NewPullRequestEventBuilder("opened", repo, 2).Base("release/2").Build(), []string{"prs into release"}},
+		{"pull request into master", "pull_request", NewPullRequestEventBuilder("opened", repo, 2).Build(), []string{"#4"}},
+		{"nil event", "push", (*PushEvent)(nil), []string{"#4"}},
+	}
+	for _, test := range tests {
+		if got := matchedRules(router.Match(test.eventType, test.event)); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: matched %v (should have matched %v)", test.name, got, test.want)
+		}
+	}
+}
+
+func TestRouterExplain(t *testing.T) {
+	router, err := NewRouter([]Rule{
+		{Name: "sev", Handler: "pager", Events: []string{"issues"}, Labels: []string{"sev*"}},
+		{Name: "push", Handler: "deployer", Events: []string{"push"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo := NewRepositoryBuilder(NewAccountBuilder("Codertocat").Build(), "Hello-World").Build()
+	results := router.Explain("issues", NewIssuesEventBuilder("opened", repo, 1).Labels("bug").Build())
+	if len(results) != 2 || results[0].Matched || results[1].Matched {
+		t.Fatalf("Explain() was %+v", results)
+	}
+	if !strings.Contains(results[0].Reason, "labels [bug]") || !strings.Contains(results[1].Reason, "event \"issues\"") {
+		t.Errorf("Explain() reasons were \"%s\" and \"%s\"", results[0].Reason, results[1].Reason)
+	}
+}
+
+func TestRouterRoute(t *testing.T) {
+	router, err := NewRouter([]Rule{
+		{Name: "all", Handler: "count", Continue: true},
+		{Name: "push", Handler: "fail", Events: []string{"push"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	count := 0
+	router.Handle("count", func(eventType string, event interface{}) error {
+		count++
+		return nil
+	})
+	router.Handle("fail", func(eventType string, event interface{}) error {
+		return errors.New("boom")
+	})
+	repo := NewRepositoryBuilder(NewAccountBuilder("Codertocat").Build(), "Hello-World").Build()
+	matches, err := router.Route("push", NewPushEventBuilder(repo).Build())
+	if err == nil || !strings.Contains(err.Error(), "boom") {
+		t.Errorf("Route() error was %v", err)
+	}
+	if count != 1 || len(matches) != 2 {
+		t.Errorf("count handler called %d times, %d matches", count, len(matches))
+	}
+	if _, err := router.Route("issues", &IssuesEvent{}); err != nil {
+		t.Errorf("Route() failed: %v", err)
+	}
+	if count != 2 {
+		t.Errorf("count handler called %d times (should have been 2)", count)
+	}
+}
+
+func TestRouterInvalidRules(t *testing.T) {
+	for _, rules := range [][]Rule{
+		{{Name: "no handler"}},
+		{{Handler: "x", SenderType: "robot"}},
+		{{Handler: "x", Branches: []string{"[a-"}}},
+	} {
+		if _, err := NewRouter(rules); err == nil {
+			t.Errorf("NewRouter(%+v) didn't fail", rules)
+		}
+	}
+}
+
+func TestRouterReload(t *testing.T) {
+	dir := t.TempDir()
+	start := time.Now().Add(-time.Hour)
+	path := writeRouterConfig(t, dir, `{"rules":[{"name":"old","handler":"h"}]}`, start)
+	router, err := LoadRouter(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reloadErrors := make(chan error, 10)
+	router.OnReloadError = func(err error) { reloadErrors <- err }
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go router.Watch(ctx, 10*time.Millisecond)
+
+	waitFor := func(want string) {
+		t.Helper()
+		deadline := time.Now().Add(5 * time.Second)
+		for time.Now().Before(deadline) {
+			if got := matchedRules(router.Match("push", &PushEvent{})); len(got) == 1 && got[0] == want {
+				return
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		t.Fatalf("rule \"%s\" was never loaded", want)
+	}
+	writeRouterConfig(t, dir, `{"rules":[{"name":"new","handler":"h"}]}`, start.Add(time.Minute))
+	waitFor("new")
+	// Rewrites that keep the modification time are seen too, even at the same size
+	writeRouterConfig(t, dir, `{"rules":[{"name":"two","handler":"h"}]}`, start.Add(time.Minute))
+	waitFor("two")
+	writeRouterConfig(t, dir, `{"rules":[{"name":"new","handler":"h"}]}`, start.Add(time.Minute))
+	waitFor("new")
+	// A broken file is reported, and the old rules kept
+	writeRouterConfig(t, dir, `{"rules":[{"name":"broken"}]}`, start.Add(2*time.Minute))
+	select {
+	case err := <-reloadErrors:
+		if !strings.Contains(err.Error(), "no handler") {
+			t.Errorf("reload error was %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("reloading a broken file didn't fail")
+	}
+	waitFor("new")
+}
@@ -0,0 +1,10 @@
+module github.com/ragnarlonn/github-events/sqlitestore
+
+go 1.18
+
+require (
+	github.com/mattn/go-sqlite3 v1.14.6
+	github.com/ragnarlonn/github-events v0.0.0
+)
+
+replace github.com/ragnarlonn/github-events => ../
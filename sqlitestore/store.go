@@ -0,0 +1,141 @@
+// Package sqlitestore keeps the installations of a ghevent.InstallationRegistry in
+// a SQLite database, so they survive restarts
+package sqlitestore
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	_ "github.com/mattn/go-sqlite3" // The "sqlite3" driver
+
+	ghevent "github.com/ragnarlonn/github-events"
+)
+
+//
+// Opening a store, and a registry kept in it:
+//
+//   store, err := sqlitestore.Open("installations.db")
+//   if err != nil {
+//       ...
+//   }
+//   defer store.Close()
+//   registry, err := ghevent.NewStoredInstallationRegistry(store)
+//
+// Each installation is a row of ghevent_installations, with the registered
+// installation as JSON, and its repositories are rows of ghevent_repositories, for
+// other programs to query:
+//
+//   SELECT installation_id FROM ghevent_repositories WHERE full_name = 'Codertocat/Hello-World'
+//
+
+const schema = `
+CREATE TABLE IF NOT EXISTS ghevent_installations (
+	id      INTEGER PRIMARY KEY,
+	account TEXT NOT NULL,
+	data    TEXT NOT NULL
+);
+CREATE TABLE IF NOT EXISTS ghevent_repositories (
+	full_name       TEXT NOT NULL PRIMARY KEY COLLATE NOCASE,
+	installation_id INTEGER NOT NULL
+);
+CREATE INDEX IF NOT EXISTS ghevent_repositories_installation ON ghevent_repositories (installation_id);
+`
+
+// Store is a ghevent.InstallationStore in a SQLite database
+type Store struct {
+	db *sql.DB
+}
+
+// Open opens (or creates) the database file at path. "file::memory:" is a database
+// in memory
+func Open(path string) (*Store, error) {
+	db, err := sql.Open("sqlite3", path)
+	if err != nil {
+		return nil, err
+	}
+	// Only one connection, so that a database in memory is always the same one
+	db.SetMaxOpenConns(1)
+	store, err := New(db)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return store, nil
+}
+
+// New returns a store in an open SQLite database, creating the tables if needed
+func New(db *sql.DB) (*Store, error) {
+	if _, err := db.Exec(schema); err != nil {
+		return nil, fmt.Errorf("creating tables: %w", err)
+	}
+	return &Store{db: db}, nil
+}
+
+// Close closes the database
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+func (s *Store) LoadInstallations() ([]*ghevent.RegisteredInstallation, error) {
+	rows, err := s.db.Query("SELECT id, data FROM ghevent_installations ORDER BY id")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	installations := []*ghevent.RegisteredInstallation{}
+	for rows.Next() {
+		id, data := 0, ""
+		if err := rows.Scan(&id, &data); err != nil {
+			return nil, err
+		}
+		ri := &ghevent.RegisteredInstallation{}
+		if err := json.Unmarshal([]byte(data), ri); err != nil {
+			return nil, fmt.Errorf("installation %d: %w", id, err)
+		}
+		installations = append(installations, ri)
+	}
+	return installations, rows.Err()
+}
+
+// SaveInstallation replaces the row of the installation and its repositories
+func (s *Store) SaveInstallation(ri *ghevent.RegisteredInstallation) error {
+	data, err := json.Marshal(ri)
+	if err != nil {
+		return err
+	}
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if _, err := tx.Exec("INSERT OR REPLACE INTO ghevent_installations (id, account, data) VALUES (?, ?, ?)", ri.ID, ri.Account, string(data)); err != nil {
+		return err
+	}
+	if _, err := tx.Exec("DELETE FROM ghevent_repositories WHERE installation_id = ?", ri.ID); err != nil {
+		return err
+	}
+	for _, fullName := range ri.Repositories {
+		// Replaced if the installation the repository moved from isn't saved yet
+		if _, err := tx.Exec("INSERT OR REPLACE INTO ghevent_repositories (full_name, installation_id) VALUES (?, ?)", fullName, ri.ID); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// DeleteInstallation deletes the row of the installation and its repositories
+func (s *Store) DeleteInstallation(id int) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if _, err := tx.Exec("DELETE FROM ghevent_repositories WHERE installation_id = ?", id); err != nil {
+		return err
+	}
+	if _, err := tx.Exec("DELETE FROM ghevent_installations WHERE id = ?", id); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
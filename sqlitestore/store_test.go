@@ -0,0 +1,81 @@
+package sqlitestore
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	ghevent "github.com/ragnarlonn/github-events"
+)
+
+func TestStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "installations.db")
+	store, err := Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	registry, err := ghevent.NewStoredInstallationRegistry(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	org := &ghevent.Installation{ID: ghevent.Ptr(1), Account: &ghevent.Account{Login: ghevent.Ptr("octo-org")}, Permissions: map[string]string{"contents": "read"}}
+	user := &ghevent.Installation{ID: ghevent.Ptr(2), Account: &ghevent.Account{Login: ghevent.Ptr("octocat")}}
+	repos := func(names ...string) []ghevent.Repository {
+		repos := []ghevent.Repository{}
+		for _, name := range names {
+			repos = append(repos, ghevent.Repository{FullName: ghevent.Ptr(name)})
+		}
+		return repos
+	}
+	steps := []func() error{
+		func() error { return registry.Load(org, repos("octo-org/a", "octo-org/b")) },
+		func() error { return registry.Load(user, repos("octocat/hello")) },
+		func() error { // Transferred
+			return registry.Apply(&ghevent.InstallationRepositoriesEvent{Action: ghevent.Ptr("added"), Installation: user, RepositoriesAdded: repos("octo-org/b")})
+		},
+		func() error {
+			return registry.Apply(&ghevent.InstallationEvent{Action: ghevent.Ptr("suspend"), Installation: org})
+		},
+	}
+	for _, step := range steps {
+		if err := step(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := store.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Reopened
+	if store, err = Open(path); err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	restored, err := ghevent.NewStoredInstallationRegistry(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, _ := json.Marshal(registry)
+	if got, _ := json.Marshal(restored); string(got) != string(want) {
+		t.Errorf("restored registry is %s (should be %s)", got, want)
+	}
+	if ri := restored.InstallationFor("octo-org/b"); ri == nil || ri.ID != 2 {
+		t.Errorf("InstallationFor(octo-org/b) returned %+v (should be installation 2)", ri)
+	}
+	id := 0
+	if err := store.db.QueryRow("SELECT installation_id FROM ghevent_repositories WHERE full_name = 'OCTO-ORG/B'").Scan(&id); err != nil || id != 2 {
+		t.Errorf("octo-org/b has installation %d in the repositories table (should be 2): %v", id, err)
+	}
+
+	if err := restored.Apply(&ghevent.InstallationEvent{Action: ghevent.Ptr("deleted"), Installation: user}); err != nil {
+		t.Fatal(err)
+	}
+	installations, err := store.LoadInstallations()
+	if err != nil || len(installations) != 1 || installations[0].ID != 1 || !installations[0].Suspended {
+		t.Errorf("loaded %+v after deleting installation 2 (should be the suspended installation 1): %v", installations, err)
+	}
+	count := 0
+	if err := store.db.QueryRow("SELECT COUNT(*) FROM ghevent_repositories").Scan(&count); err != nil || count != 1 {
+		t.Errorf("repositories table has %d rows (should have 1, octo-org/a): %v", count, err)
+	}
+}
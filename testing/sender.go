@@ -0,0 +1,284 @@
+// Package ghtesting fakes Github delivering webhooks, for integration tests of
+// webhook handlers
+package ghtesting
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	ghevent "github.com/ragnarlonn/github-events"
+)
+
+//
+// A sender delivers events to a handler, like Github does:
+//
+//   sender := ghtesting.NewTestWebhookSender(t, handler, "s3cret")
+//   sender.Register("push", "issues") // Sends a ping, like Github does
+//   repo := ghevent.NewRepositoryBuilder(ghevent.NewAccountBuilder("octocat").Build(), "hello").Build()
+//   sent, err := sender.Send(ghevent.NewPushEventBuilder(repo).Commit("Fix", nil, nil, []string{"main.go"}).Build())
+//
+// Deliveries are signed and have the headers Github sends. Like Github, the sender
+// gives up on a delivery after 10 seconds, and unlike Github (where redelivery is
+// manual) it retries deliveries that time out or get a 5xx response. Redeliver sends
+// a delivery again, with the same GUID.
+//
+
+const (
+	DefaultTimeout      = 10 * time.Second
+	DefaultMaxAttempts  = 3
+	DefaultRetryBackoff = 100 * time.Millisecond
+
+	userAgent = "GitHub-Hookshot/044aadd"
+)
+
+var ErrNotSubscribed = errors.New("hook is not subscribed to the event")
+
+type WebhookSender struct {
+	URL          string
+	Secret       []byte
+	Timeout      time.Duration // Per attempt
+	MaxAttempts  int           // Including the first one
+	RetryBackoff time.Duration // Before the first retry, doubled for every following one
+
+	// The webhook, described in pings. Its events (default "*") are the ones Send
+	// sends
+	Hook *ghevent.Hook
+	// The repository the hook belongs to, and the user that created it. Both are
+	// sent with pings
+	Repository *ghevent.Repository
+	Sender     *ghevent.Account
+
+	mu         sync.Mutex
+	deliveries []*SentDelivery
+}
+
+// SentDelivery is a delivery, and how each attempt to send it went
+type SentDelivery struct {
+	*ghevent.Delivery
+	Redelivery bool
+	Attempts   []Attempt
+}
+
+type Attempt struct {
+	Status   int    // HTTP status, or 0 if the request failed
+	Body     []byte // Response body
+	Duration time.Duration
+	Err      error // Request error, or non-2xx status
+}
+
+// Status returns the HTTP status of the last attempt
+func (s *SentDelivery) Status() int {
+	if len(s.Attempts) == 0 {
+		return 0
+	}
+	return s.Attempts[len(s.Attempts)-1].Status
+}
+
+// NewWebhookSender returns a sender for a repository webhook posting to url, signing
+// deliveries with secret (unless it is empty)
+func NewWebhookSender(url, secret string) *WebhookSender {
+	owner := ghevent.NewAccountBuilder("Codertocat").Build()
+	repo := ghevent.NewRepositoryBuilder(owner, "Hello-World").Build()
+	hookURL := repo.GetURL() + "/hooks/1"
+	hook := &ghevent.Hook{
+		Type:   ghevent.Ptr("Repository"),
+		ID:     ghevent.Ptr(1),
+		Name:   ghevent.Ptr("web"),
+		Active: ghevent.Ptr(true),
+		Events: []string{"*"},
+		Config: &ghevent.HookConfig{
+			ContentType: ghevent.Ptr("json"),
+			InsecureSSL: ghevent.Ptr("0"),
+			URL:         ghevent.Ptr(url),
+		},
+		URL:           ghevent.Ptr(hookURL),
+		TestURL:       ghevent.Ptr(hookURL + "/test"),
+		PingURL:       ghevent.Ptr(hookURL + "/pings"),
+		DeliveriesURL: ghevent.Ptr(hookURL + "/deliveries"),
+		LastResponse:  &ghevent.HookResponse{Status: ghevent.Ptr("unused")},
+		CreatedAt:     ghevent.NewTimeWrapper(time.Now()),
+		UpdatedAt:     ghevent.NewTimeWrapper(time.Now()),
+	}
+	if secret != "" {
+		hook.Config.Secret = ghevent.Ptr("********")
+	}
+	return &WebhookSender{
+		URL:          url,
+		Secret:       []byte(secret),
+		Timeout:      DefaultTimeout,
+		MaxAttempts:  DefaultMaxAttempts,
+		RetryBackoff: DefaultRetryBackoff,
+		Hook:         hook,
+		Repository:   repo,
+		Sender:       owner,
+	}
+}
+
+// NewTestWebhookSender starts an httptest server running handler, and returns a
+// sender posting to it. The server is closed when the test ends
+func NewTestWebhookSender(t testing.TB, handler http.Handler, secret string) *WebhookSender {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return NewWebhookSender(server.URL, secret)
+}
+
+// Register subscribes the hook to the given events ("*" for all) and, like Github
+// does when a webhook is created, sends a ping
+func (s *WebhookSender) Register(events ...string) (*SentDelivery, error) {
+	s.Hook.Events = events
+	return s.Ping()
+}
+
+// Ping sends a ping event
+func (s *WebhookSender) Ping() (*SentDelivery, error) {
+	ping := &ghevent.PingEvent{
+		Zen:        ghevent.Ptr("Design for failure."),
+		HookID:     s.Hook.ID,
+		Hook:       s.Hook,
+		Repository: s.Repository,
+		Sender:     s.Sender,
+	}
+	payload, err := json.Marshal(ping)
+	if err != nil {
+		return nil, err
+	}
+	return s.deliver(&SentDelivery{Delivery: s.newDelivery("ping", payload)})
+}
+
+// Send sends an event struct, e.g. a *ghevent.PushEvent, if the hook is subscribed to
+// its event type. The error is that of the last attempt, if it failed
+func (s *WebhookSender) Send(event interface{}) (*SentDelivery, error) {
+	eventType := ghevent.EventTypeOf(event)
+	if eventType == "" {
+		return nil, fmt.Errorf("%T is not an event type", event)
+	}
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+	return s.SendPayload(eventType, payload)
+}
+
+// SendPayload sends a JSON payload as an event of the given type
+func (s *WebhookSender) SendPayload(eventType string, payload []byte) (*SentDelivery, error) {
+	if !s.subscribed(eventType) {
+		return nil, ErrNotSubscribed
+	}
+	return s.deliver(&SentDelivery{Delivery: s.newDelivery(eventType, payload)})
+}
+
+// Redeliver sends an earlier delivery again, with the same GUID and payload
+func (s *WebhookSender) Redeliver(guid string) (*SentDelivery, error) {
+	s.mu.Lock()
+	var original *SentDelivery
+	for _, sent := range s.deliveries {
+		if sent.GUID() == guid {
+			original = sent
+			break
+		}
+	}
+	s.mu.Unlock()
+	if original == nil {
+		return nil, fmt.Errorf("no delivery with GUID %s", guid)
+	}
+	d := &ghevent.Delivery{Header: original.Header.Clone(), Body: original.Body, DeliveredAt: time.Now()}
+	return s.deliver(&SentDelivery{Delivery: d, Redelivery: true})
+}
+
+// Deliveries returns everything sent so far, including pings and redeliveries
+func (s *WebhookSender) Deliveries() []*SentDelivery {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*SentDelivery{}, s.deliveries...)
+}
+
+func (s *WebhookSender) subscribed(eventType string) bool {
+	for _, event := range s.Hook.Events {
+		if event == "*" || event == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *WebhookSender) newDelivery(eventType string, payload []byte) *ghevent.Delivery {
+	d := &ghevent.Delivery{Header: http.Header{}, Body: payload, DeliveredAt: time.Now()}
+	d.Header.Set("Content-Type", "application/json")
+	d.Header.Set("User-Agent", userAgent)
+	d.Header.Set(ghevent.EventHeader, eventType)
+	d.Header.Set(ghevent.DeliveryHeader, newGUID())
+	d.Header.Set("X-Github-Hook-Id", strconv.Itoa(s.Hook.GetID()))
+	if s.Repository != nil {
+		d.Header.Set("X-Github-Hook-Installation-Target-Id", strconv.Itoa(s.Repository.GetID()))
+		d.Header.Set("X-Github-Hook-Installation-Target-Type", strings.ToLower(s.Hook.GetType()))
+	}
+	if len(s.Secret) > 0 {
+		d.Sign(s.Secret)
+	}
+	return d
+}
+
+// newGUID returns a random (version 4) UUID
+func newGUID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+func (s *WebhookSender) deliver(sent *SentDelivery) (*SentDelivery, error) {
+	s.mu.Lock()
+	s.deliveries = append(s.deliveries, sent)
+	s.mu.Unlock()
+	client := &http.Client{Timeout: s.Timeout}
+	backoff := s.RetryBackoff
+	for attempt := 1; ; attempt++ {
+		a := s.attempt(client, sent.Delivery)
+		s.mu.Lock()
+		sent.Attempts = append(sent.Attempts, a)
+		s.mu.Unlock()
+		retry := a.Err != nil && (a.Status == 0 || a.Status >= 500)
+		if !retry || attempt >= s.MaxAttempts {
+			return sent, a.Err
+		}
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+func (s *WebhookSender) attempt(client *http.Client, d *ghevent.Delivery) Attempt {
+	a := Attempt{}
+	req, err := http.NewRequest(http.MethodPost, s.URL, bytes.NewReader(d.Body))
+	if err != nil {
+		a.Err = err
+		return a
+	}
+	req.Header = d.Header.Clone()
+	start := time.Now()
+	resp, err := client.Do(req)
+	if err != nil {
+		a.Duration = time.Since(start)
+		a.Err = err
+		return a
+	}
+	defer resp.Body.Close()
+	a.Body, a.Err = ioutil.ReadAll(resp.Body)
+	a.Duration = time.Since(start)
+	a.Status = resp.StatusCode
+	if a.Err == nil && (resp.StatusCode < 200 || resp.StatusCode > 299) {
+		a.Err = fmt.Errorf("%s", resp.Status)
+	}
+	return a
+}
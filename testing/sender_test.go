@@ -0,0 +1,123 @@
+package ghtesting
+
+import (
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	ghevent "github.com/ragnarlonn/github-events"
+)
+
+// recorder is a webhook handler that records what it gets, and answers with the
+// statuses given (then 200)
+type recorder struct {
+	mu       sync.Mutex
+	secret   []byte
+	statuses []int
+	delay    time.Duration
+	received []*ghevent.Delivery
+}
+
+func (r *recorder) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := ioutil.ReadAll(req.Body)
+	d := &ghevent.Delivery{Header: req.Header, Body: body}
+	r.mu.Lock()
+	r.received = append(r.received, d)
+	status := http.StatusOK
+	if len(r.statuses) > 0 {
+		status, r.statuses = r.statuses[0], r.statuses[1:]
+	}
+	r.mu.Unlock()
+	if d.Verify(r.secret) != nil {
+		status = http.StatusUnauthorized
+	}
+	time.Sleep(r.delay)
+	w.WriteHeader(status)
+}
+
+func testRepository() *ghevent.Repository {
+	return ghevent.NewRepositoryBuilder(ghevent.NewAccountBuilder("octocat").Build(), "Spoon-Knife").Build()
+}
+
+func TestWebhookSender(t *testing.T) {
+	r := &recorder{secret: []byte("s3cret")}
+	sender := NewTestWebhookSender(t, r, "s3cret")
+	sender.RetryBackoff = time.Millisecond
+
+	ping, err := sender.Register("push", "issues")
+	if err != nil {
+		t.Fatal(err)
+	}
+	event, err := r.received[0].Decode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p := event.(*ghevent.PingEvent); p.GetHookID() != 1 || len(p.GetHook().GetEvents()) != 2 || p.GetHook().GetConfig().GetURL() != sender.URL {
+		t.Errorf("wrong ping: %+v", p)
+	}
+	if ping.Status() != http.StatusOK || r.received[0].GUID() != ping.GUID() || r.received[0].Header.Get("User-Agent") == "" {
+		t.Errorf("ping delivery %v was received with headers %v", ping.Status(), r.received[0].Header)
+	}
+
+	// Retries on 5xx, with the same GUID
+	r.statuses = []int{http.StatusBadGateway, http.StatusServiceUnavailable}
+	sent, err := sender.Send(ghevent.NewPushEventBuilder(testRepository()).Build())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sent.Attempts) != 3 || len(r.received) != 4 || r.received[1].GUID() != r.received[3].GUID() {
+		t.Errorf("push took %d attempts, %d deliveries received", len(sent.Attempts), len(r.received))
+	}
+	if r.received[3].EventType() != "push" || r.received[3].Header.Get("X-Github-Hook-Installation-Target-Type") != "repository" {
+		t.Errorf("wrong headers %v", r.received[3].Header)
+	}
+
+	// But gives up after MaxAttempts, and doesn't retry 4xx
+	r.statuses = []int{500, 500, 500, 500}
+	if sent, err = sender.Send(ghevent.NewIssuesEventBuilder("opened", testRepository(), 1).Build()); err == nil || len(sent.Attempts) != 3 {
+		t.Errorf("got %v after %d attempts", err, len(sent.Attempts))
+	}
+	r.statuses = []int{http.StatusNotFound}
+	if sent, err = sender.Send(ghevent.NewIssuesEventBuilder("opened", testRepository(), 1).Build()); err == nil || len(sent.Attempts) != 1 {
+		t.Errorf("got %v after %d attempts", err, len(sent.Attempts))
+	}
+
+	if _, err := sender.Send(ghevent.NewPullRequestEventBuilder("opened", testRepository(), 2).Build()); err != ErrNotSubscribed {
+		t.Errorf("sending an event the hook isn't subscribed to returned %v", err)
+	}
+	if _, err := sender.Send(&ghevent.Repository{}); err == nil {
+		t.Error("sending a non-event should fail")
+	}
+
+	redelivered, err := sender.Redeliver(ping.GUID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	last := r.received[len(r.received)-1]
+	if !redelivered.Redelivery || last.GUID() != ping.GUID() || string(last.Body) != string(ping.Body) {
+		t.Errorf("redelivery got GUID %s (should be %s)", last.GUID(), ping.GUID())
+	}
+	if _, err := sender.Redeliver("nope"); err == nil {
+		t.Error("redelivering an unknown GUID should fail")
+	}
+	if n := len(sender.Deliveries()); n != 5 {
+		t.Errorf("sender has %d deliveries (should have 5)", n)
+	}
+}
+
+func TestWebhookSenderTimeout(t *testing.T) {
+	r := &recorder{delay: 100 * time.Millisecond}
+	sender := NewTestWebhookSender(t, r, "")
+	sender.Timeout = 20 * time.Millisecond
+	sender.MaxAttempts = 2
+	sender.RetryBackoff = time.Millisecond
+	sent, err := sender.Send(ghevent.NewPushEventBuilder(testRepository()).Build())
+	if err == nil || len(sent.Attempts) != 2 || sent.Status() != 0 {
+		t.Errorf("got %v after %d attempts", err, len(sent.Attempts))
+	}
+	if sent.Header.Get(ghevent.SignatureHeader) != "" {
+		t.Error("delivery without a secret shouldn't be signed")
+	}
+}
@@ -0,0 +1,290 @@
+package ghevent
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+//
+// Many of the *_url fields are RFC 6570 URI templates, e.g. ".../commits{/sha}" or
+// ".../notifications{?since,all,participating}". ExpandURITemplate implements level 3
+// of RFC 6570 (all operators, multiple variables per expression, string values),
+// which is all that Github uses.
+//
+
+// Per-operator expansion rules, from RFC 6570 appendix A
+type templateOperator struct {
+	first    string
+	sep      string
+	named    bool
+	ifEmpty  string
+	reserved bool // allow reserved characters (and existing %XX escapes) through
+}
+
+var templateOperators = map[byte]templateOperator{
+	'+': {first: "", sep: ",", reserved: true},
+	'#': {first: "#", sep: ",", reserved: true},
+	'.': {first: ".", sep: "."},
+	'/': {first: "/", sep: "/"},
+	';': {first: ";", sep: ";", named: true},
+	'?': {first: "?", sep: "&", named: true, ifEmpty: "="},
+	'&': {first: "&", sep: "&", named: true, ifEmpty: "="},
+}
+
+// ExpandURITemplate expands template using values. Variables that aren't in values
+// are undefined, and expand to nothing (as opposed to the empty string, which is a
+// defined value)
+func ExpandURITemplate(template string, values map[string]string) (string, error) {
+	var sb strings.Builder
+	for len(template) > 0 {
+		start := strings.IndexByte(template, '{')
+		if start < 0 {
+			if strings.IndexByte(template, '}') >= 0 {
+				return "", errors.New("unmatched \"}\" in URI template")
+			}
+			sb.WriteString(templateEscape(template, true))
+			break
+		}
+		if strings.IndexByte(template[:start], '}') >= 0 {
+			return "", errors.New("unmatched \"}\" in URI template")
+		}
+		sb.WriteString(templateEscape(template[:start], true))
+		end := strings.IndexByte(template[start:], '}')
+		if end < 0 {
+			return "", errors.New("unterminated expression in URI template")
+		}
+		expanded, err := expandExpression(template[start+1:start+end], values)
+		if err != nil {
+			return "", err
+		}
+		sb.WriteString(expanded)
+		template = template[start+end+1:]
+	}
+	return sb.String(), nil
+}
+
+func expandExpression(expr string, values map[string]string) (string, error) {
+	if expr == "" {
+		return "", errors.New("empty expression in URI template")
+	}
+	op := templateOperator{first: "", sep: ","}
+	if o, ok := templateOperators[expr[0]]; ok {
+		op = o
+		expr = expr[1:]
+	} else if strings.IndexByte("=,!@|", expr[0]) >= 0 {
+		return "", fmt.Errorf("reserved operator \"%c\" in URI template", expr[0])
+	}
+	parts := []string{}
+	for _, name := range strings.Split(expr, ",") {
+		if !validVarName(name) {
+			if strings.ContainsAny(name, ":*") {
+				return "", fmt.Errorf("URI template modifier in \"%s\" is not supported (level 4)", name)
+			}
+			return "", fmt.Errorf("invalid variable name \"%s\" in URI template", name)
+		}
+		value, ok := values[name]
+		if !ok {
+			continue
+		}
+		part := ""
+		if op.named {
+			part = name
+			if value == "" {
+				parts = append(parts, part+op.ifEmpty)
+				continue
+			}
+			part += "="
+		}
+		parts = append(parts, part+templateEscape(value, op.reserved))
+	}
+	if len(parts) == 0 {
+		return "", nil
+	}
+	return op.first + strings.Join(parts, op.sep), nil
+}
+
+func validVarName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', c == '_', c == '.':
+		case c == '%' && i+2 < len(name) && isHex(name[i+1]) && isHex(name[i+2]):
+			i += 2
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func isHex(c byte) bool {
+	return (c >= '0' && c <= '9') || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')
+}
+
+// templateEscape percent-encodes everything but unreserved characters, and, if
+// reserved is true, reserved characters and existing percent-encoded triplets
+func templateEscape(s string, reserved bool) string {
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', strings.IndexByte("-._~", c) >= 0:
+			sb.WriteByte(c)
+		case reserved && strings.IndexByte(":/?#[]@!$&'()*+,;=", c) >= 0:
+			sb.WriteByte(c)
+		case reserved && c == '%' && i+2 < len(s) && isHex(s[i+1]) && isHex(s[i+2]):
+			sb.WriteString(s[i : i+3])
+			i += 2
+		default:
+			fmt.Fprintf(&sb, "%%%02X", c)
+		}
+	}
+	return sb.String()
+}
+
+//
+// Typed helpers for the templated URLs. Empty string (or zero) arguments are left
+// undefined, which gives you the URL of the list rather than of a single item, e.g.
+// ExpandIssuesURL(0) returns ".../issues"
+//
+
+func expandURLField(field, template string, values map[string]string) (string, error) {
+	if template == "" {
+		return "", fmt.Errorf("%s is missing", field)
+	}
+	for name, value := range values {
+		if value == "" {
+			delete(values, name)
+		}
+	}
+	return ExpandURITemplate(template, values)
+}
+
+func optionalNumber(n int) string {
+	if n == 0 {
+		return ""
+	}
+	return strconv.Itoa(n)
+}
+
+func (r *Repository) ExpandCommitsURL(sha string) (string, error) {
+	return expandURLField("commits_url", r.GetCommitsURL(), map[string]string{"sha": sha})
+}
+
+func (r *Repository) ExpandGitCommitsURL(sha string) (string, error) {
+	return expandURLField("git_commits_url", r.GetGitCommitsURL(), map[string]string{"sha": sha})
+}
+
+func (r *Repository) ExpandStatusesURL(sha string) (string, error) {
+	return expandURLField("statuses_url", r.GetStatusesURL(), map[string]string{"sha": sha})
+}
+
+func (r *Repository) ExpandTreesURL(sha string) (string, error) {
+	return expandURLField("trees_url", r.GetTreesURL(), map[string]string{"sha": sha})
+}
+
+func (r *Repository) ExpandBlobsURL(sha string) (string, error) {
+	return expandURLField("blobs_url", r.GetBlobsURL(), map[string]string{"sha": sha})
+}
+
+// ExpandContentsURL takes a path in the repository, e.g. "docs/README.md". Slashes
+// are kept as-is, and everything else that isn't allowed in a path segment is
+// percent-encoded, including "#", "?" and "%"
+func (r *Repository) ExpandContentsURL(path string) (string, error) {
+	// {+path} keeps reserved characters, so the segments are encoded here and
+	// the escapes pass through
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return expandURLField("contents_url", r.GetContentsURL(), map[string]string{"path": strings.Join(segments, "/")})
+}
+
+// ExpandCompareURL takes two commitishes, e.g. "master" and "feature" or two SHAs
+func (r *Repository) ExpandCompareURL(base, head string) (string, error) {
+	if base == "" || head == "" {
+		return "", errors.New("both base and head are needed for compare_url")
+	}
+	return expandURLField("compare_url", r.GetCompareURL(), map[string]string{"base": base, "head": head})
+}
+
+// ExpandArchiveURL takes an archive format ("tarball" or "zipball") and an optional ref
+func (r *Repository) ExpandArchiveURL(format, ref string) (string, error) {
+	if format != "tarball" && format != "zipball" {
+		return "", fmt.Errorf("unknown archive format \"%s\" for archive_url (should be \"tarball\" or \"zipball\")", format)
+	}
+	return expandURLField("archive_url", r.GetArchiveURL(), map[string]string{"archive_format": format, "ref": ref})
+}
+
+func (r *Repository) ExpandBranchesURL(branch string) (string, error) {
+	return expandURLField("branches_url", r.GetBranchesURL(), map[string]string{"branch": branch})
+}
+
+func (r *Repository) ExpandIssuesURL(number int) (string, error) {
+	return expandURLField("issues_url", r.GetIssuesURL(), map[string]string{"number": optionalNumber(number)})
+}
+
+func (r *Repository) ExpandIssueCommentsURL(id int) (string, error) {
+	return expandURLField("issue_comment_url", r.GetIssueCommentsURL(), map[string]string{"number": optionalNumber(id)})
+}
+
+func (r *Repository) ExpandPullsURL(number int) (string, error) {
+	return expandURLField("pulls_url", r.GetPullsURL(), map[string]string{"number": optionalNumber(number)})
+}
+
+func (r *Repository) ExpandMilestonesURL(number int) (string, error) {
+	return expandURLField("milestones_url", r.GetMilestonesURL(), map[string]string{"number": optionalNumber(number)})
+}
+
+func (r *Repository) ExpandLabelsURL(name string) (string, error) {
+	return expandURLField("labels_url", r.GetLabelsURL(), map[string]string{"name": name})
+}
+
+func (r *Repository) ExpandReleasesURL(id int) (string, error) {
+	return expandURLField("releases_url", r.GetReleasesURL(), map[string]string{"id": optionalNumber(id)})
+}
+
+func (r *Repository) ExpandAssigneesURL(user string) (string, error) {
+	return expandURLField("assignees_url", r.GetAssigneesURL(), map[string]string{"user": user})
+}
+
+func (r *Repository) ExpandCollaboratorsURL(collaborator string) (string, error) {
+	return expandURLField("collaborators_url", r.GetCollaboratorsURL(), map[string]string{"collaborator": collaborator})
+}
+
+// ExpandNotificationURL takes the query parameters of the notifications endpoint:
+// "since", "all" and "participating". Unset (or empty) ones are left out
+func (r *Repository) ExpandNotificationURL(params map[string]string) (string, error) {
+	values := map[string]string{}
+	for name, value := range params {
+		values[name] = value
+	}
+	return expandURLField("notifications_url", r.GetNotificationURL(), values)
+}
+
+func (a *Account) ExpandFollowingURL(otherUser string) (string, error) {
+	return expandURLField("following_url", a.GetFollowingURL(), map[string]string{"other_user": otherUser})
+}
+
+func (a *Account) ExpandGistsURL(gistID string) (string, error) {
+	return expandURLField("gists_url", a.GetGistsURL(), map[string]string{"gist_id": gistID})
+}
+
+func (a *Account) ExpandStarredURL(owner, repo string) (string, error) {
+	return expandURLField("starred_url", a.GetStarredURL(), map[string]string{"owner": owner, "repo": repo})
+}
+
+// ExpandEventsURL takes an optional privacy setting, i.e. "public"
+func (a *Account) ExpandEventsURL(privacy string) (string, error) {
+	return expandURLField("events_url", a.GetEventsURL(), map[string]string{"privacy": privacy})
+}
+
+func (i *Issue) ExpandLabelsURL(name string) (string, error) {
+	return expandURLField("labels_url", i.GetLabelsURL(), map[string]string{"name": name})
+}
@@ -0,0 +1,129 @@
+package ghevent
+
+import (
+	"io/ioutil"
+	"testing"
+)
+
+// Examples from RFC 6570, section 1.2 (levels 1-3) and section 3.2
+func TestExpandURITemplate(t *testing.T) {
+	values := map[string]string{
+		"var":   "value",
+		"hello": "Hello World!",
+		"path":  "/foo/bar",
+		"empty": "",
+		"x":     "1024",
+		"y":     "768",
+		"half":  "50%",
+		"base":  "http://example.com/home/",
+	}
+	tests := []struct {
+		template string
+		want     string
+	}{
+		{"{var}", "value"},
+		{"{hello}", "Hello%20World%21"},
+		{"{half}", "50%25"},
+		{"O{empty}X", "OX"},
+		{"O{undef}X", "OX"},
+		{"{+var}", "value"},
+		{"{+hello}", "Hello%20World!"},
+		{"{+half}", "50%25"},
+		{"{base}index", "http%3A%2F%2Fexample.com%2Fhome%2Findex"},
+		{"{+base}index", "http://example.com/home/index"},
+		{"{+path}/here", "/foo/bar/here"},
+		{"here?ref={+path}", "here?ref=/foo/bar"},
+		{"{#var}", "#value"},
+		{"{#hello}", "#Hello%20World!"},
+		{"X{#undef}", "X"},
+		{"map?{x,y}", "map?1024,768"},
+		{"{x,hello,y}", "1024,Hello%20World%21,768"},
+		{"{+x,hello,y}", "1024,Hello%20World!,768"},
+		{"{+path,x}/here", "/foo/bar,1024/here"},
+		{"{#x,hello,y}", "#1024,Hello%20World!,768"},
+		{"{#path,x}/here", "#/foo/bar,1024/here"},
+		{"X{.var}", "X.value"},
+		{"X{.x,y}", "X.1024.768"},
+		{"X{.empty}", "X."},
+		{"X{.undef}", "X"},
+		{"{/var}", "/value"},
+		{"{/var,x}/here", "/value/1024/here"},
+		{"{/var,undef}", "/value"},
+		{"{;x,y}", ";x=1024;y=768"},
+		{"{;x,y,empty}", ";x=1024;y=768;empty"},
+		{"{?x,y}", "?x=1024&y=768"},
+		{"{?x,y,empty}", "?x=1024&y=768&empty="},
+		{"{?undef}", ""},
+		{"?fixed=yes{&x}", "?fixed=yes&x=1024"},
+		{"{&x,y,empty}", "&x=1024&y=768&empty="},
+		{"{var}{/undef}{?undef}", "value"},
+	}
+	for _, test := range tests {
+		got, err := ExpandURITemplate(test.template, values)
+		if err != nil {
+			t.Errorf("ExpandURITemplate(\"%s\") failed: %v", test.template, err)
+		} else if got != test.want {
+			t.Errorf("ExpandURITemplate(\"%s\") was \"%s\" (should have been \"%s\")", test.template, got, test.want)
+		}
+	}
+}
+
+func TestExpandURITemplateErrors(t *testing.T) {
+	for _, template := range []string{"{var", "var}", "{}", "{var:3}", "{list*}", "{=var}", "{va r}"} {
+		if got, err := ExpandURITemplate(template, map[string]string{"var": "value"}); err == nil {
+			t.Errorf("ExpandURITemplate(\"%s\") didn't fail (returned \"%s\")", template, got)
+		}
+	}
+}
+
+func TestRepositoryURLHelpers(t *testing.T) {
+	payload, err := ioutil.ReadFile("testdata/push/branch.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	event, err := ParseWebHook("push", payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo := event.(*PushEvent).Repository
+	api := "https://api.github.com/repos/Codertocat/Hello-World"
+	tests := []struct {
+		name string
+		got  func() (string, error)
+		want string
+	}{
+		{"ExpandCommitsURL", func() (string, error) { return repo.ExpandCommitsURL("6113728f27ae") }, api + "/commits/6113728f27ae"},
+		{"ExpandCommitsURL (list)", func() (string, error) { return repo.ExpandCommitsURL("") }, api + "/commits"},
+		{"ExpandContentsURL", func() (string, error) { return repo.ExpandContentsURL("docs/my file.md") }, api + "/contents/docs/my%20file.md"},
+		{"ExpandContentsURL (reserved)", func() (string, error) { return repo.ExpandContentsURL("docs/a#b?/100%.md") }, api + "/contents/docs/a%23b%3F/100%25.md"},
+		{"ExpandCompareURL", func() (string, error) { return repo.ExpandCompareURL("master", "feature/x") }, api + "/compare/master...feature%2Fx"},
+		{"ExpandStatusesURL", func() (string, error) { return repo.ExpandStatusesURL("abc123") }, api + "/statuses/abc123"},
+		{"ExpandIssuesURL", func() (string, error) { return repo.ExpandIssuesURL(12) }, api + "/issues/12"},
+		{"ExpandIssuesURL (list)", func() (string, error) { return repo.ExpandIssuesURL(0) }, api + "/issues"},
+		{"ExpandLabelsURL", func() (string, error) { return repo.ExpandLabelsURL("good first issue") }, api + "/labels/good%20first%20issue"},
+		{"ExpandArchiveURL", func() (string, error) { return repo.ExpandArchiveURL("tarball", "v1.0") }, api + "/tarball/v1.0"},
+		{"ExpandNotificationURL", func() (string, error) {
+			return repo.ExpandNotificationURL(map[string]string{"since": "2021-01-14T07:35:08Z", "participating": "true"})
+		}, api + "/notifications?since=2021-01-14T07%3A35%3A08Z&participating=true"},
+		{"ExpandFollowingURL", func() (string, error) { return repo.Owner.ExpandFollowingURL("octocat") }, "https://api.github.com/users/Codertocat/following/octocat"},
+		{"ExpandStarredURL", func() (string, error) { return repo.Owner.ExpandStarredURL("octocat", "Spoon-Knife") }, "https://api.github.com/users/Codertocat/starred/octocat/Spoon-Knife"},
+	}
+	for _, test := range tests {
+		got, err := test.got()
+		if err != nil {
+			t.Errorf("%s() failed: %v", test.name, err)
+		} else if got != test.want {
+			t.Errorf("%s() was \"%s\" (should have been \"%s\")", test.name, got, test.want)
+		}
+	}
+	if _, err := repo.ExpandCompareURL("master", ""); err == nil {
+		t.Error("ExpandCompareURL() didn't fail without a head")
+	}
+	if _, err := repo.ExpandArchiveURL("tar.gz", "v1.0"); err == nil {
+		t.Error("ExpandArchiveURL() didn't fail on an unknown format")
+	}
+	var missing *Repository
+	if _, err := missing.ExpandCommitsURL("abc"); err == nil {
+		t.Error("ExpandCommitsURL() didn't fail on a nil repository")
+	}
+}
@@ -0,0 +1,71 @@
+package ghevent
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+//
+// Mapping between the X-Github-Event header value and the types in this package
+//
+
+var eventTypes = map[string]func() interface{}{
+	"fork":                      func() interface{} { return &ForkEvent{} },
+	"installation":              func() interface{} { return &InstallationEvent{} },
+	"installation_repositories": func() interface{} { return &InstallationRepositoriesEvent{} },
+	"issue_comment":             func() interface{} { return &IssueCommentEvent{} },
+	"issues":                    func() interface{} { return &IssuesEvent{} },
+	"label":                     func() interface{} { return &LabelEvent{} },
+	"milestone":                 func() interface{} { return &MilestoneEvent{} },
+	"ping":                      func() interface{} { return &PingEvent{} },
+	"pull_request":              func() interface{} { return &PullRequestEvent{} },
+	"push":                      func() interface{} { return &PushEvent{} },
+}
+
+// EventTypes returns the (sorted) X-Github-Event names this package can decode
+func EventTypes() []string {
+	names := make([]string, 0, len(eventTypes))
+	for name := range eventTypes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// NewEvent returns a pointer to a new, empty, event struct for the X-Github-Event
+// name given, e.g. a *PushEvent for "push"
+func NewEvent(eventType string) (interface{}, error) {
+	newFunc, ok := eventTypes[eventType]
+	if !ok {
+		return nil, fmt.Errorf("unknown event type \"%s\"", eventType)
+	}
+	return newFunc(), nil
+}
+
+// EventTypeOf returns the X-Github-Event name for an event struct, e.g. "push" for a
+// *PushEvent, or "" if it isn't an event type
+func EventTypeOf(event interface{}) string {
+	t := reflect.TypeOf(event)
+	for name, newFunc := range eventTypes {
+		if reflect.TypeOf(newFunc()) == t {
+			return name
+		}
+	}
+	return ""
+}
+
+// ParseWebHook decodes a webhook payload into the event struct matching eventType
+// (the value of the X-Github-Event header). The returned value is a pointer, e.g.
+// a *PushEvent for "push"
+func ParseWebHook(eventType string, payload []byte) (interface{}, error) {
+	event, err := NewEvent(eventType)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(payload, event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
@@ -0,0 +1,462 @@
+package ghevent
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+//
+// Golden fixtures: testdata/<X-Github-Event>/<action or variant>.json
+//
+// Each fixture is decoded into its ghevent type, checked for a few key fields, then
+// encoded again and compared to the original JSON. The comparison is semantic: nulls
+// and empty arrays are dropped (a nil pointer/slice with omitempty won't be encoded),
+// and timestamps are compared as points in time (epoch integers are re-encoded as
+// RFC3339 strings). Anything else that goes missing means a field isn't modelled, or
+// has a broken struct tag.
+//
+
+var fixtureTests = map[string]func(t *testing.T, event interface{}){
+	"push/branch.json": func(t *testing.T, event interface{}) {
+		e := event.(*PushEvent)
+		expectString(t, "ref", e.Ref, "refs/heads/master")
+		expectString(t, "repository.full_name", e.Repository.FullName, "Codertocat/Hello-World")
+		expectString(t, "pusher.email", e.Pusher.Email, "21031067+Codertocat@users.noreply.github.com")
+		if len(e.Commits) != 2 {
+			t.Fatalf("len(commits) was %d (should have been 2)", len(e.Commits))
+		}
+		expectString(t, "commits[1].author.username", e.Commits[1].Author.Username, "Codertocat")
+		if len(e.Commits[1].Removed) != 1 || e.Commits[1].Removed[0] != "bin/launcher" {
+			t.Errorf("commits[1].removed was %v", e.Commits[1].Removed)
+		}
+		expectString(t, "head_commit.id", e.HeadCommit.ID, "a10867b14bb761a232cd80139fbd4c0d33264240")
+		expectInt(t, "installation.id", e.Installation.ID, 2311213)
+		if e.Repository.CreatedAt.Time().Unix() != 1557933565 {
+			t.Errorf("repository.created_at was %v", e.Repository.CreatedAt.Time())
+		}
+	},
+	"push/tag_deleted.json": func(t *testing.T, event interface{}) {
+		e := event.(*PushEvent)
+		expectString(t, "ref", e.Ref, "refs/tags/simple-tag")
+		expectBool(t, "deleted", e.Deleted, true)
+		if e.HeadCommit != nil {
+			t.Error("head_commit was not <nil> when it was null in the input")
+		}
+		if e.BaseRef != nil {
+			t.Error("base_ref was not <nil> when it was null in the input")
+		}
+		if e.Commits == nil || len(e.Commits) != 0 {
+			t.Errorf("commits was %v (should have been an empty slice)", e.Commits)
+		}
+	},
+	"push/force_new_branch.json": func(t *testing.T, event interface{}) {
+		e := event.(*PushEvent)
+		expectBool(t, "created", e.Created, true)
+		expectBool(t, "forced", e.Forced, true)
+		expectString(t, "base_ref", e.BaseRef, "refs/heads/master")
+		expectString(t, "organization.members_url", e.Organization.MembersURL, "https://api.github.com/orgs/Octocoders/members{/member}")
+		expectBool(t, "commits[0].distinct", e.Commits[0].Distinct, false)
+	},
+	"fork/fork.json": func(t *testing.T, event interface{}) {
+		e := event.(*ForkEvent)
+		expectString(t, "forkee.full_name", e.Forkee.FullName, "Octocoders/Hello-World")
+		expectBool(t, "forkee.fork", e.Forkee.Fork, true)
+		expectString(t, "forkee.owner.type", e.Forkee.Owner.Type, "Organization")
+		expectString(t, "repository.full_name", e.Repository.FullName, "Codertocat/Hello-World")
+	},
+	"issues/opened.json": func(t *testing.T, event interface{}) {
+		e := event.(*IssuesEvent)
+		expectString(t, "action", e.Action, "opened")
+		expectInt(t, "issue.number", e.Issue.Number, 1)
+		expectString(t, "issue.milestone.title", e.Issue.Milestone.Title, "v1.0")
+		if e.Issue.ClosedAt != nil {
+			t.Error("issue.closed_at was not <nil> when it was null in the input")
+		}
+	},
+	"issues/labeled.json": func(t *testing.T, event interface{}) {
+		e := event.(*IssuesEvent)
+		expectString(t, "action", e.Action, "labeled")
+		expectString(t, "label.name", e.Label.Name, "sev1")
+		if len(e.Issue.Labels) != 2 {
+			t.Errorf("len(issue.labels) was %d (should have been 2)", len(e.Issue.Labels))
+		}
+	},
+	"issues/edited.json": func(t *testing.T, event interface{}) {
+		e := event.(*IssuesEvent)
+		expectString(t, "changes.title.from", e.Changes.Title.From, "Spelling error in the README")
+		if e.Changes.Body != nil {
+			t.Error("changes.body was not <nil> when it was missing from the input")
+		}
+	},
+	"issues/closed.json": func(t *testing.T, event interface{}) {
+		e := event.(*IssuesEvent)
+		expectString(t, "issue.state", e.Issue.State, "closed")
+		expectInt(t, "issue.comments", e.Issue.Comments, 1)
+		expectString(t, "organization.login", e.Organization.Login, "Octocoders")
+		expectString(t, "sender.login", e.Sender.Login, "octocat")
+	},
+	"issues/milestoned.json": func(t *testing.T, event interface{}) {
+		e := event.(*IssuesEvent)
+		expectInt(t, "milestone.number", e.Milestone.Number, 1)
+		if !e.Milestone.DueOn.Time().Equal(time.Date(2019, 5, 23, 7, 0, 0, 0, time.UTC)) {
+			t.Errorf("milestone.due_on was %v", e.Milestone.DueOn.Time())
+		}
+	},
+	"issue_comment/created.json": func(t *testing.T, event interface{}) {
+		e := event.(*IssueCommentEvent)
+		expectString(t, "action", e.Action, "created")
+		expectInt(t, "comment.id", e.Comment.ID, 492700400)
+		expectString(t, "comment.author_association", e.Comment.AuthorAssociation, "OWNER")
+		expectInt(t, "issue.number", e.Issue.Number, 1)
+	},
+	"issue_comment/edited.json": func(t *testing.T, event interface{}) {
+		e := event.(*IssueCommentEvent)
+		expectString(t, "changes.body.from", e.Changes.Body.From, "You are totally right! I'll get this fixed right away.")
+	},
+	"label/created.json": func(t *testing.T, event interface{}) {
+		e := event.(*LabelEvent)
+		expectString(t, "label.name", e.Label.Name, "sev1")
+		expectBool(t, "label.default", e.Label.Default, false)
+	},
+	"label/edited.json": func(t *testing.T, event interface{}) {
+		e := event.(*LabelEvent)
+		expectString(t, "changes.name.from", e.Changes.Name.From, "severity-1")
+		expectString(t, "changes.color.from", e.Changes.Color.From, "e11d21")
+	},
+	"milestone/edited.json": func(t *testing.T, event interface{}) {
+		e := event.(*MilestoneEvent)
+		expectString(t, "action", e.Action, "edited")
+		expectInt(t, "milestone.number", e.Milestone.Number, 1)
+		expectString(t, "milestone.title", e.Milestone.Title, "v1.0")
+		expectString(t, "changes.title.from", e.Changes.Title.From, "1.0")
+	},
+	"pull_request/opened.json": func(t *testing.T, event interface{}) {
+		e := event.(*PullRequestEvent)
+		expectInt(t, "number", e.Number, 2)
+		expectString(t, "pull_request.head.ref", e.PullRequest.Head.Ref, "changes")
+		expectString(t, "pull_request.base.repo.full_name", e.PullRequest.Base.Repo.FullName, "Codertocat/Hello-World")
+		expectString(t, "pull_request._links.html.href", e.PullRequest.Links.HTML.Href, "https://github.com/Codertocat/Hello-World/pull/2")
+		expectString(t, "pull_request.requested_reviewers[0].login", e.PullRequest.RequestedReviewers[0].Login, "octocat")
+		if e.PullRequest.Mergeable != nil {
+			t.Error("pull_request.mergeable was not <nil> when it was null in the input")
+		}
+	},
+	"pull_request/closed.json": func(t *testing.T, event interface{}) {
+		e := event.(*PullRequestEvent)
+		expectBool(t, "pull_request.merged", e.PullRequest.Merged, true)
+		expectString(t, "pull_request.merged_by.login", e.PullRequest.MergedBy.Login, "Codertocat")
+		expectString(t, "pull_request.merge_commit_sha", e.PullRequest.MergeCommitSHA, "c4295bd74fb0f4fda03689c3df3f2803b658fd85")
+	},
+	"pull_request/synchronize.json": func(t *testing.T, event interface{}) {
+		e := event.(*PullRequestEvent)
+		expectString(t, "before", e.Before, "f95f852bd8fca8fcc58a9a2d6c842781e32a215e")
+		expectString(t, "after", e.After, "ec26c3e57ca3a959ca5aad62de7213c562f8c821")
+	},
+	"pull_request/labeled.json": func(t *testing.T, event interface{}) {
+		e := event.(*PullRequestEvent)
+		expectString(t, "label.name", e.Label.Name, "bug")
+		expectString(t, "sender.type", e.Sender.Type, "Bot")
+	},
+	"pull_request/review_requested.json": func(t *testing.T, event interface{}) {
+		e := event.(*PullRequestEvent)
+		expectString(t, "requested_reviewer.login", e.RequestedReviewer.Login, "octocat")
+	},
+	"installation/created.json": func(t *testing.T, event interface{}) {
+		e := event.(*InstallationEvent)
+		expectString(t, "action", e.Action, "created")
+		expectInt(t, "installation.app_id", e.Installation.AppID, 5725)
+		if e.Installation.Permissions["issues"] != "write" {
+			t.Errorf("installation.permissions was %v", e.Installation.Permissions)
+		}
+		if len(e.Repositories) != 2 {
+			t.Errorf("len(repositories) was %d (should have been 2)", len(e.Repositories))
+		}
+	},
+	"installation/deleted.json": func(t *testing.T, event interface{}) {
+		e := event.(*InstallationEvent)
+		expectString(t, "action", e.Action, "deleted")
+		expectString(t, "installation.account.login", e.Installation.Account.Login, "Codertocat")
+	},
+	"installation/suspend.json": func(t *testing.T, event interface{}) {
+		e := event.(*InstallationEvent)
+		expectString(t, "installation.suspended_by", e.Installation.SuspendedBy, "Codertocat")
+		if e.Installation.SuspendedAt == nil {
+			t.Error("installation.suspended_at was <nil>")
+		}
+	},
+	"installation/new_permissions_accepted.json": func(t *testing.T, event interface{}) {
+		e := event.(*InstallationEvent)
+		if e.Installation.Permissions["checks"] != "write" {
+			t.Errorf("installation.permissions was %v", e.Installation.Permissions)
+		}
+	},
+	"installation_repositories/added.json": func(t *testing.T, event interface{}) {
+		e := event.(*InstallationRepositoriesEvent)
+		expectString(t, "repository_selection", e.RepositorySelection, "selected")
+		if len(e.RepositoriesAdded) != 1 || *e.RepositoriesAdded[0].FullName != "Codertocat/Moon-Base" {
+			t.Errorf("repositories_added was %v", e.RepositoriesAdded)
+		}
+	},
+	"installation_repositories/removed.json": func(t *testing.T, event interface{}) {
+		e := event.(*InstallationRepositoriesEvent)
+		expectBool(t, "repositories_removed[0].private", e.RepositoriesRemoved[0].Private, true)
+	},
+	"ping/ping.json": func(t *testing.T, event interface{}) {
+		e := event.(*PingEvent)
+		expectString(t, "zen", e.Zen, "Design for failure.")
+		expectInt(t, "hook_id", e.HookID, 30)
+		expectString(t, "hook.config.url", e.Hook.Config.URL, "https://smee.io/WZmVKLpnXyIbsl8C")
+		expectString(t, "hook.last_response.status", e.Hook.LastResponse.Status, "unused")
+		if len(e.Hook.Events) != 2 {
+			t.Errorf("hook.events was %v", e.Hook.Events)
+		}
+	},
+}
+
+func TestFixtureRoundTrip(t *testing.T) {
+	for name, check := range fixtureTests {
+		name, check := name, check
+		t.Run(name, func(t *testing.T) {
+			payload, err := ioutil.ReadFile(filepath.Join("testdata", name))
+			if err != nil {
+				t.Fatal(err)
+			}
+			event, err := ParseWebHook(filepath.Dir(name), payload)
+			if err != nil {
+				t.Fatal(err)
+			}
+			check(t, event)
+			encoded, err := json.Marshal(event)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var want, got interface{}
+			if err := json.Unmarshal(payload, &want); err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal(encoded, &got); err != nil {
+				t.Fatal(err)
+			}
+			for _, diff := range jsonDiff("", normalizeJSON(want), normalizeJSON(got)) {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+// Every fixture on disk should have a table entry, and every event type a fixture
+func TestFixtureCoverage(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("testdata", "*", "*.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	covered := map[string]bool{}
+	for _, file := range files {
+		name := filepath.ToSlash(strings.TrimPrefix(file, "testdata"+string(filepath.Separator)))
+		if _, ok := fixtureTests[name]; !ok {
+			t.Errorf("fixture %s has no entry in fixtureTests", name)
+		}
+		covered[filepath.Dir(file)[len("testdata")+1:]] = true
+	}
+	for _, eventType := range EventTypes() {
+		if !covered[eventType] {
+			t.Errorf("event type \"%s\" has no fixture in testdata/%s/", eventType, eventType)
+		}
+	}
+}
+
+func TestParseWebHookUnknownEvent(t *testing.T) {
+	if _, err := ParseWebHook("no_such_event", []byte(`{}`)); err == nil {
+		t.Error("ParseWebHook() didn't fail on an unknown event type")
+	}
+}
+
+// Allocations grow with the payload, even for payloads of empty objects that each
+// become a struct
+func TestParseWebHookAllocations(t *testing.T) {
+	payloads := map[string]string{
+		"push":         `{"commits":[%s{}],"repository":{"owner":{}}}`,
+		"installation": `{"installation":{"permissions":{"issues":"write"}},"repositories":[%s{}]}`,
+	}
+	for eventType, format := range payloads {
+		payload := []byte(fmt.Sprintf(format, strings.Repeat("{},", 1000)))
+		allocs := testing.AllocsPerRun(5, func() {
+			if _, err := ParseWebHook(eventType, payload); err != nil {
+				t.Fatal(err)
+			}
+		})
+		// At most an allocation (a struct, or a field value) per byte, plus slack for
+		// slice growth
+		if limit := float64(len(payload) + 100); allocs > limit {
+			t.Errorf("decoding %d bytes of %s made %.0f allocations (should be at most %.0f)", len(payload), eventType, allocs, limit)
+		}
+	}
+}
+
+// Decoding attacker controlled payloads must not panic, must allocate memory only in
+// proportion to the payload size, and whatever we decode must encode to valid JSON
+func FuzzParseWebHook(f *testing.F) {
+	for name := range fixtureTests {
+		payload, err := ioutil.ReadFile(filepath.Join("testdata", name))
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(filepath.Dir(name), payload)
+	}
+	f.Add("push", []byte(`{"commits":[{},{},{}],"repository":{"owner":{}}}`))
+	f.Add("installation", []byte(`{"installation":{"permissions":{"issues":"write"}},"repositories":[{},{}]}`))
+	f.Add("issues", []byte(`{"issue":{"created_at":"1557933565","closed_at":null}}`))
+	f.Fuzz(func(t *testing.T, eventType string, payload []byte) {
+		event, err := ParseWebHook(eventType, payload)
+		if err != nil {
+			return
+		}
+		encoded, err := json.Marshal(event)
+		if err != nil {
+			t.Fatalf("decoded %q payload failed to encode: %v", eventType, err)
+		}
+		if !json.Valid(encoded) {
+			t.Fatalf("decoded %q payload encoded to invalid JSON: %q", eventType, encoded)
+		}
+	})
+}
+
+func expectString(t *testing.T, field string, got *string, want string) {
+	t.Helper()
+	if got == nil {
+		t.Errorf("%s was <nil> (should have been \"%s\")", field, want)
+	} else if *got != want {
+		t.Errorf("%s was \"%s\" (should have been \"%s\")", field, *got, want)
+	}
+}
+
+func expectInt(t *testing.T, field string, got *int, want int) {
+	t.Helper()
+	if got == nil {
+		t.Errorf("%s was <nil> (should have been %d)", field, want)
+	} else if *got != want {
+		t.Errorf("%s was %d (should have been %d)", field, *got, want)
+	}
+}
+
+func expectBool(t *testing.T, field string, got *bool, want bool) {
+	t.Helper()
+	if got == nil {
+		t.Errorf("%s was <nil> (should have been %v)", field, want)
+	} else if *got != want {
+		t.Errorf("%s was %v (should have been %v)", field, *got, want)
+	}
+}
+
+// normalizeJSON drops nulls and empty arrays, which we can't expect to survive a round trip
+func normalizeJSON(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		out := map[string]interface{}{}
+		for key, value := range v {
+			if value == nil {
+				continue
+			}
+			if slice, ok := value.([]interface{}); ok && len(slice) == 0 {
+				continue
+			}
+			out[key] = normalizeJSON(value)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, value := range v {
+			out[i] = normalizeJSON(value)
+		}
+		return out
+	}
+	return v
+}
+
+// jsonDiff returns a description of every difference between two decoded JSON values
+func jsonDiff(path string, want, got interface{}) []string {
+	if reflect.DeepEqual(want, got) || sameTime(want, got) {
+		return nil
+	}
+	switch w := want.(type) {
+	case map[string]interface{}:
+		g, ok := got.(map[string]interface{})
+		if !ok {
+			break
+		}
+		keys := []string{}
+		for key := range w {
+			keys = append(keys, key)
+		}
+		for key := range g {
+			if _, ok := w[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		diffs := []string{}
+		for _, key := range keys {
+			wv, wok := w[key]
+			gv, gok := g[key]
+			switch {
+			case !gok:
+				diffs = append(diffs, fmt.Sprintf("%s%s: lost in round trip (not modelled?)", path, key))
+			case !wok:
+				diffs = append(diffs, fmt.Sprintf("%s%s: appeared in round trip", path, key))
+			default:
+				diffs = append(diffs, jsonDiff(path+key+".", wv, gv)...)
+			}
+		}
+		return diffs
+	case []interface{}:
+		g, ok := got.([]interface{})
+		if !ok || len(g) != len(w) {
+			break
+		}
+		diffs := []string{}
+		for i := range w {
+			diffs = append(diffs, jsonDiff(fmt.Sprintf("%s%d.", path, i), w[i], g[i])...)
+		}
+		return diffs
+	}
+	return []string{fmt.Sprintf("%s: was %v after round trip (should have been %v)", strings.TrimSuffix(path, "."), got, want)}
+}
+
+// sameTime compares timestamps that may have been converted from epoch integers to RFC3339
+func sameTime(want, got interface{}) bool {
+	g, ok := got.(string)
+	if !ok {
+		return false
+	}
+	gt, err := time.Parse(time.RFC3339, g)
+	if err != nil {
+		return false
+	}
+	switch w := want.(type) {
+	case string:
+		wt, err := time.Parse(time.RFC3339, w)
+		return err == nil && wt.Equal(gt)
+	case float64:
+		return int64(w) == gt.Unix()
+	}
+	return false
+}
+
+func TestEventTypeOf(t *testing.T) {
+	for _, name := range EventTypes() {
+		event, _ := NewEvent(name)
+		if got := EventTypeOf(event); got != name {
+			t.Errorf("EventTypeOf(%T) returned \"%s\"", event, got)
+		}
+	}
+	if got := EventTypeOf(PushEvent{}); got != "" {
+		t.Errorf("EventTypeOf() of a non-pointer returned \"%s\"", got)
+	}
+}